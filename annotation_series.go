@@ -7,10 +7,27 @@ import (
 
 // AnnotationSeries is a series of labels on the chart.
 type AnnotationSeries struct {
-	Name        string
-	Style       Style
-	YAxis       YAxisType
+	Name  string
+	Style Style
+	YAxis YAxisType
+
+	// Annotations are the labels; each annotation's style is inherited from the series style,
+	// so it only needs to set what differs (i.e. the fill color, font color or font size).
 	Annotations []Value2
+
+	// Overlap is how overlapping annotations are resolved; by default they are drawn where they are.
+	Overlap LabelOverlap
+	// Rounded draws the annotations as rounded chips beside their anchors, rather than tags pointing at them.
+	Rounded bool
+	// Seed, if set, breaks ties between overlapping annotations of the same priority pseudo-randomly.
+	Seed int64
+	// MarginLabels draws the labels in the margin right of the canvas (and its axes) at the height of their points,
+	// connected to them with leader lines; the chart reserves the margin when measuring.
+	// Overlapping margin labels are nudged unless `Overlap` is set.
+	MarginLabels bool
+
+	// marginOffset is the width of the axes right of the canvas, set by the chart.
+	marginOffset int
 }
 
 // GetName returns the name of the time series.
@@ -28,6 +45,14 @@ func (as AnnotationSeries) GetYAxis() YAxisType {
 	return as.YAxis
 }
 
+// WithSeed implements `SeededSeries`.
+func (as AnnotationSeries) WithSeed(seed int64) Series {
+	if as.Seed == 0 {
+		as.Seed = seed
+	}
+	return as
+}
+
 func (as AnnotationSeries) annotationStyleDefaults(defaults Style) Style {
 	return Style{
 		FontColor:   DefaultTextColor,
@@ -49,16 +74,18 @@ func (as AnnotationSeries) Measure(r Renderer, canvasBox Box, xrange, yrange Ran
 		Bottom: 0,
 	}
 	if as.Style.IsZero() || as.Style.Show {
-		seriesStyle := as.Style.InheritFrom(as.annotationStyleDefaults(defaults))
-		for _, a := range as.Annotations {
-			style := a.Style.InheritFrom(seriesStyle)
-			lx := canvasBox.Left + xrange.Translate(a.XValue)
-			ly := canvasBox.Bottom - yrange.Translate(a.YValue)
-			ab := Draw.MeasureAnnotation(r, canvasBox, style, lx, ly, a.Label)
-			box.Top = Math.MinInt(box.Top, ab.Top)
-			box.Left = Math.MinInt(box.Left, ab.Left)
-			box.Right = Math.MaxInt(box.Right, ab.Right)
-			box.Bottom = Math.MaxInt(box.Bottom, ab.Bottom)
+		for _, p := range as.getPlacements(r, canvasBox, xrange, yrange, defaults) {
+			if p.Hidden {
+				continue
+			}
+			box.Top = Math.MinInt(box.Top, p.Box.Top)
+			box.Left = Math.MinInt(box.Left, p.Box.Left)
+			box.Right = Math.MaxInt(box.Right, p.Box.Right)
+			box.Bottom = Math.MaxInt(box.Bottom, p.Box.Bottom)
+		}
+		// margin labels may be nudged once the canvas has shrunk to fit them, so reserve room for that too.
+		if overlap := as.getOverlap(); as.MarginLabels && (overlap == LabelOverlapNudge || overlap == LabelOverlapNudgeOrHide) {
+			box.Right += DefaultLabelLeaderOffset
 		}
 	}
 	return box
@@ -68,15 +95,56 @@ func (as AnnotationSeries) Measure(r Renderer, canvasBox Box, xrange, yrange Ran
 func (as AnnotationSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
 	if as.Style.IsZero() || as.Style.Show {
 		seriesStyle := as.Style.InheritFrom(as.annotationStyleDefaults(defaults))
-		for _, a := range as.Annotations {
+		for index, p := range as.getPlacements(r, canvasBox, xrange, yrange, defaults) {
+			if p.Hidden {
+				continue
+			}
+			a := as.Annotations[index]
 			style := a.Style.InheritFrom(seriesStyle)
-			lx := canvasBox.Left + xrange.Translate(a.XValue)
-			ly := canvasBox.Bottom - yrange.Translate(a.YValue)
-			Draw.Annotation(r, canvasBox, style, lx, ly, a.Label)
+			lx, ly := p.Anchor.X+p.Offset.X, p.Anchor.Y+p.Offset.Y
+			if p.HasLeader() || as.MarginLabels {
+				style.GetStrokeOptions().WriteToRenderer(r)
+				r.MoveTo(canvasBox.Left+xrange.Translate(a.XValue), canvasBox.Bottom-yrange.Translate(a.YValue))
+				r.LineTo(lx, ly)
+				r.Stroke()
+			}
+			if as.Rounded {
+				Draw.RoundedAnnotation(r, canvasBox, style, lx, ly, a.Label)
+			} else {
+				Draw.Annotation(r, canvasBox, style, lx, ly, a.Label)
+			}
 		}
 	}
 }
 
+// getPlacements measures the annotations and resolves their overlaps.
+func (as AnnotationSeries) getPlacements(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) []LabelPlacement {
+	seriesStyle := as.Style.InheritFrom(as.annotationStyleDefaults(defaults))
+	candidates := make([]LabelCandidate, len(as.Annotations))
+	for index, a := range as.Annotations {
+		style := a.Style.InheritFrom(seriesStyle)
+		lx := canvasBox.Left + xrange.Translate(a.XValue)
+		ly := canvasBox.Bottom - yrange.Translate(a.YValue)
+		if as.MarginLabels {
+			lx = canvasBox.Right + as.marginOffset + DefaultAnnotationMarginGap
+		}
+		candidates[index] = LabelCandidate{
+			Anchor:   Point{X: lx, Y: ly},
+			Box:      Draw.MeasureAnnotation(r, canvasBox, style, lx, ly, a.Label),
+			Priority: a.Priority,
+		}
+	}
+	return PlaceLabelsWithSeed(canvasBox, candidates, as.getOverlap(), as.Seed)
+}
+
+// getOverlap returns how overlapping annotations are resolved; margin labels are nudged by default.
+func (as AnnotationSeries) getOverlap() LabelOverlap {
+	if as.MarginLabels && as.Overlap == LabelOverlapUnset {
+		return LabelOverlapNudge
+	}
+	return as.Overlap
+}
+
 // Validate validates the series.
 func (as AnnotationSeries) Validate() error {
 	if len(as.Annotations) == 0 {
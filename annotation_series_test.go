@@ -1,7 +1,10 @@
 package chart
 
 import (
+	"bytes"
+	"fmt"
 	"image/color"
+	"strings"
 	"testing"
 
 	"github.com/blendlabs/go-assert"
@@ -117,3 +120,156 @@ func TestAnnotationSeriesRender(t *testing.T) {
 	assert.Equal(0, converted.G)
 	assert.Equal(0, converted.B)
 }
+
+func TestAnnotationSeriesMeasureWrapped(t *testing.T) {
+	assert := assert.New(t)
+
+	label := "a long annotation label that would overflow the canvas"
+	as := AnnotationSeries{
+		Annotations: []Value2{
+			{XValue: 1.0, YValue: 2.0, Label: label},
+		},
+	}
+
+	r, err := PNG(400, 200)
+	assert.Nil(err)
+	f, err := GetDefaultFont()
+	assert.Nil(err)
+
+	xrange := &ContinuousRange{Min: 1.0, Max: 4.0, Domain: 300}
+	yrange := &ContinuousRange{Min: 1.0, Max: 4.0, Domain: 150}
+	cb := Box{Top: 5, Left: 5, Right: 305, Bottom: 155}
+	sd := Style{FontSize: 10.0, Font: f}
+
+	unwrapped := as.Measure(r, cb, xrange, yrange, sd)
+	assert.True(unwrapped.Right > cb.Right)
+
+	as.Style = Style{Show: true, TextWrap: TextWrapWord}
+	wrapped := as.Measure(r, cb, xrange, yrange, sd)
+	assert.True(wrapped.Right <= cb.Right, wrapped.String())
+	assert.True(wrapped.Height() > unwrapped.Height())
+}
+
+func TestAnnotationSeriesMeasureOverlap(t *testing.T) {
+	assert := assert.New(t)
+
+	as := AnnotationSeries{
+		Annotations: []Value2{
+			{XValue: 2.0, YValue: 2.0, Label: "first"},
+			{XValue: 2.1, YValue: 2.0, Label: "second", Priority: 1},
+		},
+	}
+
+	r, err := PNG(400, 200)
+	assert.Nil(err)
+	f, err := GetDefaultFont()
+	assert.Nil(err)
+
+	xrange := &ContinuousRange{Min: 1.0, Max: 4.0, Domain: 300}
+	yrange := &ContinuousRange{Min: 1.0, Max: 4.0, Domain: 150}
+	cb := Box{Top: 5, Left: 5, Right: 305, Bottom: 155}
+	sd := Style{FontSize: 10.0, Font: f}
+
+	piled := as.Measure(r, cb, xrange, yrange, sd)
+
+	as.Overlap = LabelOverlapNudge
+	nudged := as.Measure(r, cb, xrange, yrange, sd)
+	assert.True(nudged.Height() > piled.Height())
+
+	placements := as.getPlacements(r, cb, xrange, yrange, sd)
+	assert.True(placements[0].HasLeader())
+	assert.False(placements[1].HasLeader())
+	assert.False(placements[0].Box.Intersects(placements[1].Box))
+
+	as.Overlap = LabelOverlapHide
+	hidden := as.getPlacements(r, cb, xrange, yrange, sd)
+	assert.True(hidden[0].Hidden)
+	assert.False(hidden[1].Hidden)
+}
+
+func TestAnnotationSeriesRenderPerAnnotationStyle(t *testing.T) {
+	assert := assert.New(t)
+
+	as := AnnotationSeries{
+		Style: Style{
+			Show:      true,
+			FillColor: drawing.ColorWhite,
+		},
+		Annotations: []Value2{
+			{XValue: 1.0, YValue: 1.0, Label: "ok"},
+			{XValue: 2.0, YValue: 2.0, Label: "warn", Style: Style{FillColor: ColorOrange}},
+			{XValue: 3.0, YValue: 3.0, Label: "critical", Style: Style{FillColor: ColorRed, FontColor: drawing.ColorWhite, FontSize: 14.0}},
+		},
+	}
+
+	r, err := SVG(200, 200)
+	assert.Nil(err)
+	r.SetDPI(DefaultDPI)
+	f, err := GetDefaultFont()
+	assert.Nil(err)
+
+	xrange := &ContinuousRange{Min: 1.0, Max: 4.0, Domain: 150}
+	yrange := &ContinuousRange{Min: 1.0, Max: 4.0, Domain: 150}
+	cb := Box{Top: 5, Left: 5, Right: 155, Bottom: 155}
+	as.Render(r, cb, xrange, yrange, Style{FontSize: 10.0, Font: f})
+
+	buffer := bytes.NewBuffer([]byte{})
+	assert.Nil(r.Save(buffer))
+	svg := buffer.String()
+	assert.True(strings.Contains(svg, "fill:"+drawing.ColorWhite.String()))
+	assert.True(strings.Contains(svg, "fill:"+ColorOrange.String()))
+	assert.True(strings.Contains(svg, "fill:"+ColorRed.String()))
+	criticalFontSize := fmt.Sprintf("font-size:%.1fpx", drawing.PointsToPixels(DefaultDPI, 14.0))
+	assert.True(strings.Contains(svg, criticalFontSize), "the critical annotation should use its own font size")
+}
+
+func TestAnnotationSeriesMarginLabels(t *testing.T) {
+	assert := assert.New(t)
+
+	as := AnnotationSeries{
+		MarginLabels: true,
+		Annotations: []Value2{
+			{XValue: 1.0, YValue: 1.0, Label: "1.0"},
+			{XValue: 4.0, YValue: 4.0, Label: "4.0"},
+		},
+	}
+
+	r, err := PNG(200, 110)
+	assert.Nil(err)
+
+	f, err := GetDefaultFont()
+	assert.Nil(err)
+
+	xrange := &ContinuousRange{Min: 1.0, Max: 4.0, Domain: 100}
+	yrange := &ContinuousRange{Min: 1.0, Max: 4.0, Domain: 100}
+	cb := Box{Top: 5, Left: 5, Right: 105, Bottom: 105}
+
+	box := as.Measure(r, cb, xrange, yrange, Style{FontSize: 10.0, Font: f})
+	assert.Equal(cb.Right+DefaultAnnotationMarginGap, box.Left)
+	assert.True(box.Right > cb.Right)
+
+	as.marginOffset = 20
+	offsetBox := as.Measure(r, cb, xrange, yrange, Style{FontSize: 10.0, Font: f})
+	assert.Equal(box.Left+20, offsetBox.Left)
+}
+
+func TestChartAnnotationMarginLabels(t *testing.T) {
+	assert := assert.New(t)
+
+	c := Chart{
+		Info:  &RenderInfo{},
+		YAxis: YAxis{Style: StyleShow()},
+		Series: []Series{
+			ContinuousSeries{XValues: []float64{1, 2, 3}, YValues: []float64{1, 2, 3}},
+			AnnotationSeries{MarginLabels: true, Annotations: []Value2{{XValue: 2, YValue: 2, Label: "a long margin label"}}},
+		},
+	}
+	withoutLabels := Chart{
+		Info:   &RenderInfo{},
+		YAxis:  YAxis{Style: StyleShow()},
+		Series: c.Series[:1],
+	}
+	assert.Nil(c.Render(PNG, bytes.NewBuffer([]byte{})))
+	assert.Nil(withoutLabels.Render(PNG, bytes.NewBuffer([]byte{})))
+	assert.True(c.Info.Canvas.Right < withoutLabels.Info.Canvas.Right)
+}
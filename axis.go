@@ -22,6 +22,27 @@ const (
 	YAxisSecondary YAxisType = 1
 )
 
+// YAxisSide is the side of the canvas a y-axis is drawn on.
+type YAxisSide int
+
+const (
+	// YAxisSideUnset draws the primary axis on the right (on the left for right-to-left charts),
+	// and the secondary axis on the other side of the primary axis.
+	YAxisSideUnset YAxisSide = 0
+	// YAxisSideRight draws the axis on the right of the canvas.
+	YAxisSideRight YAxisSide = 1
+	// YAxisSideLeft draws the axis on the left of the canvas.
+	YAxisSideLeft YAxisSide = 2
+)
+
+// Opposite returns the other side.
+func (yas YAxisSide) Opposite() YAxisSide {
+	if yas == YAxisSideLeft {
+		return YAxisSideRight
+	}
+	return YAxisSideLeft
+}
+
 // Axis is a chart feature detailing what values happen where.
 type Axis interface {
 	GetName() string
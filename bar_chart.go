@@ -14,6 +14,10 @@ type BarChart struct {
 	Title      string
 	TitleStyle Style
 
+	// Description is a caption (or alt text) for the chart; it is embedded in the output
+	// where the format supports it, i.e. as the svg `<desc>` or a png text chunk.
+	Description string
+
 	Width  int
 	Height int
 	DPI    float64
@@ -93,6 +97,9 @@ func (bc BarChart) Render(rp RendererProvider, w io.Writer) error {
 	if err != nil {
 		return err
 	}
+	if dr, isDescriptionRenderer := r.(DescriptionRenderer); isDescriptionRenderer && len(bc.Description) > 0 {
+		dr.SetDescription(bc.Description)
+	}
 
 	if bc.Font == nil {
 		defaultFont, err := GetDefaultFont()
@@ -124,7 +131,7 @@ func (bc BarChart) Render(rp RendererProvider, w io.Writer) error {
 		yr = bc.setRangeDomains(canvasBox, yr)
 	}
 
-	bc.drawBars(r, canvasBox, yr)
+	bc.drawBars(r, canvasBox, yr, yf)
 	bc.drawXAxis(r, canvasBox)
 	bc.drawYAxis(r, canvasBox, yr, yt)
 
@@ -178,7 +185,7 @@ func (bc BarChart) drawBackground(r Renderer) {
 	}, bc.getBackgroundStyle())
 }
 
-func (bc BarChart) drawBars(r Renderer, canvasBox Box, yr Range) {
+func (bc BarChart) drawBars(r Renderer, canvasBox Box, yr Range, yf ValueFormatter) {
 	xoffset := canvasBox.Left
 
 	width, spacing, _ := bc.calculateScaledTotalWidth(canvasBox)
@@ -200,6 +207,7 @@ func (bc BarChart) drawBars(r Renderer, canvasBox Box, yr Range) {
 		}
 
 		Draw.Box(r, barBox, bar.Style.InheritFrom(bc.styleDefaultsBar(index)))
+		recordArea(r, ImageMapAreaBar, bar.Label, imageMapBarTitle(bar.Label, yf(bar.Value)), barBox)
 
 		xoffset += width + spacing
 	}
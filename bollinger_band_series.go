@@ -53,6 +53,16 @@ func (bbs BollingerBandsSeries) GetK(defaults ...float64) float64 {
 	return bbs.K
 }
 
+// GetInnerSeries implements `DerivedSeries`.
+func (bbs BollingerBandsSeries) GetInnerSeries() ValueProvider {
+	return bbs.InnerSeries
+}
+
+// GetDerivation implements `DerivedSeries`.
+func (bbs BollingerBandsSeries) GetDerivation() string {
+	return fmt.Sprintf("Bollinger(%d, %v)", bbs.GetPeriod(), bbs.GetK())
+}
+
 // Len returns the number of elements in the series.
 func (bbs *BollingerBandsSeries) Len() int {
 	return bbs.InnerSeries.Len()
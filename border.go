@@ -0,0 +1,27 @@
+package chart
+
+// Border is a per-side box border; only the sides with `Show` set are drawn, each with its own stroke.
+// Sides inherit unset stroke options from the style of the box they border.
+type Border struct {
+	Top    Style
+	Left   Style
+	Right  Style
+	Bottom Style
+}
+
+// IsZero returns if none of the sides are shown.
+func (b Border) IsZero() bool {
+	return !b.Top.Show && !b.Left.Show && !b.Right.Show && !b.Bottom.Show
+}
+
+// BorderLeftBottom returns a border with only the left and bottom sides shown, i.e. an open frame.
+func BorderLeftBottom(s Style) Border {
+	s.Show = true
+	return Border{Left: s, Bottom: s}
+}
+
+// BorderAll returns a border with all sides shown with the same stroke.
+func BorderAll(s Style) Border {
+	s.Show = true
+	return Border{Top: s, Left: s, Right: s, Bottom: s}
+}
@@ -0,0 +1,60 @@
+package chart
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/blendlabs/go-assert"
+	"github.com/wcharczuk/go-chart/drawing"
+)
+
+func TestBorderIsZero(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.True(Border{}.IsZero())
+	assert.True(Border{Top: Style{StrokeWidth: 2}}.IsZero())
+	assert.False(BorderLeftBottom(Style{}).IsZero())
+	assert.False(BorderAll(Style{}).IsZero())
+}
+
+func TestDrawBoxBorder(t *testing.T) {
+	assert := assert.New(t)
+
+	r, err := SVG(100, 100)
+	assert.Nil(err)
+
+	border := Border{
+		Left:   Style{Show: true, StrokeWidth: 3.0},
+		Bottom: Style{Show: true, StrokeColor: drawing.ColorRed},
+	}
+	Draw.BoxBorder(r, Box{Top: 10, Left: 10, Right: 90, Bottom: 90}, border, Style{StrokeColor: drawing.ColorBlack, StrokeWidth: 1.0})
+
+	buffer := bytes.NewBuffer([]byte{})
+	assert.Nil(r.Save(buffer))
+	svg := buffer.String()
+	assert.Equal(2, strings.Count(svg, "<path"))
+	assert.True(strings.Contains(svg, "M 10 90\nL 10 10"))
+	assert.True(strings.Contains(svg, "stroke-width:3"))
+	assert.True(strings.Contains(svg, "stroke:"+drawing.ColorRed.String()))
+	assert.False(strings.Contains(svg, "M 10 10\nL 90 10"), "the top side should not be drawn")
+}
+
+func TestChartCanvasBorder(t *testing.T) {
+	assert := assert.New(t)
+
+	c := Chart{
+		Width:        200,
+		Height:       100,
+		Canvas:       Style{StrokeColor: drawing.ColorBlue, StrokeWidth: 2.0},
+		CanvasBorder: BorderLeftBottom(Style{}),
+		Series: []Series{
+			ContinuousSeries{XValues: []float64{1, 2}, YValues: []float64{1, 2}},
+		},
+	}
+
+	buffer := bytes.NewBuffer([]byte{})
+	assert.Nil(c.Render(SVG, buffer))
+	svg := buffer.String()
+	assert.Equal(2, strings.Count(svg, "stroke:"+drawing.ColorBlue.String()), "only the left and bottom sides should be stroked")
+}
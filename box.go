@@ -122,6 +122,14 @@ func (b Box) Equals(other Box) bool {
 		b.Bottom == other.Bottom
 }
 
+// Intersects returns if the box overlaps another box.
+func (b Box) Intersects(other Box) bool {
+	return b.Left < other.Right &&
+		other.Left < b.Right &&
+		b.Top < other.Bottom &&
+		other.Top < b.Bottom
+}
+
 // Grow grows a box based on another box.
 func (b Box) Grow(other Box) Box {
 	return Box{
@@ -30,6 +30,16 @@ func TestBoxEquals(t *testing.T) {
 	assert.False(b.Equals(c))
 }
 
+func TestBoxIntersects(t *testing.T) {
+	assert := assert.New(t)
+
+	a := Box{Top: 10, Left: 10, Right: 20, Bottom: 20}
+	assert.True(a.Intersects(Box{Top: 15, Left: 15, Right: 25, Bottom: 25}))
+	assert.True(a.Intersects(Box{Top: 12, Left: 12, Right: 18, Bottom: 18}))
+	assert.False(a.Intersects(Box{Top: 20, Left: 10, Right: 20, Bottom: 30}))
+	assert.False(a.Intersects(Box{Top: 10, Left: 30, Right: 40, Bottom: 20}))
+}
+
 func TestBoxIsBiggerThan(t *testing.T) {
 	assert := assert.New(t)
 
@@ -0,0 +1,404 @@
+package chart
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+
+	"github.com/golang/freetype/truetype"
+)
+
+const (
+	// DefaultBumpChartLabelMargin is the default distance between the ends of the trajectories and their labels.
+	DefaultBumpChartLabelMargin = 8
+	// DefaultBumpChartDotRadius is the default radius of the dots at each time step of a trajectory.
+	DefaultBumpChartDotRadius = 3.0
+	// DefaultBumpChartLineWidth is the default width of the trajectories.
+	DefaultBumpChartLineWidth = 3.0
+)
+
+// BumpSeries is a category of a bump chart with its value at each time step of the chart.
+// Missing values can be set to `math.NaN()`; the category is left unranked at that time step.
+type BumpSeries struct {
+	Name   string
+	Style  Style
+	Values []float64
+}
+
+// BumpChart is a chart that ranks categories at each time step and draws the rank of each category over time,
+// with the best rank at the top and the category names at both ends of each trajectory.
+type BumpChart struct {
+	Title      string
+	TitleStyle Style
+
+	// Description is a caption (or alt text) for the chart; it is embedded in the output
+	// where the format supports it, i.e. as the svg `<desc>` or a png text chunk.
+	Description string
+
+	Width  int
+	Height int
+	DPI    float64
+
+	Background Style
+	Canvas     Style
+
+	XAxis XAxis
+	// YAxis is the rank axis; its range and ticks are set by the bump chart.
+	YAxis YAxis
+
+	Font *truetype.Font
+
+	// XValues are the time steps; use `Time.ToFloat64` and a time value formatter on the x-axis for dates.
+	XValues []float64
+	Series  []BumpSeries
+
+	// Ascending ranks the lowest value first, otherwise the highest value is ranked first.
+	Ascending bool
+	// LabelOverlap is how overlapping end labels are resolved, it defaults to `LabelOverlapNudge`.
+	LabelOverlap LabelOverlap
+	// Seed, if set, breaks ties between overlapping end labels pseudo-randomly.
+	Seed int64
+
+	Elements []Renderable
+}
+
+// GetDPI returns the dpi for the chart.
+func (bc BumpChart) GetDPI(defaults ...float64) float64 {
+	if bc.DPI == 0 {
+		if len(defaults) > 0 {
+			return defaults[0]
+		}
+		return DefaultDPI
+	}
+	return bc.DPI
+}
+
+// GetWidth returns the chart width or the default value.
+func (bc BumpChart) GetWidth() int {
+	if bc.Width == 0 {
+		return DefaultChartWidth
+	}
+	return bc.Width
+}
+
+// GetHeight returns the chart height or the default value.
+func (bc BumpChart) GetHeight() int {
+	if bc.Height == 0 {
+		return DefaultChartHeight
+	}
+	return bc.Height
+}
+
+// GetLabelOverlap returns the label overlap resolution or a default.
+func (bc BumpChart) GetLabelOverlap() LabelOverlap {
+	if bc.LabelOverlap == LabelOverlapUnset {
+		return LabelOverlapNudge
+	}
+	return bc.LabelOverlap
+}
+
+// Ranks returns the rank (starting at 1) of each series at each time step, by series and then time step.
+// Ties are broken by the order of the series; missing values are ranked as `math.NaN()`.
+func (bc BumpChart) Ranks() [][]float64 {
+	ranks := make([][]float64, len(bc.Series))
+	for index := range ranks {
+		ranks[index] = make([]float64, len(bc.XValues))
+	}
+
+	order := make([]int, 0, len(bc.Series))
+	for step := range bc.XValues {
+		order = order[:0]
+		for index, s := range bc.Series {
+			if step < len(s.Values) && !math.IsNaN(s.Values[step]) {
+				order = append(order, index)
+			} else {
+				ranks[index][step] = math.NaN()
+			}
+		}
+		sort.SliceStable(order, func(i, j int) bool {
+			if bc.Ascending {
+				return bc.Series[order[i]].Values[step] < bc.Series[order[j]].Values[step]
+			}
+			return bc.Series[order[i]].Values[step] > bc.Series[order[j]].Values[step]
+		})
+		for rank, index := range order {
+			ranks[index][step] = float64(rank + 1)
+		}
+	}
+	return ranks
+}
+
+// Render renders the chart with the given renderer to the given io.Writer.
+func (bc BumpChart) Render(rp RendererProvider, w io.Writer) error {
+	if len(bc.Series) == 0 {
+		return errors.New("please provide at least one series")
+	}
+	if len(bc.XValues) < 2 {
+		return errors.New("please provide at least two time steps")
+	}
+	for _, s := range bc.Series {
+		if len(s.Values) != len(bc.XValues) {
+			return fmt.Errorf("bump series %q must have a value for each time step", s.Name)
+		}
+	}
+
+	c, err := bc.getChart(rp)
+	if err != nil {
+		return err
+	}
+	return c.Render(rp, w)
+}
+
+// getChart builds the chart that draws the rank trajectories, reserving room for the labels in the background padding.
+func (bc BumpChart) getChart(rp RendererProvider) (Chart, error) {
+	font := bc.Font
+	if font == nil {
+		defaultFont, err := GetDefaultFont()
+		if err != nil {
+			return Chart{}, err
+		}
+		font = defaultFont
+	}
+
+	// measure the labels up front, so they're laid out outside of the canvas (and the axes).
+	r, err := rp(bc.GetWidth(), bc.GetHeight())
+	if err != nil {
+		return Chart{}, err
+	}
+	r.SetDPI(bc.GetDPI(DefaultDPI))
+	var labelWidth int
+	for index, s := range bc.Series {
+		labelWidth = Math.MaxInt(labelWidth, Draw.MeasureText(r, s.Name, bc.getLabelStyle(index, font)).Width())
+	}
+	labelWidth += DefaultBumpChartLabelMargin + DefaultLabelLeaderOffset
+
+	background := bc.Background
+	padLeft := background.Padding.GetLeft(DefaultBackgroundPadding.Left)
+	padRight := background.Padding.GetRight(DefaultBackgroundPadding.Right)
+	background.Padding = Box{
+		Top:    background.Padding.GetTop(DefaultBackgroundPadding.Top),
+		Left:   padLeft + labelWidth,
+		Right:  padRight + labelWidth,
+		Bottom: background.Padding.GetBottom(DefaultBackgroundPadding.Bottom),
+	}
+
+	ranks := bc.Ranks()
+	xrange := &ContinuousRange{}
+	xrange.Min, xrange.Max = Math.MinAndMax(bc.XValues...)
+	yrange := &ContinuousRange{Min: 1, Max: float64(Math.MaxInt(len(bc.Series), 2)), Descending: true}
+
+	yaxis := bc.YAxis
+	yaxis.Range = yrange
+	yaxis.Ticks = nil
+	for rank := 1; rank <= len(bc.Series); rank++ {
+		yaxis.Ticks = append(yaxis.Ticks, Tick{Value: float64(rank), Label: strconv.Itoa(rank)})
+	}
+	xaxis := bc.XAxis
+	if xaxis.Range == nil {
+		xaxis.Range = xrange
+	}
+
+	series := make([]Series, len(bc.Series))
+	for index, s := range bc.Series {
+		series[index] = bumpSeries{
+			Name:    s.Name,
+			Style:   s.Style,
+			XValues: bc.XValues,
+			YValues: ranks[index],
+		}
+	}
+
+	c := Chart{
+		Title:       bc.Title,
+		TitleStyle:  bc.TitleStyle,
+		Description: bc.Description,
+		Width:       bc.GetWidth(),
+		Height:      bc.GetHeight(),
+		DPI:         bc.DPI,
+		Background:  background,
+		Canvas:      bc.Canvas,
+		XAxis:       xaxis,
+		YAxis:       yaxis,
+		Font:        bc.Font,
+		Series:      series,
+	}
+	c.Elements = append([]Renderable{bc.labels(&c, yrange, ranks, font)}, bc.Elements...)
+	return c, nil
+}
+
+// labels returns a renderable that draws the name of each series next to its first and last rank.
+func (bc BumpChart) labels(c *Chart, yrange Range, ranks [][]float64, font *truetype.Font) Renderable {
+	return func(r Renderer, canvasBox Box, defaults Style) {
+		// the labels are placed next to the plot box, i.e. the canvas and the rank axis drawn beside it.
+		plotBox := canvasBox
+		if c.YAxis.Style.Show {
+			plotBox = plotBox.Grow(c.YAxis.Measure(r, canvasBox, yrange, Style{Font: font}.InheritFrom(c.styleDefaultsAxes()), c.YAxis.Ticks))
+		}
+		leftEdge := plotBox.Left - DefaultBumpChartLabelMargin
+		rightEdge := plotBox.Right + DefaultBumpChartLabelMargin
+		bounds := Box{Top: canvasBox.Top, Left: 0, Right: c.GetWidth(), Bottom: canvasBox.Bottom}
+
+		var left, right []LabelCandidate
+		var leftIndexes, rightIndexes []int
+		for index, s := range bc.Series {
+			style := bc.getLabelStyle(index, font)
+			tb := Draw.MeasureText(r, s.Name, style)
+			first, last := bumpFirstAndLast(ranks[index])
+			if first < 0 {
+				continue
+			}
+
+			y := canvasBox.Bottom - yrange.Translate(ranks[index][first])
+			left = append(left, LabelCandidate{
+				Anchor:   Point{X: leftEdge, Y: y},
+				Box:      Box{Top: y - (tb.Height() >> 1), Left: leftEdge - tb.Width(), Right: leftEdge, Bottom: y + (tb.Height() >> 1)},
+				Priority: len(bc.Series) - int(ranks[index][first]),
+			})
+			leftIndexes = append(leftIndexes, index)
+
+			y = canvasBox.Bottom - yrange.Translate(ranks[index][last])
+			right = append(right, LabelCandidate{
+				Anchor:   Point{X: rightEdge, Y: y},
+				Box:      Box{Top: y - (tb.Height() >> 1), Left: rightEdge, Right: rightEdge + tb.Width(), Bottom: y + (tb.Height() >> 1)},
+				Priority: len(bc.Series) - int(ranks[index][last]),
+			})
+			rightIndexes = append(rightIndexes, index)
+		}
+
+		for position, p := range PlaceLabelsWithSeed(bounds, left, bc.GetLabelOverlap(), bc.Seed) {
+			// nudged labels on the left are moved away from the canvas, i.e. to the left.
+			p.Box = p.Box.Shift(-2*p.Offset.X, 0)
+			p.Offset.X = -p.Offset.X
+			bc.drawLabel(r, bc.Series[leftIndexes[position]].Name, p, bc.getLabelStyle(leftIndexes[position], font))
+		}
+		for position, p := range PlaceLabelsWithSeed(bounds, right, bc.GetLabelOverlap(), bc.Seed) {
+			bc.drawLabel(r, bc.Series[rightIndexes[position]].Name, p, bc.getLabelStyle(rightIndexes[position], font))
+		}
+	}
+}
+
+func (bc BumpChart) drawLabel(r Renderer, label string, p LabelPlacement, style Style) {
+	if p.Hidden {
+		return
+	}
+	if p.HasLeader() {
+		style.GetStrokeOptions().WriteToRenderer(r)
+		r.MoveTo(p.Anchor.X, p.Anchor.Y)
+		if p.Offset.X < 0 {
+			r.LineTo(p.Box.Right, (p.Box.Top+p.Box.Bottom)>>1)
+		} else {
+			r.LineTo(p.Box.Left, (p.Box.Top+p.Box.Bottom)>>1)
+		}
+		r.Stroke()
+	}
+	Draw.Text(r, label, p.Box.Left, p.Box.Bottom, style)
+}
+
+func (bc BumpChart) getLabelStyle(index int, font *truetype.Font) Style {
+	color := bc.Series[index].Style.GetStrokeColor(GetDefaultColor(index))
+	return Style{
+		StrokeColor: color,
+		StrokeWidth: 1.0,
+		FontColor:   color,
+		FontSize:    DefaultFontSize,
+		Font:        font,
+	}
+}
+
+// bumpFirstAndLast returns the first and last time step with a rank, or -1 if there are none.
+func bumpFirstAndLast(ranks []float64) (first, last int) {
+	first, last = -1, -1
+	for step, rank := range ranks {
+		if math.IsNaN(rank) {
+			continue
+		}
+		if first < 0 {
+			first = step
+		}
+		last = step
+	}
+	return
+}
+
+// bumpSeries draws the ranks of a bump series as a smooth trajectory.
+type bumpSeries struct {
+	Name    string
+	Style   Style
+	XValues []float64
+	YValues []float64
+}
+
+func (bs bumpSeries) GetName() string {
+	return bs.Name
+}
+
+func (bs bumpSeries) GetStyle() Style {
+	return bs.Style
+}
+
+func (bs bumpSeries) GetYAxis() YAxisType {
+	return YAxisPrimary
+}
+
+func (bs bumpSeries) Len() int {
+	return len(bs.XValues)
+}
+
+func (bs bumpSeries) GetValue(index int) (float64, float64) {
+	return bs.XValues[index], bs.YValues[index]
+}
+
+func (bs bumpSeries) GetValueFormatters() (x, y ValueFormatter) {
+	return FloatValueFormatter, FloatValueFormatter
+}
+
+func (bs bumpSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
+	style := bs.Style.InheritFrom(Style{
+		StrokeColor: defaults.GetStrokeColor(),
+		StrokeWidth: DefaultBumpChartLineWidth,
+		FillColor:   defaults.GetStrokeColor(),
+	})
+
+	var points []Point
+	flush := func() {
+		if len(points) > 1 {
+			style.GetStrokeOptions().WriteToRenderer(r)
+			r.MoveTo(points[0].X, points[0].Y)
+			for index := 1; index < len(points); index++ {
+				// an s-curve from the previous point, made of two quad curves that meet halfway.
+				from, to := points[index-1], points[index]
+				mx, my := (from.X+to.X)>>1, (from.Y+to.Y)>>1
+				r.QuadCurveTo(mx, from.Y, mx, my)
+				r.QuadCurveTo(mx, to.Y, to.X, to.Y)
+			}
+			r.Stroke()
+		}
+		style.GetFillAndStrokeOptions().WriteToRenderer(r)
+		for _, p := range points {
+			r.Circle(DefaultBumpChartDotRadius, p.X, p.Y)
+		}
+		points = points[:0]
+	}
+
+	for index := range bs.XValues {
+		if math.IsNaN(bs.YValues[index]) {
+			flush()
+			continue
+		}
+		points = append(points, Point{
+			X: canvasBox.Left + xrange.Translate(bs.XValues[index]),
+			Y: canvasBox.Bottom - yrange.Translate(bs.YValues[index]),
+		})
+	}
+	flush()
+}
+
+func (bs bumpSeries) Validate() error {
+	if len(bs.XValues) != len(bs.YValues) {
+		return fmt.Errorf("bump series must have the same number of x and y values")
+	}
+	return nil
+}
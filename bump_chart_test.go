@@ -0,0 +1,129 @@
+package chart
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	assert "github.com/blendlabs/go-assert"
+)
+
+func TestBumpChartRanks(t *testing.T) {
+	assert := assert.New(t)
+
+	bc := BumpChart{
+		XValues: []float64{1, 2, 3},
+		Series: []BumpSeries{
+			{Name: "a", Values: []float64{10, 5, 1}},
+			{Name: "b", Values: []float64{20, 5, math.NaN()}},
+			{Name: "c", Values: []float64{15, 30, 2}},
+		},
+	}
+
+	ranks := bc.Ranks()
+	assert.Equal([]float64{3, 2, 2}, ranks[0])
+	assert.Equal(1.0, ranks[1][0])
+	assert.Equal(3.0, ranks[1][1], "ties are broken by the order of the series")
+	assert.True(math.IsNaN(ranks[1][2]))
+	assert.Equal([]float64{2, 1, 1}, ranks[2])
+
+	bc.Ascending = true
+	ranks = bc.Ranks()
+	assert.Equal([]float64{1, 1, 1}, ranks[0])
+}
+
+func TestBumpChartRender(t *testing.T) {
+	assert := assert.New(t)
+
+	bc := BumpChart{
+		Width:   400,
+		Height:  200,
+		XValues: []float64{1, 2, 3},
+		Series: []BumpSeries{
+			{Name: "a", Values: []float64{10, 5, 10}},
+			{Name: "b", Values: []float64{20, 6, 3}},
+		},
+	}
+
+	collector := &DrawTraceWriter{}
+	assert.Nil(bc.Render(Recording, collector))
+
+	var leftA, rightA DrawCall
+	for _, call := range collector.Trace().Calls {
+		if call.Op == DrawOpText && call.Text == "a" {
+			if leftA.Op == "" {
+				leftA = call
+			} else {
+				rightA = call
+			}
+		}
+	}
+	assert.True(leftA.X < rightA.X)
+	assert.True(leftA.Y > rightA.Y, "a is ranked last at the first step and first at the last step")
+
+	buffer := bytes.NewBuffer(nil)
+	assert.Nil(bc.Render(SVG, buffer))
+	assert.NotZero(buffer.Len())
+}
+
+func TestBumpChartRenderValidates(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.NotNil(BumpChart{}.Render(PNG, bytes.NewBuffer(nil)))
+	assert.NotNil(BumpChart{
+		XValues: []float64{1, 2},
+		Series:  []BumpSeries{{Name: "a", Values: []float64{1}}},
+	}.Render(PNG, bytes.NewBuffer(nil)))
+}
+
+func TestBumpChartLabelsBesidePlot(t *testing.T) {
+	assert := assert.New(t)
+
+	bc := BumpChart{
+		Width:   400,
+		Height:  200,
+		XAxis:   XAxis{Style: Style{Show: true, FontSize: 30}},
+		YAxis:   YAxis{Style: Style{Show: true, FontSize: 30}},
+		XValues: []float64{1, 2, 3},
+		Series: []BumpSeries{
+			{Name: "a", Values: []float64{10, 5, 10}},
+			{Name: "b", Values: []float64{20, 6, 3}},
+		},
+	}
+
+	collector := &DrawTraceWriter{}
+	assert.Nil(bc.Render(Recording, collector))
+
+	f, err := GetDefaultFont()
+	assert.Nil(err)
+	r, err := Recording(bc.GetWidth(), bc.GetHeight())
+	assert.Nil(err)
+	r.SetDPI(bc.GetDPI())
+	r.SetFont(f)
+
+	// the dots of the first time step are drawn on the left edge of the canvas.
+	plotLeft, axisRight := bc.GetWidth(), 0
+	var labels []DrawCall
+	for _, call := range collector.Trace().Calls {
+		switch {
+		case call.Op == DrawOpCircle:
+			plotLeft = Math.MinInt(plotLeft, call.X)
+		case call.Op == DrawOpText && (call.Text == "1" || call.Text == "2"):
+			r.SetFontSize(call.Style.FontSize)
+			axisRight = Math.MaxInt(axisRight, call.X+r.MeasureText(call.Text).Width())
+		case call.Op == DrawOpText && (call.Text == "a" || call.Text == "b"):
+			labels = append(labels, call)
+		}
+	}
+	assert.NotZero(axisRight)
+	assert.Len(labels, 4)
+	for _, label := range labels[:2] {
+		r.SetFontSize(label.Style.FontSize)
+		gap := plotLeft - (label.X + r.MeasureText(label.Text).Width())
+		assert.True(gap >= 0 && gap <= DefaultBumpChartLabelMargin+1, "the start labels are placed next to the canvas")
+	}
+	for _, label := range labels[2:] {
+		assert.True(label.X > axisRight, "the end labels don't overlap the rank axis")
+		assert.True(label.X <= axisRight+DefaultBumpChartLabelMargin, "the end labels are placed next to the rank axis")
+	}
+}
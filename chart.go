@@ -2,10 +2,12 @@ package chart
 
 import (
 	"errors"
+	"fmt"
 	"io"
 	"math"
 
 	"github.com/golang/freetype/truetype"
+	"github.com/wcharczuk/go-chart/drawing"
 )
 
 // Chart is what we're drawing.
@@ -13,6 +15,10 @@ type Chart struct {
 	Title      string
 	TitleStyle Style
 
+	// Description is a caption (or alt text) for the chart; it is embedded in the output
+	// where the format supports it, i.e. as the svg `<desc>` or a png text chunk.
+	Description string
+
 	Width  int
 	Height int
 	DPI    float64
@@ -20,15 +26,66 @@ type Chart struct {
 	Background Style
 	Canvas     Style
 
+	// CanvasBorder, if any side is shown, replaces the canvas stroke with per-side strokes,
+	// i.e. only the left and bottom sides for an open frame.
+	CanvasBorder Border
+
 	XAxis          XAxis
+	XAxisSecondary DerivedXAxis
 	YAxis          YAxis
 	YAxisSecondary YAxis
 
+	// AlignYAxisTicks draws the primary and secondary y axis ticks at the same positions, so the grid lines serve
+	// both axes; auto ranged y axes get nice bounds that split them into the same number of intervals.
+	AlignYAxisTicks bool
+
 	Font        *truetype.Font
 	defaultFont *truetype.Font
 
 	Series   []Series
 	Elements []Renderable
+	// seriesBelow is how many of the first series were put below the others by an overlay; they take their default
+	// colors after the others, so adding an overlay doesn't recolor the series of the chart.
+	seriesBelow int
+	// Legend, if set, draws a legend of the chart as it is rendered (i.e. `LegendThin`), after the elements.
+	Legend LegendFunc
+	// ColorScales are color bar legends (i.e. of a heatmap) the chart reserves space for, right of the canvas and
+	// its axes (or below them, if horizontal).
+	ColorScales []ColorScale
+
+	// ColorCycle controls the default colors of series that don't set their own stroke color.
+	ColorCycle ColorCycle
+
+	// LegendDerived is which of derived series (see `DerivedSeries`) and their inner series the legends show.
+	LegendDerived LegendDerived
+
+	// IsRTL lays the chart out for right-to-left languages; the primary y-axis is drawn on the left,
+	// the secondary y-axis on the right, and legends are anchored to the right.
+	IsRTL bool
+
+	// MinCanvasWidth and MinCanvasHeight are the smallest canvas the series are drawn in once the title, axes
+	// and annotations are measured; a smaller canvas fails the render with a `CanvasTooSmallError`. They are opt-in:
+	// a zero minimum skips the check on that side, so small charts (i.e. sparklines) render as they always have.
+	MinCanvasWidth  int
+	MinCanvasHeight int
+
+	// DropAxesWhenSmall hides the axes (and their ticks) and retries the layout before failing
+	// if the canvas would be smaller than the minimum; it needs `MinCanvasWidth` or `MinCanvasHeight` to be set.
+	DropAxesWhenSmall bool
+
+	// Seed seeds any randomized rendering of the series (see `SeededSeries`) so renders are reproducible;
+	// each series is seeded with the chart seed plus its index.
+	Seed int64
+
+	// Logger, if set, records the layout decisions of each render.
+	Logger Logger
+
+	// Info receives a description of the rendered ticks, ranges, series and legend entries after a render.
+	Info *RenderInfo
+
+	// Ranges receives the resolved ranges after a render.
+	// If it is already populated, the ranges are reused as is and the series are not scanned.
+	Ranges *ChartRanges
 }
 
 // GetDPI returns the dpi for the chart.
@@ -71,12 +128,18 @@ func (c Chart) Render(rp RendererProvider, w io.Writer) error {
 	if len(c.Series) == 0 {
 		return errors.New("Please provide at least one series")
 	}
-	c.YAxisSecondary.AxisType = YAxisSecondary
+	if err := c.validateColorScales(); err != nil {
+		return err
+	}
+	c.YAxis.AxisType, c.YAxisSecondary.AxisType = c.getYAxisTypes()
 
 	r, err := rp(c.GetWidth(), c.GetHeight())
 	if err != nil {
 		return err
 	}
+	if dr, isDescriptionRenderer := r.(DescriptionRenderer); isDescriptionRenderer && len(c.Description) > 0 {
+		dr.SetDescription(c.Description)
+	}
 
 	if c.Font == nil {
 		defaultFont, err := GetDefaultFont()
@@ -86,12 +149,18 @@ func (c Chart) Render(rp RendererProvider, w io.Writer) error {
 		c.defaultFont = defaultFont
 	}
 	r.SetDPI(c.GetDPI(DefaultDPI))
+	c.Series = c.getRenderSeries(r)
 
 	c.drawBackground(r)
 
 	var xt, yt, yta []Tick
+	reused := !c.Ranges.IsZero()
 	xr, yr, yra := c.getRanges()
-	canvasBox := c.getDefaultCanvasBox()
+	if c.Logger != nil {
+		c.log("chart ranges", "x", xr.String(), "y", yr.String(), "y_secondary", yra.String(), "reused", reused)
+	}
+	canvasBox := c.getTitleAdjustedCanvasBox(r, c.getColorScaleAdjustedCanvasBox(r, c.getDefaultCanvasBox()))
+	c.logCanvasBox("title", canvasBox)
 	xf, yf, yfa := c.getValueFormatters()
 	xr, yr, yra = c.setRangeDomains(canvasBox, xr, yr, yra)
 
@@ -104,25 +173,72 @@ func (c Chart) Render(rp RendererProvider, w io.Writer) error {
 
 	if c.hasAxes() {
 		xt, yt, yta = c.getAxesTicks(r, xr, yr, yra, xf, yf, yfa)
+		c.logTicks("axes", xt, yt, yta)
 		canvasBox = c.getAxesAdjustedCanvasBox(r, canvasBox, xr, yr, yra, xt, yt, yta)
+		c.logCanvasBox("axes", canvasBox)
 		xr, yr, yra = c.setRangeDomains(canvasBox, xr, yr, yra)
 
 		// do a second pass in case things haven't settled yet.
 		xt, yt, yta = c.getAxesTicks(r, xr, yr, yra, xf, yf, yfa)
+		c.logTicks("axes (second pass)", xt, yt, yta)
 		canvasBox = c.getAxesAdjustedCanvasBox(r, canvasBox, xr, yr, yra, xt, yt, yta)
+		c.logCanvasBox("axes (second pass)", canvasBox)
 		xr, yr, yra = c.setRangeDomains(canvasBox, xr, yr, yra)
 	}
 
 	if c.hasAnnotationSeries() {
-		canvasBox = c.getAnnotationAdjustedCanvasBox(r, canvasBox, xr, yr, yra, xf, yf, yfa)
+		canvasBox = c.getAnnotationAdjustedCanvasBox(r, canvasBox, xr, yr, yra, xt, yt, yta)
+		c.logCanvasBox("annotations", canvasBox)
 		xr, yr, yra = c.setRangeDomains(canvasBox, xr, yr, yra)
 		xt, yt, yta = c.getAxesTicks(r, xr, yr, yra, xf, yf, yfa)
+		c.logTicks("annotations", xt, yt, yta)
+
+		// the ticks may have changed, so make sure the new labels (i.e. the last x tick label) still fit.
+		if c.hasAxes() {
+			canvasBox = c.getAxesAdjustedCanvasBox(r, canvasBox, xr, yr, yra, xt, yt, yta)
+			c.logCanvasBox("annotations (axes)", canvasBox)
+			xr, yr, yra = c.setRangeDomains(canvasBox, xr, yr, yra)
+		}
+	}
+
+	err = c.checkCanvasBox(canvasBox)
+	if err != nil {
+		if c.DropAxesWhenSmall && c.hasAxes() {
+			c.log("chart dropping axes", "reason", err.Error())
+			c.XAxis.Style.Show = false
+			c.XAxisSecondary.Style.Show = false
+			c.YAxis.Style.Show = false
+			c.YAxisSecondary.Style.Show = false
+			return c.Render(rp, w)
+		}
+		r.Save(w)
+		return err
+	}
+
+	if c.Info != nil {
+		*c.Info = c.getRenderInfo(canvasBox, xr, yr, yra, xt, yt, yta, xf, yf, yfa, c.Info.IncludePoints)
 	}
 
 	c.drawCanvas(r, canvasBox)
 	c.drawAxes(r, canvasBox, xr, yr, yra, xt, yt, yta)
+	gr, isGroupRenderer := r.(GroupRenderer)
+	var seriesKeys []string
+	if isGroupRenderer {
+		seriesKeys = c.SeriesKeys()
+	}
+	marginOffset := c.getAnnotationMarginOffset(r, canvasBox, xr, yr, yra, xt, yt, yta)
 	for index, series := range c.Series {
+		if isGroupRenderer {
+			gr.StartGroup(seriesKeys[index])
+		}
+		if as, isAnnotationSeries := series.(AnnotationSeries); isAnnotationSeries {
+			as.marginOffset = marginOffset
+			series = as
+		}
 		c.drawSeries(r, canvasBox, xr, yr, yra, series, index)
+		if isGroupRenderer {
+			gr.EndGroup()
+		}
 	}
 
 	c.drawTitle(r)
@@ -130,10 +246,41 @@ func (c Chart) Render(rp RendererProvider, w io.Writer) error {
 	for _, a := range c.Elements {
 		a(r, canvasBox, c.styleDefaultsElements())
 	}
+	c.drawColorScales(r, canvasBox)
+	if c.Legend != nil {
+		c.Legend(&c)(r, canvasBox, c.styleDefaultsElements())
+	}
 
 	return r.Save(w)
 }
 
+// getYAxisTypes resolves the y-axis sides; the axis type decides which side an axis is drawn on,
+// with `YAxisPrimary` on the right and `YAxisSecondary` on the left.
+func (c Chart) getYAxisTypes() (primary, secondary YAxisType) {
+	primarySide := c.YAxis.Side
+	if primarySide == YAxisSideUnset {
+		if c.YAxisSecondary.Side != YAxisSideUnset {
+			primarySide = c.YAxisSecondary.Side.Opposite()
+		} else if c.IsRTL || c.YAxis.AxisType == YAxisSecondary {
+			primarySide = YAxisSideLeft
+		} else {
+			primarySide = YAxisSideRight
+		}
+	}
+	secondarySide := c.YAxisSecondary.Side
+	if secondarySide == YAxisSideUnset {
+		secondarySide = primarySide.Opposite()
+	}
+	return yAxisTypeForSide(primarySide), yAxisTypeForSide(secondarySide)
+}
+
+func yAxisTypeForSide(side YAxisSide) YAxisType {
+	if side == YAxisSideLeft {
+		return YAxisSecondary
+	}
+	return YAxisPrimary
+}
+
 func (c Chart) validateSeries() error {
 	var err error
 	for _, s := range c.Series {
@@ -145,7 +292,20 @@ func (c Chart) validateSeries() error {
 	return nil
 }
 
+func (c Chart) validateColorScales() error {
+	for _, cs := range c.ColorScales {
+		if err := cs.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (c Chart) getRanges() (xrange, yrange, yrangeAlt Range) {
+	if x, y, ySecondary, ok := c.Ranges.get(); ok {
+		return x, y, ySecondary
+	}
+
 	var minx, maxx float64 = math.MaxFloat64, -math.MaxFloat64
 	var miny, maxy float64 = math.MaxFloat64, -math.MaxFloat64
 	var minya, maxya float64 = math.MaxFloat64, -math.MaxFloat64
@@ -156,6 +316,9 @@ func (c Chart) getRanges() (xrange, yrange, yrangeAlt Range) {
 	// all axis are represented by either custom ticks or custom ranges.
 	for _, s := range c.Series {
 		if s.GetStyle().IsZero() || s.GetStyle().Show {
+			if _, isXRangeValueProvider := s.(XRangeValueProvider); isXRangeValueProvider {
+				continue // these span the x range, so they're scanned once it is resolved.
+			}
 			seriesAxis := s.GetYAxis()
 			if bvp, isBoundedValueProvider := s.(BoundedValueProvider); isBoundedValueProvider {
 				seriesLength := bvp.Len()
@@ -226,10 +389,38 @@ func (c Chart) getRanges() (xrange, yrange, yrangeAlt Range) {
 		xrange.SetMin(tickMin)
 		xrange.SetMax(tickMax)
 	} else if xrange.IsZero() {
+		if c.XAxis.RangeFunc != nil {
+			minx, maxx = c.XAxis.RangeFunc(minx, maxx)
+		}
 		xrange.SetMin(minx)
 		xrange.SetMax(maxx)
 	}
 
+	for _, s := range c.Series {
+		if s.GetStyle().IsZero() || s.GetStyle().Show {
+			if xrvp, isXRangeValueProvider := s.(XRangeValueProvider); isXRangeValueProvider {
+				vp := xrvp.WithXRange(xrange)
+				seriesLength := vp.Len()
+				for index := 0; index < seriesLength; index++ {
+					_, vy := vp.GetValue(index)
+					if s.GetYAxis() == YAxisPrimary {
+						miny = math.Min(miny, vy)
+						maxy = math.Max(maxy, vy)
+					} else if s.GetYAxis() == YAxisSecondary {
+						minya = math.Min(minya, vy)
+						maxya = math.Max(maxya, vy)
+						seriesMappedToSecondaryAxis = true
+					}
+				}
+			}
+		}
+	}
+
+	ycr, isYContinuous := yrange.(*ContinuousRange)
+	ycra, isYAltContinuous := yrangeAlt.(*ContinuousRange)
+	alignY := c.AlignYAxisTicks && seriesMappedToSecondaryAxis && isYContinuous && isYAltContinuous &&
+		len(c.YAxis.Ticks) == 0 && len(c.YAxisSecondary.Ticks) == 0 && yrange.IsZero() && yrangeAlt.IsZero()
+
 	if len(c.YAxis.Ticks) > 0 {
 		tickMin, tickMax := math.MaxFloat64, -math.MaxFloat64
 		for _, t := range c.YAxis.Ticks {
@@ -238,6 +429,10 @@ func (c Chart) getRanges() (xrange, yrange, yrangeAlt Range) {
 		}
 		yrange.SetMin(tickMin)
 		yrange.SetMax(tickMax)
+	} else if yrange.IsZero() && c.YAxis.RangeFunc != nil {
+		miny, maxy = c.YAxis.RangeFunc(miny, maxy)
+		yrange.SetMin(miny)
+		yrange.SetMax(maxy)
 	} else if yrange.IsZero() {
 		yrange.SetMin(miny)
 		yrange.SetMax(maxy)
@@ -257,6 +452,10 @@ func (c Chart) getRanges() (xrange, yrange, yrangeAlt Range) {
 		}
 		yrangeAlt.SetMin(tickMin)
 		yrangeAlt.SetMax(tickMax)
+	} else if seriesMappedToSecondaryAxis && yrangeAlt.IsZero() && c.YAxisSecondary.RangeFunc != nil {
+		minya, maxya = c.YAxisSecondary.RangeFunc(minya, maxya)
+		yrangeAlt.SetMin(minya)
+		yrangeAlt.SetMax(maxya)
 	} else if seriesMappedToSecondaryAxis && yrangeAlt.IsZero() {
 		yrangeAlt.SetMin(minya)
 		yrangeAlt.SetMax(maxya)
@@ -268,6 +467,16 @@ func (c Chart) getRanges() (xrange, yrange, yrangeAlt Range) {
 		yrangeAlt.SetMax(rmax)
 	}
 
+	if alignY {
+		// align from the data (or range func) bounds rather than the rounded ones.
+		ycr.SetMin(miny)
+		ycr.SetMax(maxy)
+		ycra.SetMin(minya)
+		ycra.SetMax(maxya)
+		yrange, yrangeAlt = alignYRanges(ycr, ycra, c.getAlignedYAxisIntervals())
+	}
+
+	c.Ranges.publish(xrange, yrange, yrangeAlt)
 	return
 }
 
@@ -287,10 +496,53 @@ func (c Chart) checkRanges(xr, yr, yra Range) error {
 	return nil
 }
 
+// checkCanvasBox makes sure the measured decorations left the minimum canvas size, if one is set.
+func (c Chart) checkCanvasBox(canvasBox Box) error {
+	tooNarrow := c.MinCanvasWidth > 0 && canvasBox.Right-canvasBox.Left < c.MinCanvasWidth
+	tooShort := c.MinCanvasHeight > 0 && canvasBox.Bottom-canvasBox.Top < c.MinCanvasHeight
+	if tooNarrow || tooShort {
+		return CanvasTooSmallError{
+			Canvas:    canvasBox,
+			MinWidth:  c.MinCanvasWidth,
+			MinHeight: c.MinCanvasHeight,
+		}
+	}
+	return nil
+}
+
 func (c Chart) getDefaultCanvasBox() Box {
 	return c.Box()
 }
 
+// getTitleAdjustedCanvasBox pushes the top of the canvas below the title, if shown.
+func (c Chart) getTitleAdjustedCanvasBox(r Renderer, canvasBox Box) Box {
+	if len(c.Title) == 0 || !c.TitleStyle.Show {
+		return canvasBox
+	}
+	textBox := Draw.MeasureText(r, c.Title, c.getTitleStyle())
+	titleBottom := c.TitleStyle.Padding.GetTop(DefaultTitleTop) + textBox.Height() + c.TitleStyle.Padding.GetBottom(DefaultTitleBottom)
+	canvasBox.Top = Math.MaxInt(canvasBox.Top, titleBottom)
+	return canvasBox
+}
+
+// getLayoutBox returns the box the canvas and its axes are laid out within.
+func (c Chart) getLayoutBox(r Renderer) Box {
+	return c.getTitleAdjustedCanvasBox(r, c.getColorScaleAdjustedCanvasBox(r, c.Box()))
+}
+
+// getColorScaleAdjustedCanvasBox reserves the space the color scales take up right of (and below) the canvas.
+func (c Chart) getColorScaleAdjustedCanvasBox(r Renderer, canvasBox Box) Box {
+	width, height := canvasBox.Width(), canvasBox.Height()
+	for _, cs := range c.ColorScales {
+		if cs.Horizontal {
+			canvasBox.Bottom -= cs.Measure(r, width, c.styleDefaultsElements())
+		} else {
+			canvasBox.Right -= cs.Measure(r, height, c.styleDefaultsElements())
+		}
+	}
+	return canvasBox
+}
+
 func (c Chart) getValueFormatters() (x, y, ya ValueFormatter) {
 	for _, s := range c.Series {
 		if vfp, isVfp := s.(ValueFormatterProvider); isVfp {
@@ -317,7 +569,7 @@ func (c Chart) getValueFormatters() (x, y, ya ValueFormatter) {
 }
 
 func (c Chart) hasAxes() bool {
-	return c.XAxis.Style.Show || c.YAxis.Style.Show || c.YAxisSecondary.Style.Show
+	return c.XAxis.Style.Show || c.XAxisSecondary.Style.Show || c.YAxis.Style.Show || c.YAxisSecondary.Style.Show
 }
 
 func (c Chart) getAxesTicks(r Renderer, xr, yr, yar Range, xf, yf, yfa ValueFormatter) (xticks, yticks, yticksAlt []Tick) {
@@ -334,11 +586,21 @@ func (c Chart) getAxesTicks(r Renderer, xr, yr, yar Range, xf, yf, yfa ValueForm
 }
 
 func (c Chart) getAxesAdjustedCanvasBox(r Renderer, canvasBox Box, xr, yr, yra Range, xticks, yticks, yticksAlt []Tick) Box {
+	return canvasBox.OuterConstrain(c.getLayoutBox(r), c.getAxesOuterBox(r, canvasBox, xr, yr, yra, xticks, yticks, yticksAlt))
+}
+
+// getAxesOuterBox returns the box around the canvas and the axes drawn around it.
+func (c Chart) getAxesOuterBox(r Renderer, canvasBox Box, xr, yr, yra Range, xticks, yticks, yticksAlt []Tick) Box {
 	axesOuterBox := canvasBox.Clone()
 	if c.XAxis.Style.Show {
 		axesBounds := c.XAxis.Measure(r, canvasBox, xr, c.styleDefaultsAxes(), xticks)
 		axesOuterBox = axesOuterBox.Grow(axesBounds)
 	}
+	if c.XAxisSecondary.Style.Show {
+		xticksDerived := c.XAxisSecondary.GetTicks(r, xr, c.styleDefaultsAxes(), xticks)
+		axesBounds := c.XAxisSecondary.Measure(r, canvasBox, xr, c.styleDefaultsAxes(), xticksDerived)
+		axesOuterBox = axesOuterBox.Grow(axesBounds)
+	}
 	if c.YAxis.Style.Show {
 		axesBounds := c.YAxis.Measure(r, canvasBox, yr, c.styleDefaultsAxes(), yticks)
 		axesOuterBox = axesOuterBox.Grow(axesBounds)
@@ -347,8 +609,15 @@ func (c Chart) getAxesAdjustedCanvasBox(r Renderer, canvasBox Box, xr, yr, yra R
 		axesBounds := c.YAxisSecondary.Measure(r, canvasBox, yra, c.styleDefaultsAxes(), yticksAlt)
 		axesOuterBox = axesOuterBox.Grow(axesBounds)
 	}
+	return axesOuterBox
+}
 
-	return canvasBox.OuterConstrain(c.Box(), axesOuterBox)
+// getAnnotationMarginOffset returns how far right of the canvas the axes reach, for annotations with margin labels.
+func (c Chart) getAnnotationMarginOffset(r Renderer, canvasBox Box, xr, yr, yra Range, xticks, yticks, yticksAlt []Tick) int {
+	if !c.hasAxes() {
+		return 0
+	}
+	return c.getAxesOuterBox(r, canvasBox, xr, yr, yra, xticks, yticks, yticksAlt).Right - canvasBox.Right
 }
 
 func (c Chart) setRangeDomains(canvasBox Box, xr, yr, yra Range) (Range, Range, Range) {
@@ -378,11 +647,13 @@ func (c Chart) hasSecondarySeries() bool {
 	return false
 }
 
-func (c Chart) getAnnotationAdjustedCanvasBox(r Renderer, canvasBox Box, xr, yr, yra Range, xf, yf, yfa ValueFormatter) Box {
+func (c Chart) getAnnotationAdjustedCanvasBox(r Renderer, canvasBox Box, xr, yr, yra Range, xt, yt, yta []Tick) Box {
 	annotationSeriesBox := canvasBox.Clone()
+	marginOffset := c.getAnnotationMarginOffset(r, canvasBox, xr, yr, yra, xt, yt, yta)
 	for seriesIndex, s := range c.Series {
 		if as, isAnnotationSeries := s.(AnnotationSeries); isAnnotationSeries {
 			if as.Style.IsZero() || as.Style.Show {
+				as.marginOffset = marginOffset
 				style := c.styleDefaultsSeries(seriesIndex)
 				var annotationBounds Box
 				if as.YAxis == YAxisPrimary {
@@ -396,7 +667,7 @@ func (c Chart) getAnnotationAdjustedCanvasBox(r Renderer, canvasBox Box, xr, yr,
 		}
 	}
 
-	return canvasBox.OuterConstrain(c.Box(), annotationSeriesBox)
+	return canvasBox.OuterConstrain(c.getLayoutBox(r), annotationSeriesBox)
 }
 
 func (c Chart) getBackgroundStyle() Style {
@@ -415,21 +686,108 @@ func (c Chart) getCanvasStyle() Style {
 }
 
 func (c Chart) drawCanvas(r Renderer, canvasBox Box) {
-	Draw.Box(r, canvasBox, c.getCanvasStyle())
+	canvasStyle := c.getCanvasStyle()
+	if c.CanvasBorder.IsZero() {
+		Draw.Box(r, canvasBox, canvasStyle)
+		return
+	}
+	canvasStyle.GetFillOptions().WriteToRenderer(r)
+	r.MoveTo(canvasBox.Left, canvasBox.Top)
+	r.LineTo(canvasBox.Right, canvasBox.Top)
+	r.LineTo(canvasBox.Right, canvasBox.Bottom)
+	r.LineTo(canvasBox.Left, canvasBox.Bottom)
+	r.Close()
+	r.Fill()
+	r.ResetStyle()
+	Draw.BoxBorder(r, canvasBox, c.CanvasBorder, canvasStyle)
+}
+
+// drawColorScales draws the color scales in the space reserved for them, in order outward from the canvas.
+func (c Chart) drawColorScales(r Renderer, canvasBox Box) {
+	box := c.Box()
+	reserved := c.getColorScaleAdjustedCanvasBox(r, box)
+	right, bottom := reserved.Right, reserved.Bottom
+	for _, cs := range c.ColorScales {
+		if cs.Horizontal {
+			cs.Render(r, canvasBox, bottom, c.styleDefaultsElements())
+			bottom += cs.Measure(r, box.Width(), c.styleDefaultsElements())
+		} else {
+			cs.Render(r, canvasBox, right, c.styleDefaultsElements())
+			right += cs.Measure(r, box.Height(), c.styleDefaultsElements())
+		}
+	}
 }
 
 func (c Chart) drawAxes(r Renderer, canvasBox Box, xrange, yrange, yrangeAlt Range, xticks, yticks, yticksAlt []Tick) {
 	if c.XAxis.Style.Show {
 		c.XAxis.Render(r, canvasBox, xrange, c.styleDefaultsAxes(), xticks)
 	}
+	if c.XAxisSecondary.Style.Show {
+		xticksDerived := c.XAxisSecondary.GetTicks(r, xrange, c.styleDefaultsAxes(), xticks)
+		c.XAxisSecondary.Render(r, canvasBox, xrange, c.styleDefaultsAxes(), xticksDerived)
+	}
 	if c.YAxis.Style.Show {
-		c.YAxis.Render(r, canvasBox, yrange, c.styleDefaultsAxes(), yticks)
+		c.withYAxisNameHint(c.YAxis, YAxisPrimary).Render(r, canvasBox, yrange, c.styleDefaultsAxes(), yticks)
 	}
 	if c.YAxisSecondary.Style.Show {
-		c.YAxisSecondary.Render(r, canvasBox, yrangeAlt, c.styleDefaultsAxes(), yticksAlt)
+		c.withYAxisNameHint(c.YAxisSecondary, YAxisSecondary).Render(r, canvasBox, yrangeAlt, c.styleDefaultsAxes(), yticksAlt)
 	}
 }
 
+// withYAxisNameHint colors the name of a y-axis like the first visible series mapped to it, if the axis asks for it.
+func (c Chart) withYAxisNameHint(ya YAxis, axisType YAxisType) YAxis {
+	if !ya.NameColorHint || !ya.NameStyle.FontColor.IsZero() {
+		return ya
+	}
+	for index, s := range c.Series {
+		if s.GetYAxis() != axisType || (!s.GetStyle().IsZero() && !s.GetStyle().Show) {
+			continue
+		}
+		if _, isAnnotationSeries := s.(AnnotationSeries); isAnnotationSeries {
+			continue
+		}
+		ya.NameStyle.FontColor = s.GetStyle().InheritFrom(c.styleDefaultsSeries(index)).GetStrokeColor()
+		return ya
+	}
+	return ya
+}
+
+// getSeededSeries returns the series with the chart seed given to each series with randomized rendering, so the
+// ranges, the render info and the drawn values all see the same values.
+func (c Chart) getSeededSeries(series []Series) []Series {
+	output := make([]Series, len(series))
+	for index, s := range series {
+		output[index] = c.seededSeries(s, index)
+	}
+	return output
+}
+
+// getRenderSeries returns the series as a render draws them; laid out, seeded and with their derived values cached.
+func (c Chart) getRenderSeries(r Renderer) []Series {
+	return c.getCachedSeries(c.getSeededSeries(c.getLayoutSeries(getLayout(r, Box{Right: c.GetWidth(), Bottom: c.GetHeight()}))))
+}
+
+// getCachedSeries returns the series with their costly derived values computed once, rather than on each access
+// while the ranges are measured and the series are drawn.
+func (c Chart) getCachedSeries(series []Series) []Series {
+	output := make([]Series, len(series))
+	for index, s := range series {
+		if cs, isCachedSeries := s.(cachedSeries); isCachedSeries {
+			s = cs.withCachedValues()
+		}
+		output[index] = s
+	}
+	return output
+}
+
+// seededSeries gives a series with randomized rendering the chart seed, varied by the series index.
+func (c Chart) seededSeries(s Series, seriesIndex int) Series {
+	if ss, isSeededSeries := s.(SeededSeries); isSeededSeries && c.Seed != 0 {
+		return ss.WithSeed(c.Seed + int64(seriesIndex))
+	}
+	return s
+}
+
 func (c Chart) drawSeries(r Renderer, canvasBox Box, xrange, yrange, yrangeAlt Range, s Series, seriesIndex int) {
 	if s.GetStyle().IsZero() || s.GetStyle().Show {
 		if s.GetYAxis() == YAxisPrimary {
@@ -442,10 +800,8 @@ func (c Chart) drawSeries(r Renderer, canvasBox Box, xrange, yrange, yrangeAlt R
 
 func (c Chart) drawTitle(r Renderer) {
 	if len(c.Title) > 0 && c.TitleStyle.Show {
-		r.SetFont(c.TitleStyle.GetFont(c.GetFont()))
-		r.SetFontColor(c.TitleStyle.GetFontColor(DefaultTextColor))
-		titleFontSize := c.TitleStyle.GetFontSize(DefaultTitleFontSize)
-		r.SetFontSize(titleFontSize)
+		c.getTitleStyle().WriteToRenderer(r)
+		defer r.ResetStyle()
 
 		textBox := r.MeasureText(c.Title)
 
@@ -459,6 +815,14 @@ func (c Chart) drawTitle(r Renderer) {
 	}
 }
 
+func (c Chart) getTitleStyle() Style {
+	return Style{
+		Font:      c.TitleStyle.GetFont(c.GetFont()),
+		FontColor: c.TitleStyle.GetFontColor(DefaultTextColor),
+		FontSize:  c.TitleStyle.GetFontSize(DefaultTitleFontSize),
+	}
+}
+
 func (c Chart) styleDefaultsBackground() Style {
 	return Style{
 		FillColor:   DefaultBackgroundColor,
@@ -476,7 +840,20 @@ func (c Chart) styleDefaultsCanvas() Style {
 }
 
 func (c Chart) styleDefaultsSeries(seriesIndex int) Style {
-	strokeColor := GetDefaultColor(seriesIndex)
+	var used []drawing.Color
+	cycleIndex := c.getPaletteIndex(seriesIndex)
+	if c.ColorCycle.SkipUsed {
+		// the series that set their own color don't take a color from the (filtered) palette.
+		cycleIndex = 0
+		for index, s := range c.Series {
+			if sc := s.GetStyle().StrokeColor; !sc.IsZero() {
+				used = append(used, sc)
+			} else if c.getPaletteIndex(index) < c.getPaletteIndex(seriesIndex) {
+				cycleIndex++
+			}
+		}
+	}
+	strokeColor := c.ColorCycle.GetColor(cycleIndex, used...)
 	return Style{
 		StrokeColor: strokeColor,
 		StrokeWidth: DefaultSeriesLineWidth,
@@ -485,6 +862,16 @@ func (c Chart) styleDefaultsSeries(seriesIndex int) Style {
 	}
 }
 
+// getPaletteIndex returns the order a series takes its default color in; the series an overlay put below the others
+// come after them.
+func (c Chart) getPaletteIndex(seriesIndex int) int {
+	below := Math.MinInt(c.seriesBelow, len(c.Series))
+	if seriesIndex < below {
+		return len(c.Series) - below + seriesIndex
+	}
+	return seriesIndex - below
+}
+
 func (c Chart) styleDefaultsAxes() Style {
 	return Style{
 		Font:        c.GetFont(),
@@ -513,3 +900,17 @@ func (c Chart) Box() Box {
 		Bottom: c.GetHeight() - dpb,
 	}
 }
+
+// CanvasTooSmallError is returned by `Render` when the title, axes and annotations leave
+// a canvas smaller than the chart's minimum canvas size.
+type CanvasTooSmallError struct {
+	Canvas    Box
+	MinWidth  int
+	MinHeight int
+}
+
+// Error implements `error`.
+func (e CanvasTooSmallError) Error() string {
+	return fmt.Sprintf("Canvas is too small after measuring the axes and annotations: %dx%d, the minimum is %dx%d; increase the chart size or reduce the decorations",
+		e.Canvas.Right-e.Canvas.Left, e.Canvas.Bottom-e.Canvas.Top, e.MinWidth, e.MinHeight)
+}
@@ -2,7 +2,10 @@ package chart
 
 import (
 	"bytes"
+	"fmt"
 	"math"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -186,6 +189,125 @@ func TestChartGetRangesUseUserRanges(t *testing.T) {
 	assert.True(yar.IsZero(), yar.String())
 }
 
+func TestChartGetRangesUseRangeFuncs(t *testing.T) {
+	assert := assert.New(t)
+
+	c := Chart{
+		XAxis: XAxis{
+			RangeFunc: RangeAtLeast(-10, 10),
+		},
+		YAxis: YAxis{
+			RangeFunc: RangePowerOfTwo,
+		},
+		YAxisSecondary: YAxis{
+			RangeFunc: RangePadded(0.1),
+		},
+		Series: []Series{
+			ContinuousSeries{
+				XValues: []float64{-2.0, -1.0, 0, 1.0, 2.0},
+				YValues: []float64{1.0, 2.0, 3.0, 4.0, 4.5},
+			},
+			ContinuousSeries{
+				YAxis:   YAxisSecondary,
+				XValues: []float64{-2.0, 2.0},
+				YValues: []float64{10.0, 20.0},
+			},
+		},
+	}
+
+	xr, yr, yar := c.getRanges()
+	assert.Equal(-10.0, xr.GetMin())
+	assert.Equal(10.0, xr.GetMax())
+	assert.Equal(1.0, yr.GetMin())
+	assert.Equal(8.0, yr.GetMax())
+	assert.Equal(10.0, yar.GetMin())
+	assert.Equal(21.0, yar.GetMax())
+
+	c.YAxis.Range = &ContinuousRange{Min: -5.0, Max: 5.0}
+	_, yr, _ = c.getRanges()
+	assert.Equal(5.0, yr.GetMax())
+}
+
+func TestChartAlignYAxisTicks(t *testing.T) {
+	assert := assert.New(t)
+
+	c := Chart{
+		AlignYAxisTicks: true,
+		YAxis:           YAxis{Style: StyleShow()},
+		YAxisSecondary:  YAxis{Style: StyleShow()},
+		Series: []Series{
+			ContinuousSeries{
+				XValues: []float64{1, 2, 3, 4, 5},
+				YValues: []float64{13, 47, 22, 81, 64},
+			},
+			ContinuousSeries{
+				YAxis:   YAxisSecondary,
+				XValues: []float64{1, 2, 3, 4, 5},
+				YValues: []float64{0.31, 0.12, 0.55, 0.43, 0.6},
+			},
+		},
+	}
+
+	_, yr, yra := c.getRanges()
+	assert.True(yr.GetMin() <= 13 && yr.GetMax() >= 81)
+	assert.True(yra.GetMin() <= 0.12 && yra.GetMax() >= 0.6)
+
+	r, err := PNG(c.GetWidth(), c.GetHeight())
+	assert.Nil(err)
+	yr.SetDomain(300)
+	yra.SetDomain(300)
+	_, yt, yta := c.getAxesTicks(r, &ContinuousRange{}, yr, yra, FloatValueFormatter, FloatValueFormatter, FloatValueFormatter)
+	assert.True(len(yt) > 2)
+	assert.Len(yta, len(yt))
+	for index := range yt {
+		assert.Equal(yr.Translate(yt[index].Value), yra.Translate(yta[index].Value))
+	}
+
+	c.AlignYAxisTicks = false
+	_, yr, _ = c.getRanges()
+	_, isAligned := yr.(TicksProvider)
+	assert.False(isAligned)
+
+	var buffer bytes.Buffer
+	c.AlignYAxisTicks = true
+	assert.Nil(c.Render(PNG, &buffer))
+}
+
+func TestAlignedBounds(t *testing.T) {
+	assert := assert.New(t)
+
+	low, high := alignedBounds(13, 81, 10)
+	assert.Equal(10.0, low)
+	assert.Equal(110.0, high)
+
+	low, high = alignedBounds(0.12, 0.6, 10)
+	assert.InDelta(0.1, low, 1e-9)
+	assert.InDelta(0.6, high, 1e-9)
+
+	low, high = alignedBounds(-3, 7, 5)
+	assert.Equal(-5.0, low)
+	assert.Equal(7.5, high)
+
+	low, high = alignedBounds(0, math.Inf(1), 5)
+	assert.Equal(0.0, low)
+	assert.True(math.IsInf(high, 1))
+}
+
+func TestChartAlignYAxisTicksInfinite(t *testing.T) {
+	assert := assert.New(t)
+
+	c := Chart{
+		AlignYAxisTicks: true,
+		YAxis:           YAxis{Style: StyleShow()},
+		YAxisSecondary:  YAxis{Style: StyleShow()},
+		Series: []Series{
+			ContinuousSeries{XValues: []float64{1, 2, 3}, YValues: []float64{1, math.Inf(1), 3}},
+			ContinuousSeries{YAxis: YAxisSecondary, XValues: []float64{1, 2, 3}, YValues: []float64{0.1, 0.2, 0.3}},
+		},
+	}
+	assert.NotNil(c.Render(PNG, bytes.NewBuffer([]byte{})))
+}
+
 func TestChartGetBackgroundStyle(t *testing.T) {
 	assert := assert.New(t)
 
@@ -424,3 +546,315 @@ func TestChartValidatesSeries(t *testing.T) {
 	assert.NotNil(c.validateSeries())
 
 }
+
+func TestChartGetRangesCached(t *testing.T) {
+	assert := assert.New(t)
+
+	ranges := &ChartRanges{}
+	assert.True(ranges.IsZero())
+
+	c := Chart{
+		Ranges: ranges,
+		Series: []Series{
+			ContinuousSeries{
+				XValues: []float64{-2.0, -1.0, 0, 1.0, 2.0},
+				YValues: []float64{1.0, 2.0, 3.0, 4.0, 4.5},
+			},
+		},
+	}
+
+	xr, yr, _ := c.getRanges()
+	assert.False(ranges.IsZero())
+	assert.Equal(-2.0, ranges.X.GetMin())
+	assert.Equal(2.0, ranges.X.GetMax())
+	assert.Equal(yr.GetMax(), ranges.Y.GetMax())
+
+	// the series are not scanned again if the ranges are set.
+	c.Series = []Series{
+		ContinuousSeries{
+			XValues: []float64{-20.0, 20.0},
+			YValues: []float64{-10.0, 10.0},
+		},
+	}
+	xr2, _, _ := c.getRanges()
+	assert.Equal(xr.GetMin(), xr2.GetMin())
+	assert.Equal(xr.GetMax(), xr2.GetMax())
+
+	pngBuffer := bytes.NewBuffer([]byte{})
+	assert.Nil(c.Render(PNG, pngBuffer))
+	svgBuffer := bytes.NewBuffer([]byte{})
+	assert.Nil(c.Render(SVG, svgBuffer))
+	assert.NotEmpty(svgBuffer.Bytes())
+
+	// each render sets the domains of its own copies of the cached ranges.
+	cached := ranges.X.GetDomain()
+	c.Width = 300
+	assert.Nil(c.Render(PNG, bytes.NewBuffer([]byte{})))
+	assert.Equal(cached, ranges.X.GetDomain())
+	assert.True(xr2 != ranges.X)
+}
+
+func TestChartRTL(t *testing.T) {
+	assert := assert.New(t)
+
+	yTickX := func(isRTL bool) int {
+		c := Chart{
+			Width:  1024,
+			Height: 400,
+			IsRTL:  isRTL,
+			YAxis: YAxis{
+				Style: StyleShow(),
+			},
+			Series: []Series{
+				ContinuousSeries{
+					XValues: []float64{1.0, 2.0, 3.0},
+					YValues: []float64{1.0, 2.0, 3.0},
+				},
+			},
+		}
+
+		buffer := bytes.NewBuffer([]byte{})
+		assert.Nil(c.Render(SVG, buffer))
+
+		svg := buffer.String()
+		index := strings.Index(svg, ">3.00</text>")
+		assert.True(index > 0)
+		start := strings.LastIndex(svg[:index], `<text x="`) + len(`<text x="`)
+		x, err := strconv.Atoi(svg[start : start+strings.Index(svg[start:], `"`)])
+		assert.Nil(err)
+		return x
+	}
+
+	assert.True(yTickX(false) > 512)
+	assert.True(yTickX(true) < 512)
+}
+
+func TestChartTitleAdjustsCanvas(t *testing.T) {
+	assert := assert.New(t)
+
+	c := Chart{
+		Title:      "A Tall Title",
+		TitleStyle: Style{Show: true, FontSize: 28.0},
+		Background: Style{Padding: Box{Top: 5, Left: 5, Right: 5, Bottom: 5}},
+		YAxis:      YAxis{Style: StyleShow()},
+		Series: []Series{
+			ContinuousSeries{XValues: []float64{1, 2, 3}, YValues: []float64{1, 2, 3}},
+		},
+	}
+
+	collector := &DrawTraceWriter{}
+	assert.Nil(c.Render(Recording, collector))
+	trace := collector.Trace()
+
+	var title DrawCall
+	var tickTop = math.MaxInt32
+	for _, call := range trace.Calls {
+		if call.Op != DrawOpText {
+			continue
+		}
+		if call.Text == c.Title {
+			title = call
+			continue
+		}
+		// y tick labels are drawn with their baseline at the bottom of the text.
+		tickTop = Math.MinInt(tickTop, call.Y-int(drawing.PointsToPixels(DefaultDPI, DefaultAxisFontSize)))
+	}
+	assert.Equal(c.Title, title.Text)
+	assert.True(tickTop > title.Y, "the top y tick label should be below the title")
+
+	c.TitleStyle.Show = false
+	r, err := PNG(DefaultChartWidth, DefaultChartHeight)
+	assert.Nil(err)
+	assert.Equal(5, c.getTitleAdjustedCanvasBox(r, c.getDefaultCanvasBox()).Top)
+}
+
+func TestChartLastXTickLabelFitsWithAnnotations(t *testing.T) {
+	assert := assert.New(t)
+
+	c := Chart{
+		Width:      300,
+		Height:     200,
+		Background: Style{Padding: Box{Top: 5, Left: 5, Right: 5, Bottom: 5}},
+		XAxis:      XAxis{Style: StyleShow(), ValueFormatter: func(v interface{}) string { return fmt.Sprintf("%.2f units", v) }},
+		Series: []Series{
+			ContinuousSeries{XValues: []float64{1, 2, 3}, YValues: []float64{1, 2, 3}},
+			AnnotationSeries{Annotations: []Value2{{XValue: 1, YValue: 1, Label: "start"}}},
+		},
+	}
+
+	collector := &DrawTraceWriter{}
+	assert.Nil(c.Render(Recording, collector))
+
+	r, err := PNG(300, 200)
+	assert.Nil(err)
+	r.SetDPI(DefaultDPI)
+	f, err := GetDefaultFont()
+	assert.Nil(err)
+	for _, call := range collector.Trace().Calls {
+		if call.Op == DrawOpText && strings.HasSuffix(call.Text, "units") {
+			width := Draw.MeasureText(r, call.Text, Style{Font: f, FontSize: DefaultAxisFontSize}).Width()
+			assert.True(call.X+width <= 300, call.Text)
+		}
+	}
+}
+
+func TestChartYAxisSide(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(YAxisSideLeft, YAxisSideRight.Opposite())
+	assert.Equal(YAxisSideLeft, YAxisSideUnset.Opposite())
+	assert.Equal(YAxisSideRight, YAxisSideLeft.Opposite())
+
+	primary, secondary := Chart{}.getYAxisTypes()
+	assert.Equal(YAxisPrimary, primary)
+	assert.Equal(YAxisSecondary, secondary)
+
+	primary, secondary = Chart{YAxis: YAxis{Side: YAxisSideLeft}}.getYAxisTypes()
+	assert.Equal(YAxisSecondary, primary)
+	assert.Equal(YAxisPrimary, secondary)
+
+	primary, secondary = Chart{YAxisSecondary: YAxis{Side: YAxisSideRight}}.getYAxisTypes()
+	assert.Equal(YAxisSecondary, primary)
+	assert.Equal(YAxisPrimary, secondary)
+
+	primary, _ = Chart{IsRTL: true, YAxis: YAxis{Side: YAxisSideRight}}.getYAxisTypes()
+	assert.Equal(YAxisPrimary, primary, "an explicit side should win over the right-to-left default")
+
+	c := Chart{
+		Width:  400,
+		Height: 200,
+		YAxis: YAxis{
+			Style:          StyleShow(),
+			Side:           YAxisSideLeft,
+			GridMajorStyle: Style{Show: true, StrokeColor: ColorLightGray, StrokeWidth: 1.0},
+		},
+		Series: []Series{
+			ContinuousSeries{XValues: []float64{1, 2, 3}, YValues: []float64{1, 2, 3}},
+		},
+	}
+	collector := &DrawTraceWriter{}
+	assert.Nil(c.Render(Recording, collector))
+	labels := collector.Trace().Texts()
+	assert.NotEmpty(labels)
+	for _, call := range collector.Trace().Calls {
+		if call.Op == DrawOpText {
+			assert.True(call.X < 200, call.Text)
+		}
+	}
+}
+
+func TestChartMinCanvasSize(t *testing.T) {
+	assert := assert.New(t)
+
+	c := Chart{
+		Width:  60,
+		Height: 40,
+		XAxis:  XAxis{Style: StyleShow()},
+		YAxis:  YAxis{Style: StyleShow()},
+		Series: []Series{
+			ContinuousSeries{XValues: Sequence.Float64(1000000.0, 1000010.0), YValues: Sequence.Float64(1000000.0, 1000010.0)},
+		},
+	}
+	assert.Nil(c.Render(PNG, bytes.NewBuffer(nil)), "there is no minimum canvas size by default")
+
+	c.MinCanvasWidth, c.MinCanvasHeight = 10, 10
+	err := c.Render(PNG, bytes.NewBuffer(nil))
+	assert.NotNil(err)
+	tooSmall, isTooSmall := err.(CanvasTooSmallError)
+	assert.True(isTooSmall)
+	assert.Equal(10, tooSmall.MinWidth)
+	assert.True(tooSmall.Canvas.Right-tooSmall.Canvas.Left < 10 || tooSmall.Canvas.Bottom-tooSmall.Canvas.Top < 10)
+
+	c.DropAxesWhenSmall = true
+	collector := &DrawTraceWriter{}
+	assert.Nil(c.Render(Recording, collector))
+	assert.Empty(collector.Trace().Texts(), "the axes labels should have been dropped")
+
+	c = Chart{
+		Width:          200,
+		Height:         100,
+		MinCanvasWidth: 500,
+		Series: []Series{
+			ContinuousSeries{XValues: Sequence.Float64(1.0, 10.0), YValues: Sequence.Float64(1.0, 10.0)},
+		},
+	}
+	err = c.Render(PNG, bytes.NewBuffer(nil))
+	assert.NotNil(err)
+	assert.True(len(err.Error()) > 0)
+}
+
+func TestChartYAxisNameColorHint(t *testing.T) {
+	assert := assert.New(t)
+
+	c := Chart{
+		Width:  400,
+		Height: 200,
+		YAxis: YAxis{
+			Name:          "Requests/s",
+			NameStyle:     StyleShow(),
+			NameColorHint: true,
+			Style:         StyleShow(),
+		},
+		YAxisSecondary: YAxis{
+			Name:          "Error %",
+			NameStyle:     Style{Show: true, FontColor: ColorBlack},
+			NameColorHint: true,
+			Style:         StyleShow(),
+		},
+		Series: []Series{
+			AnnotationSeries{Annotations: []Value2{{XValue: 1, YValue: 1, Label: "deploy"}}},
+			ContinuousSeries{XValues: []float64{1, 2, 3}, YValues: []float64{1, 2, 3}},
+			ContinuousSeries{YAxis: YAxisSecondary, Style: Style{Show: true, StrokeColor: ColorRed}, XValues: []float64{1, 2, 3}, YValues: []float64{3, 2, 1}},
+		},
+	}
+
+	collector := &DrawTraceWriter{}
+	assert.Nil(c.Render(Recording, collector))
+	colors := map[string]drawing.Color{}
+	for _, call := range collector.Trace().Calls {
+		if call.Op == DrawOpText {
+			colors[call.Text] = call.Style.FontColor
+		}
+	}
+	assert.Equal(GetDefaultColor(1), colors["Requests/s"], "annotation series are skipped")
+	assert.Equal(ColorBlack, colors["Error %"], "an explicit font color wins")
+}
+
+func TestChartSparklineNoMinCanvasSize(t *testing.T) {
+	assert := assert.New(t)
+
+	c := Chart{
+		Width:  8,
+		Height: 6,
+		Series: []Series{
+			ContinuousSeries{XValues: Sequence.Float64(1.0, 10.0), YValues: Sequence.Float64(1.0, 10.0)},
+		},
+	}
+	assert.Nil(c.Render(PNG, bytes.NewBuffer(nil)))
+}
+
+func TestChartYAxisSecondaryNamePlacement(t *testing.T) {
+	assert := assert.New(t)
+
+	nameX := func(side YAxisSide) int {
+		c := Chart{
+			YAxisSecondary: YAxis{Name: "Secondary", NameStyle: StyleShow(), Style: StyleShow(), Side: side},
+			Series: []Series{
+				ContinuousSeries{XValues: []float64{1, 2, 3}, YValues: []float64{1, 2, 3}},
+				ContinuousSeries{YAxis: YAxisSecondary, XValues: []float64{1, 2, 3}, YValues: []float64{10, 20, 30}},
+			},
+		}
+		collector := &DrawTraceWriter{}
+		assert.Nil(c.Render(Recording, collector))
+		for _, call := range collector.Trace().Calls {
+			if call.Op == DrawOpText && call.Text == "Secondary" {
+				return call.X
+			}
+		}
+		t.Fatal("the axis name was not drawn")
+		return 0
+	}
+
+	// only axes placed with a side move their name clear of the tick labels.
+	assert.True(nameX(YAxisSideLeft) < nameX(YAxisSideUnset))
+}
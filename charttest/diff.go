@@ -0,0 +1,101 @@
+package charttest
+
+import (
+	"errors"
+	"image"
+	"image/color"
+)
+
+// Tolerance is the maximum absolute difference allowed per color channel
+// (on the 8 bit interval [0,255]) before two pixels are considered different.
+type Tolerance struct {
+	R, G, B, A uint8
+}
+
+// UniformTolerance returns a tolerance with the same value for every channel.
+func UniformTolerance(value uint8) Tolerance {
+	return Tolerance{R: value, G: value, B: value, A: value}
+}
+
+var (
+	// ColorMismatch is the color mismatched pixels are drawn with in the difference image.
+	ColorMismatch = color.RGBA{R: 255, G: 0, B: 0, A: 255}
+)
+
+// DiffResult is the result of comparing two images.
+type DiffResult struct {
+	// Image is the expected image (faded) with the mismatched pixels highlighted.
+	Image *image.RGBA
+	// Mismatched is the number of pixels that differ by more than the tolerance.
+	Mismatched int
+	// Total is the total number of pixels compared.
+	Total int
+}
+
+// MismatchPercent returns the percentage [0,100] of pixels that were mismatched.
+func (dr DiffResult) MismatchPercent() float64 {
+	if dr.Total == 0 {
+		return 0
+	}
+	return (float64(dr.Mismatched) / float64(dr.Total)) * 100.0
+}
+
+// IsMatch returns if there were no mismatched pixels.
+func (dr DiffResult) IsMatch() bool {
+	return dr.Mismatched == 0
+}
+
+// Diff compares two images pixel by pixel and returns a highlighted difference image as
+// well as the mismatched pixel counts. An optional tolerance can be provided; by default
+// any difference in any channel is a mismatch.
+func Diff(expected, actual image.Image, tolerances ...Tolerance) (*DiffResult, error) {
+	if expected == nil || actual == nil {
+		return nil, errors.New("charttest: both images must be provided")
+	}
+	eb, ab := expected.Bounds(), actual.Bounds()
+	if eb.Dx() != ab.Dx() || eb.Dy() != ab.Dy() {
+		return nil, errors.New("charttest: image dimensions do not match")
+	}
+
+	var tolerance Tolerance
+	if len(tolerances) > 0 {
+		tolerance = tolerances[0]
+	}
+
+	result := &DiffResult{
+		Image: image.NewRGBA(image.Rect(0, 0, eb.Dx(), eb.Dy())),
+		Total: eb.Dx() * eb.Dy(),
+	}
+
+	for y := 0; y < eb.Dy(); y++ {
+		for x := 0; x < eb.Dx(); x++ {
+			ec := color.RGBAModel.Convert(expected.At(eb.Min.X+x, eb.Min.Y+y)).(color.RGBA)
+			ac := color.RGBAModel.Convert(actual.At(ab.Min.X+x, ab.Min.Y+y)).(color.RGBA)
+
+			if channelDiff(ec.R, ac.R) > tolerance.R ||
+				channelDiff(ec.G, ac.G) > tolerance.G ||
+				channelDiff(ec.B, ac.B) > tolerance.B ||
+				channelDiff(ec.A, ac.A) > tolerance.A {
+				result.Mismatched++
+				result.Image.SetRGBA(x, y, ColorMismatch)
+				continue
+			}
+			result.Image.SetRGBA(x, y, fade(ec))
+		}
+	}
+	return result, nil
+}
+
+func channelDiff(a, b uint8) uint8 {
+	if a > b {
+		return a - b
+	}
+	return b - a
+}
+
+// fade returns a washed out grayscale version of a color so mismatches stand out.
+func fade(c color.RGBA) color.RGBA {
+	gray := (uint32(c.R)*299 + uint32(c.G)*587 + uint32(c.B)*114) / 1000
+	v := uint8(255 - ((255 - gray) / 4))
+	return color.RGBA{R: v, G: v, B: v, A: 255}
+}
@@ -0,0 +1,114 @@
+package charttest
+
+import (
+	"image"
+	"image/color"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	assert "github.com/blendlabs/go-assert"
+	"github.com/wcharczuk/go-chart"
+)
+
+func solid(width, height int, c color.RGBA) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			img.SetRGBA(x, y, c)
+		}
+	}
+	return img
+}
+
+func TestDiff(t *testing.T) {
+	assert := assert.New(t)
+
+	a := solid(10, 10, color.RGBA{R: 100, G: 100, B: 100, A: 255})
+	b := solid(10, 10, color.RGBA{R: 100, G: 100, B: 100, A: 255})
+	b.SetRGBA(0, 0, color.RGBA{R: 110, G: 100, B: 100, A: 255})
+	b.SetRGBA(1, 0, color.RGBA{R: 200, G: 100, B: 100, A: 255})
+
+	result, err := Diff(a, b)
+	assert.Nil(err)
+	assert.Equal(2, result.Mismatched)
+	assert.Equal(100, result.Total)
+	assert.InDelta(2.0, result.MismatchPercent(), 0.0001)
+	assert.Equal(ColorMismatch, result.Image.RGBAAt(0, 0))
+	assert.NotEqual(ColorMismatch, result.Image.RGBAAt(2, 0))
+
+	result, err = Diff(a, b, Tolerance{R: 20})
+	assert.Nil(err)
+	assert.Equal(1, result.Mismatched)
+
+	result, err = Diff(a, b, UniformTolerance(255))
+	assert.Nil(err)
+	assert.True(result.IsMatch())
+}
+
+func TestDiffMismatchedBounds(t *testing.T) {
+	assert := assert.New(t)
+
+	_, err := Diff(solid(10, 10, color.RGBA{}), solid(10, 11, color.RGBA{}))
+	assert.NotNil(err)
+	_, err = Diff(nil, solid(10, 11, color.RGBA{}))
+	assert.NotNil(err)
+}
+
+func TestGolden(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "charttest")
+	assert.Nil(err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "golden", "test.png")
+	c := chart.Chart{
+		Width:  200,
+		Height: 100,
+		Series: []chart.Series{
+			chart.ContinuousSeries{
+				XValues: []float64{1.0, 2.0, 3.0},
+				YValues: []float64{1.0, 2.0, 3.0},
+			},
+		},
+	}
+
+	// a missing golden file is an error, unless the golden files are being updated.
+	actual, err := RenderImage(c)
+	assert.Nil(err)
+	_, err = Golden(path, actual)
+	assert.NotNil(err)
+	assert.True(strings.Contains(err.Error(), path))
+	assert.True(strings.Contains(err.Error(), EnvUpdateGolden))
+
+	os.Setenv(EnvUpdateGolden, "true")
+	AssertGolden(t, path, c, 0)
+	os.Unsetenv(EnvUpdateGolden)
+	golden, err := ReadGolden(path)
+	assert.Nil(err)
+	assert.Equal(200, golden.Bounds().Dx())
+
+	AssertGolden(t, path, c, 0)
+
+	c.Series = []chart.Series{
+		chart.ContinuousSeries{
+			XValues: []float64{1.0, 2.0, 3.0},
+			YValues: []float64{3.0, 2.0, 1.0},
+		},
+	}
+	actual, err = RenderImage(c)
+	assert.Nil(err)
+	result, err := Golden(path, actual)
+	assert.Nil(err)
+	assert.False(result.IsMatch())
+
+	os.Setenv(EnvUpdateGolden, "true")
+	defer os.Unsetenv(EnvUpdateGolden)
+	assert.True(ShouldUpdateGolden())
+	result, err = Golden(path, actual)
+	assert.Nil(err)
+	assert.True(result.IsMatch())
+}
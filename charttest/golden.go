@@ -0,0 +1,105 @@
+package charttest
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/png"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/wcharczuk/go-chart"
+)
+
+// EnvUpdateGolden is the environment variable that, when set to a non-empty value (other than `0` or `false`),
+// causes golden files to be (re)written instead of compared.
+const EnvUpdateGolden = "CHART_UPDATE_GOLDEN"
+
+// Renderable is a chart type that can be rendered, i.e. `chart.Chart` or `chart.BarChart`.
+type Renderable interface {
+	Render(rp chart.RendererProvider, w io.Writer) error
+}
+
+// ShouldUpdateGolden returns if the golden files should be updated, based on `EnvUpdateGolden`.
+func ShouldUpdateGolden() bool {
+	value := strings.ToLower(strings.TrimSpace(os.Getenv(EnvUpdateGolden)))
+	return len(value) > 0 && value != "0" && value != "false"
+}
+
+// RenderImage renders a chart with the png renderer and returns the resulting image.
+func RenderImage(c Renderable) (image.Image, error) {
+	collector := &chart.ImageWriter{}
+	if err := c.Render(chart.PNG, collector); err != nil {
+		return nil, err
+	}
+	return collector.Image()
+}
+
+// ReadGolden reads a png golden file.
+func ReadGolden(path string) (image.Image, error) {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return png.Decode(bytes.NewReader(contents))
+}
+
+// WriteGolden writes an image as a png golden file, creating parent directories as needed.
+func WriteGolden(path string, img image.Image) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return png.Encode(f, img)
+}
+
+// Golden compares an image against the golden file at the given path.
+// If `EnvUpdateGolden` is set the golden file is written from the image instead, and an empty (matching) result is
+// returned; otherwise a missing golden file is an error, so a golden file that was never checked in fails.
+func Golden(path string, actual image.Image, tolerances ...Tolerance) (*DiffResult, error) {
+	if ShouldUpdateGolden() {
+		return &DiffResult{}, WriteGolden(path, actual)
+	}
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return nil, fmt.Errorf("charttest: golden file %s does not exist; set %s=true to write it", path, EnvUpdateGolden)
+	}
+
+	expected, err := ReadGolden(path)
+	if err != nil {
+		return nil, err
+	}
+	return Diff(expected, actual, tolerances...)
+}
+
+// AssertGolden renders a chart and compares it against a golden file, failing the test if more
+// than `maxMismatchPercent` of the pixels differ. On failure the difference image is written
+// next to the golden file with a `.diff.png` suffix.
+func AssertGolden(t testing.TB, path string, c Renderable, maxMismatchPercent float64, tolerances ...Tolerance) {
+	t.Helper()
+
+	actual, err := RenderImage(c)
+	if err != nil {
+		t.Fatalf("charttest: render failed: %v", err)
+	}
+
+	result, err := Golden(path, actual, tolerances...)
+	if err != nil {
+		t.Fatalf("charttest: golden comparison failed: %v", err)
+	}
+
+	if result.MismatchPercent() > maxMismatchPercent {
+		diffPath := strings.TrimSuffix(path, filepath.Ext(path)) + ".diff.png"
+		if err := WriteGolden(diffPath, result.Image); err != nil {
+			t.Logf("charttest: could not write diff image: %v", err)
+		}
+		t.Fatalf("charttest: %0.2f%% of pixels differ from %s (allowed %0.2f%%), see %s", result.MismatchPercent(), path, maxMismatchPercent, diffPath)
+	}
+}
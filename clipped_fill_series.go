@@ -0,0 +1,131 @@
+package chart
+
+import (
+	"fmt"
+
+	"github.com/wcharczuk/go-chart/drawing"
+)
+
+// ClipPath draws (but does not stroke or fill) a clip path with a renderer, in canvas coordinates.
+type ClipPath func(r Renderer, canvasBox Box, xrange, yrange Range)
+
+// ClipAbove returns a clip path of the area above a series' line, i.e. to fill another series only where it
+// exceeds this one.
+func ClipAbove(vp ValueProvider) ClipPath {
+	return func(r Renderer, canvasBox Box, xrange, yrange Range) {
+		clipToLine(r, canvasBox, xrange, yrange, vp, canvasBox.Top)
+	}
+}
+
+// ClipBelow returns a clip path of the area below a series' line.
+func ClipBelow(vp ValueProvider) ClipPath {
+	return func(r Renderer, canvasBox Box, xrange, yrange Range) {
+		clipToLine(r, canvasBox, xrange, yrange, vp, canvasBox.Bottom)
+	}
+}
+
+// ClipPolygon returns a clip path of a polygon given in data (not canvas) coordinates.
+func ClipPolygon(xvalues, yvalues []float64) ClipPath {
+	return func(r Renderer, canvasBox Box, xrange, yrange Range) {
+		count := Math.MinInt(len(xvalues), len(yvalues))
+		for index := 0; index < count; index++ {
+			x := canvasBox.Left + xrange.Translate(xvalues[index])
+			y := canvasBox.Bottom - yrange.Translate(yvalues[index])
+			if index == 0 {
+				r.MoveTo(x, y)
+			} else {
+				r.LineTo(x, y)
+			}
+		}
+		r.Close()
+	}
+}
+
+// clipToLine draws the area between a series' line and a given y, extended to the canvas edges.
+func clipToLine(r Renderer, canvasBox Box, xrange, yrange Range, vp ValueProvider, edge int) {
+	if vp.Len() == 0 {
+		return
+	}
+	_, v0y := vp.GetValue(0)
+	r.MoveTo(canvasBox.Left, edge)
+	r.LineTo(canvasBox.Left, canvasBox.Bottom-yrange.Translate(v0y))
+	for index := 0; index < vp.Len(); index++ {
+		vx, vy := vp.GetValue(index)
+		r.LineTo(canvasBox.Left+xrange.Translate(vx), canvasBox.Bottom-yrange.Translate(vy))
+	}
+	_, vny := vp.GetValue(vp.Len() - 1)
+	r.LineTo(canvasBox.Right, canvasBox.Bottom-yrange.Translate(vny))
+	r.LineTo(canvasBox.Right, edge)
+	r.Close()
+}
+
+// ClippedFillSeries is a line series whose area fill is clipped to a path, i.e. to fill only where it exceeds
+// another series with `ClipAbove`. The line itself is not clipped.
+// Renderers that do not implement `ClipRenderer` draw the fill unclipped.
+type ClippedFillSeries struct {
+	Name  string
+	Style Style
+	YAxis YAxisType
+
+	InnerSeries ValueProvider
+	Clip        ClipPath
+}
+
+// GetName returns the name of the time series.
+func (cfs ClippedFillSeries) GetName() string {
+	return cfs.Name
+}
+
+// GetStyle returns the line style.
+func (cfs ClippedFillSeries) GetStyle() Style {
+	return cfs.Style
+}
+
+// GetYAxis returns which YAxis the series draws on.
+func (cfs ClippedFillSeries) GetYAxis() YAxisType {
+	return cfs.YAxis
+}
+
+// Len returns the number of elements in the series.
+func (cfs ClippedFillSeries) Len() int {
+	return cfs.InnerSeries.Len()
+}
+
+// GetValue gets a value at a given index.
+func (cfs ClippedFillSeries) GetValue(index int) (x, y float64) {
+	return cfs.InnerSeries.GetValue(index)
+}
+
+// GetValueFormatters returns the value formatters of the inner series, if it provides them.
+func (cfs ClippedFillSeries) GetValueFormatters() (x, y ValueFormatter) {
+	if vfp, isVfp := cfs.InnerSeries.(ValueFormatterProvider); isVfp {
+		return vfp.GetValueFormatters()
+	}
+	return FloatValueFormatter, FloatValueFormatter
+}
+
+// Render renders the series.
+func (cfs ClippedFillSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
+	style := cfs.Style.InheritFrom(defaults)
+
+	cr, isClipRenderer := r.(ClipRenderer)
+	if isClipRenderer && cfs.Clip != nil {
+		cfs.Clip(r, canvasBox, xrange, yrange)
+		cr.Clip()
+	}
+	Draw.LineSeriesFill(r, canvasBox, xrange, yrange, style, cfs.InnerSeries)
+	if isClipRenderer && cfs.Clip != nil {
+		cr.ResetClip()
+	}
+
+	style.FillColor = drawing.Color{}
+	Draw.LineSeries(r, canvasBox, xrange, yrange, style, cfs.InnerSeries)
+}
+
+// Validate validates the series.
+func (cfs ClippedFillSeries) Validate() error {
+	if cfs.InnerSeries == nil {
+		return fmt.Errorf("clipped fill series requires InnerSeries to be set")
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package chart
+
+import (
+	"bytes"
+	"image/png"
+	"strings"
+	"testing"
+
+	assert "github.com/blendlabs/go-assert"
+	"github.com/wcharczuk/go-chart/drawing"
+)
+
+func TestClippedFillSeries(t *testing.T) {
+	assert := assert.New(t)
+
+	lower := ContinuousSeries{XValues: []float64{0, 10}, YValues: []float64{5, 5}}
+	cfs := ClippedFillSeries{
+		Style:       Style{Show: true, StrokeColor: drawing.ColorBlue, FillColor: drawing.ColorRed},
+		InnerSeries: ContinuousSeries{XValues: []float64{0, 10}, YValues: []float64{10, 10}},
+		Clip:        ClipAbove(lower),
+	}
+	assert.Nil(cfs.Validate())
+	assert.NotNil(ClippedFillSeries{}.Validate())
+
+	c := Chart{
+		Width:  100,
+		Height: 100,
+		YAxis:  YAxis{Range: &ContinuousRange{Min: 0, Max: 10}},
+		Series: []Series{cfs},
+	}
+
+	buffer := bytes.NewBuffer(nil)
+	assert.Nil(c.Render(PNG, buffer))
+	image, err := png.Decode(buffer)
+	assert.Nil(err)
+
+	canvasBox := c.getDefaultCanvasBox()
+	cx, _ := canvasBox.Center()
+	_, above, _, _ := image.At(cx, canvasBox.Top+canvasBox.Height()/4).RGBA()
+	r, g, b, _ := image.At(cx, canvasBox.Bottom-canvasBox.Height()/4).RGBA()
+	assert.Zero(above, "the fill is drawn above the lower series")
+	assert.Equal([]uint32{0xffff, 0xffff, 0xffff}, []uint32{r, g, b}, "the fill is clipped below the lower series")
+
+	buffer.Reset()
+	assert.Nil(c.Render(SVG, buffer))
+	svg := buffer.String()
+	assert.False(strings.Contains(svg, `</defs>\n`))
+	start := strings.Index(svg, `<clipPath id="`) + len(`<clipPath id="`)
+	assert.True(start > len(`<clipPath id="`))
+	id := svg[start : start+strings.Index(svg[start:], `"`)]
+	assert.True(strings.HasSuffix(id, "-1"))
+	assert.True(strings.Contains(svg, `<g clip-path="url(#`+id+`)">`+"\n"))
+
+	// another chart inlined in the same page has its own clip path ids.
+	buffer.Reset()
+	assert.Nil(c.Render(SVG, buffer))
+	assert.False(strings.Contains(buffer.String(), `id="`+id+`"`))
+}
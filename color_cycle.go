@@ -0,0 +1,73 @@
+package chart
+
+import "github.com/wcharczuk/go-chart/drawing"
+
+// ColorCycle controls the default colors of series that don't set their own stroke color.
+// The zero value cycles through `DefaultColors`, repeating them when there are more series than colors.
+type ColorCycle struct {
+	// Colors is the palette to cycle through; it defaults to `DefaultColors`.
+	Colors []drawing.Color
+	// Start is the palette index the first series starts at.
+	Start int
+	// SkipUsed leaves out palette colors that other series already set explicitly.
+	SkipUsed bool
+	// HueRotate rotates the hue of the palette each time it wraps around, instead of repeating colors.
+	HueRotate bool
+}
+
+// GetColors returns the palette used after skipping the used colors, if enabled.
+func (cc ColorCycle) GetColors(used ...drawing.Color) []drawing.Color {
+	colors := cc.Colors
+	if len(colors) == 0 {
+		colors = DefaultColors
+	}
+	if !cc.SkipUsed || len(used) == 0 {
+		return colors
+	}
+
+	var available []drawing.Color
+	for _, c := range colors {
+		if !colorCycleContains(used, c) {
+			available = append(available, c)
+		}
+	}
+	if len(available) == 0 {
+		return colors
+	}
+	return available
+}
+
+// GetColor returns the default color for a series index, given the colors other series set explicitly.
+func (cc ColorCycle) GetColor(index int, used ...drawing.Color) drawing.Color {
+	colors := cc.GetColors(used...)
+	position := cc.Start + index
+	if position < 0 {
+		position = 0
+	}
+	c := colors[position%len(colors)]
+	if cycle := position / len(colors); cc.HueRotate && cycle > 0 {
+		// place each cycle's colors between the colors of the previous cycles (1/2, 1/4, 3/4 ... of the spacing).
+		return c.RotateHue(colorCycleOffset(cycle) * 360.0 / float64(len(colors)))
+	}
+	return c
+}
+
+// colorCycleOffset returns the base 2 van der corput sequence value for a cycle.
+func colorCycleOffset(cycle int) float64 {
+	var offset float64
+	denominator := 1.0
+	for ; cycle > 0; cycle >>= 1 {
+		denominator *= 2.0
+		offset += float64(cycle&1) / denominator
+	}
+	return offset
+}
+
+func colorCycleContains(colors []drawing.Color, c drawing.Color) bool {
+	for _, existing := range colors {
+		if existing == c {
+			return true
+		}
+	}
+	return false
+}
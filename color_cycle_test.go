@@ -0,0 +1,65 @@
+package chart
+
+import (
+	"testing"
+
+	"github.com/blendlabs/go-assert"
+	"github.com/wcharczuk/go-chart/drawing"
+)
+
+func TestColorCycleDefaults(t *testing.T) {
+	assert := assert.New(t)
+
+	var cc ColorCycle
+	for index := 0; index < 2*len(DefaultColors); index++ {
+		assert.Equal(GetDefaultColor(index), cc.GetColor(index))
+	}
+}
+
+func TestColorCycleStart(t *testing.T) {
+	assert := assert.New(t)
+
+	cc := ColorCycle{Start: 2}
+	assert.Equal(DefaultColors[2], cc.GetColor(0))
+	assert.Equal(DefaultColors[3], cc.GetColor(1))
+}
+
+func TestColorCycleSkipUsed(t *testing.T) {
+	assert := assert.New(t)
+
+	cc := ColorCycle{SkipUsed: true}
+	assert.Equal(DefaultColors[1], cc.GetColor(0, DefaultColors[0]))
+	assert.Len(cc.GetColors(DefaultColors[0], DefaultColors[2]), len(DefaultColors)-2)
+
+	cc.Colors = []drawing.Color{ColorRed}
+	assert.Equal(ColorRed, cc.GetColor(0, ColorRed), "should fall back to the full palette")
+}
+
+func TestColorCycleHueRotate(t *testing.T) {
+	assert := assert.New(t)
+
+	cc := ColorCycle{HueRotate: true}
+	seen := map[drawing.Color]bool{}
+	for index := 0; index < 4*len(DefaultColors); index++ {
+		c := cc.GetColor(index)
+		assert.False(seen[c], c.String())
+		seen[c] = true
+	}
+	assert.Equal(DefaultColors[0], cc.GetColor(0))
+}
+
+func TestChartColorCycle(t *testing.T) {
+	assert := assert.New(t)
+
+	c := Chart{
+		ColorCycle: ColorCycle{SkipUsed: true},
+		Series: []Series{
+			ContinuousSeries{Style: Style{StrokeColor: DefaultColors[0]}},
+			ContinuousSeries{},
+			ContinuousSeries{Style: Style{StrokeColor: drawing.ColorFromHex("123456")}},
+			ContinuousSeries{},
+		},
+	}
+	assert.Equal(DefaultColors[1], c.styleDefaultsSeries(1).StrokeColor)
+	assert.Equal(DefaultColors[2], c.styleDefaultsSeries(3).StrokeColor, "styled series don't use up palette colors")
+}
@@ -0,0 +1,92 @@
+package chart
+
+import (
+	"math"
+
+	"github.com/wcharczuk/go-chart/drawing"
+)
+
+// ColorMap maps a normalized value on the interval [0,1] to a color.
+type ColorMap func(v float64) drawing.Color
+
+// GetColor returns the color for a value within a given range.
+// Values outside the range are clamped to the range bounds.
+func (cm ColorMap) GetColor(ra Range, value float64) drawing.Color {
+	delta := ra.GetDelta()
+	if delta == 0 || math.IsNaN(delta) || math.IsInf(delta, 0) {
+		return cm(0)
+	}
+	return cm((value - ra.GetMin()) / delta)
+}
+
+// NewColorMap returns a color map that linearly interpolates between a set of evenly spaced color stops.
+func NewColorMap(stops ...drawing.Color) ColorMap {
+	return func(v float64) drawing.Color {
+		if len(stops) == 0 {
+			return ColorTransparent
+		}
+		if len(stops) == 1 || v <= 0 || math.IsNaN(v) {
+			return stops[0]
+		}
+		if v >= 1 {
+			return stops[len(stops)-1]
+		}
+
+		scaled := v * float64(len(stops)-1)
+		index := int(math.Floor(scaled))
+		return colorLerp(stops[index], stops[index+1], scaled-float64(index))
+	}
+}
+
+// NewColorMapHCL returns a color map that interpolates between a set of evenly spaced color stops in HCL space,
+// which keeps the steps between colors perceptually even.
+func NewColorMapHCL(stops ...drawing.Color) ColorMap {
+	return func(v float64) drawing.Color {
+		if len(stops) == 0 {
+			return ColorTransparent
+		}
+		if len(stops) == 1 || v <= 0 || math.IsNaN(v) {
+			return stops[0]
+		}
+		if v >= 1 {
+			return stops[len(stops)-1]
+		}
+
+		scaled := v * float64(len(stops)-1)
+		index := int(math.Floor(scaled))
+		return stops[index].InterpolateHCL(stops[index+1], scaled-float64(index))
+	}
+}
+
+var (
+	// ColorMapGrayscale is a color map from white to black.
+	ColorMapGrayscale = NewColorMap(ColorWhite, drawing.ColorBlack)
+
+	// ColorMapBlues is a color map from a very light blue to the theme blue.
+	ColorMapBlues = NewColorMap(
+		drawing.Color{R: 239, G: 246, B: 252, A: 255},
+		ColorBlue,
+	)
+
+	// ColorMapViridis is an approximation of the (perceptually uniform) viridis color map.
+	ColorMapViridis = NewColorMap(
+		drawing.ColorFromHex("440154"),
+		drawing.ColorFromHex("3b528b"),
+		drawing.ColorFromHex("21918c"),
+		drawing.ColorFromHex("5ec962"),
+		drawing.ColorFromHex("fde725"),
+	)
+)
+
+// colorLerp linearly interpolates between two colors by t on the interval [0,1].
+func colorLerp(from, to drawing.Color, t float64) drawing.Color {
+	lerp := func(a, b uint8) uint8 {
+		return uint8(math.Floor(float64(a) + (float64(b)-float64(a))*t + 0.5))
+	}
+	return drawing.Color{
+		R: lerp(from.R, to.R),
+		G: lerp(from.G, to.G),
+		B: lerp(from.B, to.B),
+		A: lerp(from.A, to.A),
+	}
+}
@@ -0,0 +1,46 @@
+package chart
+
+import (
+	"testing"
+
+	assert "github.com/blendlabs/go-assert"
+	"github.com/wcharczuk/go-chart/drawing"
+)
+
+func TestNewColorMap(t *testing.T) {
+	assert := assert.New(t)
+
+	cm := NewColorMap(drawing.ColorBlack, drawing.ColorWhite)
+	assert.Equal(drawing.ColorBlack, cm(0))
+	assert.Equal(drawing.ColorBlack, cm(-1))
+	assert.Equal(drawing.ColorWhite, cm(1))
+	assert.Equal(drawing.ColorWhite, cm(2))
+
+	mid := cm(0.5)
+	assert.Equal(128, int(mid.R))
+	assert.Equal(255, int(mid.A))
+
+	stops := NewColorMap(drawing.ColorRed, drawing.ColorGreen, drawing.ColorBlue)
+	assert.Equal(drawing.ColorGreen, stops(0.5))
+}
+
+func TestNewColorMapHCL(t *testing.T) {
+	assert := assert.New(t)
+
+	cm := NewColorMapHCL(drawing.ColorRed, drawing.ColorBlue)
+	assert.Equal(drawing.ColorRed, cm(0))
+	assert.Equal(drawing.ColorBlue, cm(1))
+	assert.Equal(drawing.ColorRed.InterpolateHCL(drawing.ColorBlue, 0.5), cm(0.5))
+	assert.Equal(ColorTransparent, NewColorMapHCL()(0.5))
+}
+
+func TestColorMapGetColor(t *testing.T) {
+	assert := assert.New(t)
+
+	ra := &ContinuousRange{Min: 10, Max: 20}
+	assert.Equal(ColorMapGrayscale(0), ColorMapGrayscale.GetColor(ra, 5))
+	assert.Equal(ColorMapGrayscale(0.5), ColorMapGrayscale.GetColor(ra, 15))
+	assert.Equal(ColorMapGrayscale(1), ColorMapGrayscale.GetColor(ra, 25))
+
+	assert.Equal(ColorMapGrayscale(0), ColorMapGrayscale.GetColor(&ContinuousRange{}, 1))
+}
@@ -0,0 +1,162 @@
+package chart
+
+import "fmt"
+
+const (
+	// DefaultColorScaleThickness is the default thickness of the color bar in a color scale legend.
+	DefaultColorScaleThickness = 15
+	// DefaultColorScaleMargin is the default distance between the color scale legend and the canvas.
+	DefaultColorScaleMargin = 10
+)
+
+// ColorScale is a color bar legend (with ticks) for a color map over a given range, i.e. of a heatmap. The chart
+// reserves space for the color scales it is given (see `Chart.ColorScales`) right of the canvas and its axes, or
+// below them if horizontal.
+type ColorScale struct {
+	ColorMap   ColorMap
+	Range      Range
+	Horizontal bool
+	Style      Style
+}
+
+// Validate validates the color scale.
+func (cs ColorScale) Validate() error {
+	if cs.ColorMap == nil {
+		return fmt.Errorf("color scale requires ColorMap to be set")
+	}
+	if cs.Range == nil {
+		return fmt.Errorf("color scale requires Range to be set")
+	}
+	return nil
+}
+
+// Measure returns the width (or, if horizontal, the height) the color scale takes up for a canvas length, including
+// the margin between it and the canvas.
+func (cs ColorScale) Measure(r Renderer, length int, chartDefaults Style) int {
+	legendStyle := colorScaleLegendStyle(chartDefaults, cs.Style)
+	tickRange := &ContinuousRange{Min: cs.Range.GetMin(), Max: cs.Range.GetMax(), Domain: length}
+	var labelWidth, labelHeight int
+	for _, t := range GenerateContinuousTicks(r, tickRange, !cs.Horizontal, legendStyle, FloatValueFormatter) {
+		tb := Draw.MeasureText(r, t.Label, legendStyle)
+		labelWidth = Math.MaxInt(labelWidth, tb.Width())
+		labelHeight = Math.MaxInt(labelHeight, tb.Height())
+	}
+	if cs.Horizontal {
+		return DefaultColorScaleMargin + DefaultColorScaleThickness + DefaultXAxisMargin + labelHeight
+	}
+	return DefaultColorScaleMargin + DefaultColorScaleThickness + DefaultYAxisMargin + labelWidth
+}
+
+// Render draws the color scale in the space reserved for it, which starts at an offset right of (or below) the
+// canvas.
+func (cs ColorScale) Render(r Renderer, cb Box, offset int, chartDefaults Style) {
+	legendStyle := colorScaleLegendStyle(chartDefaults, cs.Style)
+	if cs.Horizontal {
+		top := offset + DefaultColorScaleMargin
+		drawColorScaleHorizontal(r, Box{Top: top, Left: cb.Left, Right: cb.Right, Bottom: top + DefaultColorScaleThickness}, cs.ColorMap, cs.Range, legendStyle)
+		return
+	}
+	left := offset + DefaultColorScaleMargin
+	drawColorScale(r, Box{Top: cb.Top, Left: left, Right: left + DefaultColorScaleThickness, Bottom: cb.Bottom}, cs.ColorMap, cs.Range, legendStyle)
+}
+
+// ColorScaleLegend returns a renderable that draws a vertical color bar (with ticks) for a color map over a given range.
+// The legend is drawn to the right of the chart, within the background right padding, so you should reserve
+// space for it by setting `Background.Padding.Right` (similar to `LegendLeft`); use `Chart.ColorScales` to have
+// the chart reserve the space instead.
+func ColorScaleLegend(c *Chart, cm ColorMap, ra Range, userDefaults ...Style) Renderable {
+	return func(r Renderer, cb Box, chartDefaults Style) {
+		legendStyle := colorScaleLegendStyle(chartDefaults, userDefaults...)
+
+		left := c.GetWidth() - c.Background.Padding.GetRight(DefaultBackgroundPadding.Right) + DefaultColorScaleMargin
+		drawColorScale(r, Box{
+			Top:    cb.Top,
+			Left:   left,
+			Right:  left + DefaultColorScaleThickness,
+			Bottom: cb.Bottom,
+		}, cm, ra, legendStyle)
+	}
+}
+
+// ColorScaleLegendHorizontal returns a renderable that draws a horizontal color bar (with ticks) for a color map over a given range.
+// The legend is drawn below the chart, within the background bottom padding, so you should reserve
+// space for it by setting `Background.Padding.Bottom`; use `Chart.ColorScales` to have the chart reserve the
+// space instead.
+func ColorScaleLegendHorizontal(c *Chart, cm ColorMap, ra Range, userDefaults ...Style) Renderable {
+	return func(r Renderer, cb Box, chartDefaults Style) {
+		legendStyle := colorScaleLegendStyle(chartDefaults, userDefaults...)
+
+		top := c.GetHeight() - c.Background.Padding.GetBottom(DefaultBackgroundPadding.Bottom) + DefaultColorScaleMargin
+		drawColorScaleHorizontal(r, Box{
+			Top:    top,
+			Left:   cb.Left,
+			Right:  cb.Right,
+			Bottom: top + DefaultColorScaleThickness,
+		}, cm, ra, legendStyle)
+	}
+}
+
+// drawColorScale draws a vertical color bar with ticks right of it.
+func drawColorScale(r Renderer, bar Box, cm ColorMap, ra Range, legendStyle Style) {
+	height := bar.Height()
+	for y := 0; y < height; y++ {
+		color := cm(1.0 - (float64(y) / float64(height)))
+		Draw.Box(r, Box{Top: bar.Top + y, Left: bar.Left, Right: bar.Right, Bottom: bar.Top + y + 1}, Style{
+			FillColor:   color,
+			StrokeColor: color,
+		})
+	}
+	Draw.Box(r, bar, Style{StrokeColor: legendStyle.GetStrokeColor(), StrokeWidth: legendStyle.GetStrokeWidth()})
+
+	tickRange := &ContinuousRange{Min: ra.GetMin(), Max: ra.GetMax(), Domain: height}
+	for _, t := range GenerateContinuousTicks(r, tickRange, true, legendStyle, FloatValueFormatter) {
+		ty := bar.Bottom - tickRange.Translate(t.Value)
+
+		legendStyle.GetStrokeOptions().WriteToRenderer(r)
+		r.MoveTo(bar.Right, ty)
+		r.LineTo(bar.Right+DefaultHorizontalTickWidth, ty)
+		r.Stroke()
+
+		tb := Draw.MeasureText(r, t.Label, legendStyle)
+		Draw.Text(r, t.Label, bar.Right+DefaultYAxisMargin, ty+(tb.Height()>>1), legendStyle)
+	}
+}
+
+// drawColorScaleHorizontal draws a horizontal color bar with ticks below it.
+func drawColorScaleHorizontal(r Renderer, bar Box, cm ColorMap, ra Range, legendStyle Style) {
+	width := bar.Width()
+	for x := 0; x < width; x++ {
+		color := cm(float64(x) / float64(width))
+		Draw.Box(r, Box{Top: bar.Top, Left: bar.Left + x, Right: bar.Left + x + 1, Bottom: bar.Bottom}, Style{
+			FillColor:   color,
+			StrokeColor: color,
+		})
+	}
+	Draw.Box(r, bar, Style{StrokeColor: legendStyle.GetStrokeColor(), StrokeWidth: legendStyle.GetStrokeWidth()})
+
+	tickRange := &ContinuousRange{Min: ra.GetMin(), Max: ra.GetMax(), Domain: width}
+	for _, t := range GenerateContinuousTicks(r, tickRange, false, legendStyle, FloatValueFormatter) {
+		tx := bar.Left + tickRange.Translate(t.Value)
+
+		legendStyle.GetStrokeOptions().WriteToRenderer(r)
+		r.MoveTo(tx, bar.Bottom)
+		r.LineTo(tx, bar.Bottom+DefaultVerticalTickHeight)
+		r.Stroke()
+
+		tb := Draw.MeasureText(r, t.Label, legendStyle)
+		Draw.Text(r, t.Label, tx-(tb.Width()>>1), bar.Bottom+DefaultXAxisMargin+tb.Height(), legendStyle)
+	}
+}
+
+func colorScaleLegendStyle(chartDefaults Style, userDefaults ...Style) Style {
+	legendDefaults := Style{
+		FontColor:   DefaultTextColor,
+		FontSize:    8.0,
+		StrokeColor: DefaultAxisColor,
+		StrokeWidth: DefaultAxisLineWidth,
+	}
+	if len(userDefaults) > 0 {
+		return userDefaults[0].InheritFrom(chartDefaults.InheritFrom(legendDefaults))
+	}
+	return chartDefaults.InheritFrom(legendDefaults)
+}
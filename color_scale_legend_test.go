@@ -0,0 +1,100 @@
+package chart
+
+import (
+	"bytes"
+	"testing"
+
+	assert "github.com/blendlabs/go-assert"
+)
+
+func TestColorScaleLegend(t *testing.T) {
+	assert := assert.New(t)
+
+	graph := Chart{
+		Background: Style{
+			Padding: Box{Right: 80, Bottom: 60},
+		},
+		Series: []Series{
+			ContinuousSeries{
+				XValues: []float64{1.0, 2.0, 3.0, 4.0, 5.0},
+				YValues: []float64{1.0, 2.0, 3.0, 4.0, 5.0},
+			},
+		},
+	}
+
+	ra := &ContinuousRange{Min: 0, Max: 100}
+	graph.Elements = []Renderable{
+		ColorScaleLegend(&graph, ColorMapViridis, ra),
+		ColorScaleLegendHorizontal(&graph, ColorMapBlues, ra),
+	}
+	buf := bytes.NewBuffer([]byte{})
+	err := graph.Render(PNG, buf)
+	assert.Nil(err)
+	assert.NotZero(buf.Len())
+}
+
+func TestChartColorScales(t *testing.T) {
+	assert := assert.New(t)
+
+	graph := Chart{
+		Width:  400,
+		Height: 300,
+		YAxis:  YAxis{Style: StyleShow()},
+		Series: []Series{
+			ContinuousSeries{
+				XValues: []float64{1.0, 2.0, 3.0, 4.0, 5.0},
+				YValues: []float64{1.0, 2.0, 3.0, 4.0, 5.0},
+			},
+		},
+	}
+	r, err := PNG(graph.GetWidth(), graph.GetHeight())
+	assert.Nil(err)
+	f, err := GetDefaultFont()
+	assert.Nil(err)
+	graph.Font = f
+	unreserved := graph.getLayoutBox(r)
+
+	ra := &ContinuousRange{Min: 0, Max: 100}
+	graph.ColorScales = []ColorScale{
+		{ColorMap: ColorMapViridis, Range: ra},
+		{ColorMap: ColorMapBlues, Range: ra, Horizontal: true},
+	}
+	reserved := graph.getLayoutBox(r)
+	vertical := graph.ColorScales[0].Measure(r, graph.Box().Height(), graph.styleDefaultsElements())
+	horizontal := graph.ColorScales[1].Measure(r, graph.Box().Width(), graph.styleDefaultsElements())
+	assert.True(vertical > DefaultColorScaleMargin+DefaultColorScaleThickness)
+	assert.True(horizontal > DefaultColorScaleMargin+DefaultColorScaleThickness)
+	assert.Equal(unreserved.Right-vertical, reserved.Right)
+	assert.Equal(unreserved.Bottom-horizontal, reserved.Bottom)
+
+	// the canvas and the y axis labels are laid out left of the color scale rather than under it.
+	info := &RenderInfo{}
+	graph.Info = info
+	collector := &DrawTraceWriter{}
+	assert.Nil(graph.Render(Recording, collector))
+	assert.True(info.Canvas.Right < reserved.Right)
+	var labels int
+	for _, call := range collector.Trace().Calls {
+		if call.Op == DrawOpText && call.X >= reserved.Right {
+			assert.True(call.X >= reserved.Right+DefaultColorScaleMargin+DefaultColorScaleThickness, call.Text)
+			labels++
+		}
+	}
+	assert.NotZero(labels)
+}
+
+func TestChartColorScalesValidate(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.NotNil(ColorScale{Range: &ContinuousRange{Max: 1}}.Validate())
+	assert.NotNil(ColorScale{ColorMap: ColorMapBlues}.Validate())
+	assert.Nil(ColorScale{ColorMap: ColorMapBlues, Range: &ContinuousRange{Max: 1}}.Validate())
+
+	graph := Chart{
+		ColorScales: []ColorScale{{}},
+		Series: []Series{
+			ContinuousSeries{XValues: []float64{1.0, 2.0}, YValues: []float64{1.0, 2.0}},
+		},
+	}
+	assert.NotNil(graph.Render(PNG, bytes.NewBuffer(nil)))
+}
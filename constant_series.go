@@ -0,0 +1,78 @@
+package chart
+
+import (
+	"fmt"
+	"math"
+)
+
+// ConstantSeries is a flat line at a constant y value across the chart's x range, i.e. a baseline or a target.
+// The x range is injected at render time, so there is no need to fabricate points matching the data's x extent.
+type ConstantSeries struct {
+	Name  string
+	Style Style
+	YAxis YAxisType
+
+	Value float64
+
+	// XRange is the range the line spans; it is set by the chart through `WithXRange`.
+	XRange Range
+}
+
+// GetName returns the name of the series.
+func (cs ConstantSeries) GetName() string {
+	return cs.Name
+}
+
+// GetStyle returns the line style.
+func (cs ConstantSeries) GetStyle() Style {
+	return cs.Style
+}
+
+// GetYAxis returns which YAxis the series draws on.
+func (cs ConstantSeries) GetYAxis() YAxisType {
+	return cs.YAxis
+}
+
+// WithXRange returns a copy of the series spanning a given x range.
+func (cs ConstantSeries) WithXRange(xrange Range) ValueProvider {
+	cs.XRange = xrange
+	return cs
+}
+
+// Len returns the number of elements in the series; it is zero until the x range is set.
+func (cs ConstantSeries) Len() int {
+	if cs.XRange == nil {
+		return 0
+	}
+	return 2
+}
+
+// GetValue gets a value at a given index.
+func (cs ConstantSeries) GetValue(index int) (x, y float64) {
+	if cs.XRange == nil {
+		return
+	}
+	if index == 0 {
+		return cs.XRange.GetMin(), cs.Value
+	}
+	return cs.XRange.GetMax(), cs.Value
+}
+
+// GetLastValue returns the value at the end of the x range.
+func (cs ConstantSeries) GetLastValue() (x, y float64) {
+	return cs.GetValue(1)
+}
+
+// Render renders the series.
+func (cs ConstantSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
+	style := cs.Style.InheritFrom(defaults)
+	Draw.LineSeries(r, canvasBox, xrange, yrange, style, cs.WithXRange(xrange))
+}
+
+// Validate validates the series.
+func (cs ConstantSeries) Validate() error {
+	if math.IsNaN(cs.Value) || math.IsInf(cs.Value, 0) {
+		return fmt.Errorf("constant series requires a finite value")
+	}
+	return nil
+}
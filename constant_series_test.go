@@ -0,0 +1,52 @@
+package chart
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	"github.com/blendlabs/go-assert"
+)
+
+func TestConstantSeries(t *testing.T) {
+	assert := assert.New(t)
+
+	cs := ConstantSeries{Value: 5.0}
+	assert.Zero(cs.Len())
+
+	vp := cs.WithXRange(&ContinuousRange{Min: 1.0, Max: 10.0})
+	assert.Equal(2, vp.Len())
+	x, y := vp.GetValue(0)
+	assert.Equal(1.0, x)
+	assert.Equal(5.0, y)
+	x, y = vp.GetValue(1)
+	assert.Equal(10.0, x)
+	assert.Equal(5.0, y)
+
+	assert.Nil(cs.Validate())
+	assert.NotNil(ConstantSeries{Value: math.NaN()}.Validate())
+}
+
+func TestChartGetRangesConstantSeries(t *testing.T) {
+	assert := assert.New(t)
+
+	c := Chart{
+		Series: []Series{
+			ContinuousSeries{
+				XValues: []float64{1.0, 2.0, 3.0},
+				YValues: []float64{1.0, 2.0, 3.0},
+			},
+			ConstantSeries{
+				Value: 10.0,
+			},
+		},
+	}
+
+	xr, yr, _ := c.getRanges()
+	assert.Equal(1.0, xr.GetMin())
+	assert.Equal(3.0, xr.GetMax())
+	assert.True(yr.GetMax() >= 10.0)
+
+	buffer := bytes.NewBuffer([]byte{})
+	assert.Nil(c.Render(PNG, buffer))
+}
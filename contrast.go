@@ -0,0 +1,113 @@
+package chart
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/wcharczuk/go-chart/drawing"
+)
+
+const (
+	// DefaultMinTextContrast is the minimum contrast ratio between text and its background (WCAG AA for normal text).
+	DefaultMinTextContrast = 4.5
+	// DefaultMinGraphicContrast is the minimum contrast ratio between a series and the canvas (WCAG AA for graphics).
+	DefaultMinGraphicContrast = 3.0
+	// DefaultMinSeriesColorDistance is the minimum (redmean) distance between the colors of adjacent series.
+	DefaultMinSeriesColorDistance = 100.0
+)
+
+// ContrastRatio returns the WCAG 2.0 contrast ratio of two colors, on the interval [1,21].
+// A translucent foreground is blended over the background first.
+func ContrastRatio(foreground, background drawing.Color) float64 {
+	background = background.BlendOver(ColorWhite)
+	l1 := foreground.BlendOver(background).Luminance()
+	l2 := background.Luminance()
+	if l1 < l2 {
+		l1, l2 = l2, l1
+	}
+	return (l1 + 0.05) / (l2 + 0.05)
+}
+
+// ColorDistance returns the "redmean" approximation of the perceptual distance between two colors, on the interval [0,~765].
+func ColorDistance(a, b drawing.Color) float64 {
+	a, b = a.BlendOver(ColorWhite), b.BlendOver(ColorWhite)
+	rmean := (float64(a.R) + float64(b.R)) / 2.0
+	dr, dg, db := float64(a.R)-float64(b.R), float64(a.G)-float64(b.G), float64(a.B)-float64(b.B)
+	return math.Sqrt((2.0+rmean/256.0)*dr*dr + 4.0*dg*dg + (2.0+(255.0-rmean)/256.0)*db*db)
+}
+
+// ContrastWarning is a pair of colors in a chart that may be hard to tell apart.
+type ContrastWarning struct {
+	// Subject is what the warning is about, i.e. `title` or `series "p99" and "p95"`.
+	Subject    string
+	Foreground drawing.Color
+	Background drawing.Color
+	// Value is the contrast ratio, or the color distance for adjacent series.
+	Value   float64
+	Minimum float64
+}
+
+// String returns a description of the warning.
+func (cw ContrastWarning) String() string {
+	return fmt.Sprintf("%s: %s on %s is %.2f, below the minimum of %.2f", cw.Subject, cw.Foreground.String(), cw.Background.String(), cw.Value, cw.Minimum)
+}
+
+// CheckContrast returns warnings for the title and axes text that fall below `DefaultMinTextContrast` against the background,
+// series that fall below `DefaultMinGraphicContrast` against the canvas, and adjacent series whose colors are closer
+// than `DefaultMinSeriesColorDistance`. It doesn't render the chart, so it's cheap to run on every chart that is generated.
+func (c Chart) CheckContrast() []ContrastWarning {
+	var warnings []ContrastWarning
+	check := func(subject string, foreground, background drawing.Color, value, minimum float64) {
+		if value < minimum {
+			warnings = append(warnings, ContrastWarning{Subject: subject, Foreground: foreground, Background: background, Value: value, Minimum: minimum})
+		}
+	}
+	text := func(subject string, foreground, background drawing.Color) {
+		check(subject, foreground, background, ContrastRatio(foreground, background), DefaultMinTextContrast)
+	}
+
+	background := c.getBackgroundStyle().GetFillColor(DefaultBackgroundColor)
+	canvas := c.getCanvasStyle().GetFillColor(DefaultCanvasColor).BlendOver(background.BlendOver(ColorWhite))
+
+	if len(c.Title) > 0 && c.TitleStyle.Show {
+		text("title", c.getTitleStyle().GetFontColor(), background)
+	}
+	axisDefaults := c.styleDefaultsAxes()
+	if c.XAxis.Style.Show {
+		text("x-axis", c.XAxis.Style.InheritFrom(axisDefaults).GetFontColor(), background)
+	}
+	if c.YAxis.Style.Show {
+		text("y-axis", c.YAxis.Style.InheritFrom(axisDefaults).GetFontColor(), background)
+	}
+	if c.YAxisSecondary.Style.Show {
+		text("secondary y-axis", c.YAxisSecondary.Style.InheritFrom(axisDefaults).GetFontColor(), background)
+	}
+
+	var previousName string
+	var previousColor drawing.Color
+	var hasPrevious bool
+	for index, s := range c.Series {
+		style := s.GetStyle()
+		if !style.IsZero() && !style.Show {
+			continue
+		}
+		color := style.InheritFrom(c.styleDefaultsSeries(index)).GetStrokeColor()
+		if color.IsZero() || color.IsTransparent() {
+			continue
+		}
+		name := c.getContrastSeriesName(s, index)
+		check(fmt.Sprintf("series %s", name), color, canvas, ContrastRatio(color, canvas), DefaultMinGraphicContrast)
+		if hasPrevious {
+			check(fmt.Sprintf("series %s and %s", previousName, name), color, previousColor, ColorDistance(color, previousColor), DefaultMinSeriesColorDistance)
+		}
+		previousName, previousColor, hasPrevious = name, color, true
+	}
+	return warnings
+}
+
+func (c Chart) getContrastSeriesName(s Series, index int) string {
+	if len(s.GetName()) > 0 {
+		return fmt.Sprintf("%q", s.GetName())
+	}
+	return fmt.Sprintf("%d", index)
+}
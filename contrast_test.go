@@ -0,0 +1,56 @@
+package chart
+
+import (
+	"strings"
+	"testing"
+
+	assert "github.com/blendlabs/go-assert"
+	"github.com/wcharczuk/go-chart/drawing"
+)
+
+func TestContrastRatio(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.InDelta(21.0, ContrastRatio(drawing.ColorBlack, ColorWhite), 0.01)
+	assert.InDelta(21.0, ContrastRatio(ColorWhite, drawing.ColorBlack), 0.01)
+	assert.InDelta(1.0, ContrastRatio(ColorBlue, ColorBlue), 0.0001)
+	assert.InDelta(1.0, ContrastRatio(ColorTransparent, ColorWhite), 0.0001)
+	assert.True(ContrastRatio(drawing.ColorFromHex("767676"), ColorWhite) >= DefaultMinTextContrast)
+	assert.True(ContrastRatio(drawing.ColorFromHex("777777"), ColorWhite) < DefaultMinTextContrast)
+}
+
+func TestColorDistance(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Zero(ColorDistance(ColorBlue, ColorBlue))
+	assert.InDelta(764.83, ColorDistance(drawing.ColorBlack, ColorWhite), 0.01)
+
+	for index := 1; index < len(PaletteOkabeIto); index++ {
+		assert.True(ColorDistance(PaletteOkabeIto[index-1], PaletteOkabeIto[index]) >= DefaultMinSeriesColorDistance)
+	}
+}
+
+func TestChartCheckContrast(t *testing.T) {
+	assert := assert.New(t)
+
+	c := Chart{
+		Title:      "Title",
+		TitleStyle: StyleShow(),
+		XAxis:      XAxis{Style: StyleShow()},
+		Series: []Series{
+			ContinuousSeries{Name: "a", Style: Style{Show: true, StrokeColor: ColorBlue}},
+			ContinuousSeries{Name: "b", Style: Style{Show: true, StrokeColor: ColorBlue.WithAlpha(250)}},
+			ContinuousSeries{Name: "c", Style: Style{Show: true, StrokeColor: drawing.ColorFromHex("F0E442")}},
+		},
+	}
+	warnings := c.CheckContrast()
+	assert.Len(warnings, 2)
+	assert.Equal(`series "a" and "b"`, warnings[0].Subject)
+	assert.Equal(`series "c"`, warnings[1].Subject)
+	assert.True(strings.Contains(warnings[1].String(), "below the minimum of 3.00"))
+
+	c.Background = Style{FillColor: drawing.ColorFromHex("222222")}
+	warnings = c.CheckContrast()
+	assert.Equal("title", warnings[0].Subject)
+	assert.Equal("x-axis", warnings[1].Subject)
+}
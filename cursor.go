@@ -0,0 +1,145 @@
+package chart
+
+import (
+	"math"
+
+	"github.com/wcharczuk/go-chart/drawing"
+)
+
+// Cursor returns an element that draws a vertical cursor at an x value (see `Time.ToFloat64` for time axes) with a
+// readout of the x value and the value of each series at it. Giving each chart of a set that shares an x range a
+// cursor at the same x value draws a synchronized cursor across the charts, the static equivalent of a dashboard
+// crosshair. Nothing is drawn if the x value is outside of the x range.
+func Cursor(c *Chart, x float64, userDefaults ...Style) Renderable {
+	return func(r Renderer, cb Box, chartDefaults Style) {
+		cursorDefaults := Style{
+			FillColor:   drawing.ColorWhite,
+			FontColor:   DefaultTextColor,
+			FontSize:    8.0,
+			StrokeColor: DefaultAxisColor,
+			StrokeWidth: DefaultAxisLineWidth,
+		}
+		var cursorStyle Style
+		if len(userDefaults) > 0 {
+			cursorStyle = userDefaults[0].InheritFrom(chartDefaults.InheritFrom(cursorDefaults))
+		} else {
+			cursorStyle = chartDefaults.InheritFrom(cursorDefaults)
+		}
+
+		// the series and ranges are resolved as the render resolves them, so seeded series read the drawn values.
+		rc := *c
+		rc.Series = c.getRenderSeries(r)
+		xr, yr, yra := rc.getRanges()
+		xr, yr, yra = rc.setRangeDomains(cb, xr, yr, yra)
+		if x < math.Min(xr.GetMin(), xr.GetMax()) || x > math.Max(xr.GetMin(), xr.GetMax()) {
+			return
+		}
+		xf, yf, yfa := rc.getValueFormatters()
+		if xf == nil {
+			xf = FloatValueFormatter
+		}
+		if yf == nil {
+			yf = FloatValueFormatter
+		}
+		if yfa == nil {
+			yfa = FloatValueFormatter
+		}
+
+		cx := cb.Left + xr.Translate(x)
+		cursorStyle.GetStrokeOptions().WriteToRenderer(r)
+		r.MoveTo(cx, cb.Top)
+		r.LineTo(cx, cb.Bottom)
+		r.Stroke()
+		r.ResetStyle()
+
+		labels := []string{xf(x)}
+		colors := []drawing.Color{cursorStyle.GetFontColor()}
+		for index, s := range rc.Series {
+			if !s.GetStyle().IsZero() && !s.GetStyle().Show {
+				continue
+			}
+			vp, isValueProvider := s.(ValueProvider)
+			if !isValueProvider {
+				continue
+			}
+			y, ok := cursorValueAt(vp, x)
+			if !ok {
+				continue
+			}
+
+			ra, vf := yr, yf
+			if s.GetYAxis() == YAxisSecondary {
+				ra, vf = yra, yfa
+			}
+			seriesStyle := s.GetStyle().InheritFrom(rc.styleDefaultsSeries(index))
+			Style{
+				StrokeColor: seriesStyle.GetStrokeColor(),
+				FillColor:   seriesStyle.GetStrokeColor(),
+				StrokeWidth: 1,
+			}.GetFillAndStrokeOptions().WriteToRenderer(r)
+			r.Circle(DefaultCursorDotRadius, cx, cb.Bottom-ra.Translate(y))
+			r.ResetStyle()
+
+			label := vf(y)
+			if name := GetLegendName(s); len(name) > 0 {
+				label = name + ": " + label
+			}
+			labels = append(labels, label)
+			colors = append(colors, seriesStyle.GetStrokeColor())
+		}
+
+		drawCursorReadout(r, cb, cx, labels, colors, cursorStyle)
+	}
+}
+
+// drawCursorReadout draws the readout lines in a box at the top of the cursor, right of it unless it would overflow
+// the canvas.
+func drawCursorReadout(r Renderer, cb Box, cx int, labels []string, colors []drawing.Color, style Style) {
+	style.GetTextOptions().WriteToRenderer(r)
+	var width, lineHeight int
+	for _, label := range labels {
+		tb := r.MeasureText(label)
+		width = Math.MaxInt(width, tb.Width())
+		lineHeight = Math.MaxInt(lineHeight, tb.Height())
+	}
+	padding := DefaultCursorReadoutPadding
+	readout := Box{
+		Top:    cb.Top,
+		Left:   cx + padding,
+		Right:  cx + padding + width + 2*padding,
+		Bottom: cb.Top + len(labels)*(lineHeight+padding) + padding,
+	}
+	if readout.Right > cb.Right {
+		readout.Left, readout.Right = cx-padding-(width+2*padding), cx-padding
+	}
+	Draw.Box(r, readout, Style{
+		FillColor:   style.GetFillColor(),
+		StrokeColor: style.GetStrokeColor(),
+		StrokeWidth: style.GetStrokeWidth(),
+	})
+
+	ty := readout.Top + padding
+	for index, label := range labels {
+		ty += lineHeight
+		Draw.Text(r, label, readout.Left+padding, ty, Style{FontColor: colors[index]}.InheritFrom(style))
+		ty += padding
+	}
+}
+
+// cursorValueAt returns the value of a series at an x value, interpolated between the values either side of it.
+func cursorValueAt(vp ValueProvider, x float64) (y float64, ok bool) {
+	for index := 0; index < vp.Len(); index++ {
+		x0, y0 := vp.GetValue(index)
+		if x0 == x {
+			return y0, true
+		}
+		if index+1 == vp.Len() {
+			break
+		}
+		x1, y1 := vp.GetValue(index + 1)
+		if (x0 < x && x < x1) || (x1 < x && x < x0) {
+			return y0 + (y1-y0)*(x-x0)/(x1-x0), true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,75 @@
+package chart
+
+import (
+	"bytes"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	assert "github.com/blendlabs/go-assert"
+)
+
+func TestCursorValueAt(t *testing.T) {
+	assert := assert.New(t)
+
+	cs := ContinuousSeries{XValues: []float64{1, 2, 4}, YValues: []float64{10, 20, 0}}
+
+	y, ok := cursorValueAt(cs, 2)
+	assert.True(ok)
+	assert.Equal(20.0, y)
+
+	y, ok = cursorValueAt(cs, 3)
+	assert.True(ok)
+	assert.Equal(10.0, y)
+
+	_, ok = cursorValueAt(cs, 5)
+	assert.False(ok)
+}
+
+func TestCursor(t *testing.T) {
+	assert := assert.New(t)
+
+	c := Chart{
+		Series: []Series{
+			ContinuousSeries{Name: "cpu", XValues: []float64{1, 2, 3}, YValues: []float64{1, 3, 2}},
+			ContinuousSeries{Name: "hidden", Style: Style{Show: false, StrokeWidth: 1}, XValues: []float64{1, 2, 3}, YValues: []float64{1, 2, 3}},
+		},
+	}
+	c.Elements = []Renderable{Cursor(&c, 1.5)}
+
+	buf := bytes.NewBuffer([]byte{})
+	assert.Nil(c.Render(SVG, buf))
+	svg := buf.String()
+	assert.True(strings.Contains(svg, ">1.50<"))
+	assert.True(strings.Contains(svg, ">cpu: 2.00<"))
+	assert.False(strings.Contains(svg, "hidden:"))
+
+	// nothing is drawn outside of the x range.
+	c.Elements = []Renderable{Cursor(&c, 10)}
+	buf = bytes.NewBuffer([]byte{})
+	assert.Nil(c.Render(SVG, buf))
+	assert.False(strings.Contains(buf.String(), "cpu:"))
+}
+
+func TestCursorSVGDots(t *testing.T) {
+	assert := assert.New(t)
+
+	js := JitterSeries{
+		InnerSeries: ContinuousSeries{XValues: []float64{1, 2, 3}, YValues: []float64{1, 2, 3}},
+		YJitter:     0.5,
+	}
+	info := &RenderInfo{IncludePoints: true}
+	c := Chart{Seed: 100, Info: info, Series: []Series{js}}
+	c.Elements = []Renderable{Cursor(&c, 2)}
+
+	buf := bytes.NewBuffer([]byte{})
+	assert.Nil(c.Render(SVG, buf))
+	match := regexp.MustCompile(`<circle cx="(\d+)" cy="(\d+)"`).FindStringSubmatch(buf.String())
+	assert.Len(match, 3, "the cursor dot is drawn as a circle")
+
+	// the dot sits on the seeded value the series drew.
+	point := info.Series[0].Points[1]
+	assert.Equal(strconv.Itoa(point.Left), match[1])
+	assert.Equal(strconv.Itoa(point.Top), match[2])
+}
@@ -0,0 +1,219 @@
+package chart
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// DataSource fetches the series for a chart, i.e. from a database or a metrics api.
+type DataSource interface {
+	Fetch(ctx context.Context) ([]Series, error)
+}
+
+// DataSourceFunc is a function that implements `DataSource`.
+type DataSourceFunc func(ctx context.Context) ([]Series, error)
+
+// Fetch implements `DataSource`.
+func (dsf DataSourceFunc) Fetch(ctx context.Context) ([]Series, error) {
+	return dsf(ctx)
+}
+
+// RefreshTarget receives each rendered chart from a `Refresher`.
+type RefreshTarget interface {
+	Publish(ctx context.Context, contents []byte) error
+}
+
+// RefreshTargetFunc is a function that implements `RefreshTarget`.
+type RefreshTargetFunc func(ctx context.Context, contents []byte) error
+
+// Publish implements `RefreshTarget`.
+func (rtf RefreshTargetFunc) Publish(ctx context.Context, contents []byte) error {
+	return rtf(ctx, contents)
+}
+
+// ContentTypeTarget is a refresh target that is also given the content type of each rendered chart.
+type ContentTypeTarget interface {
+	RefreshTarget
+	PublishContentType(ctx context.Context, contents []byte, contentType string) error
+}
+
+// FileTarget returns a refresh target that replaces a file with each rendered chart.
+// The chart is written to a temporary file next to the destination and renamed, so readers never see a partial file.
+// The file keeps the mode of the file it replaces, or is readable by everyone (0644) if it is new.
+func FileTarget(path string) RefreshTarget {
+	return RefreshTargetFunc(func(_ context.Context, contents []byte) error {
+		mode := os.FileMode(0644)
+		if info, err := os.Stat(path); err == nil {
+			mode = info.Mode().Perm()
+		}
+		temp, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path)+".tmp")
+		if err != nil {
+			return err
+		}
+		if err = temp.Chmod(mode); err != nil {
+			temp.Close()
+			os.Remove(temp.Name())
+			return err
+		}
+		if _, err = temp.Write(contents); err != nil {
+			temp.Close()
+			os.Remove(temp.Name())
+			return err
+		}
+		if err = temp.Close(); err != nil {
+			os.Remove(temp.Name())
+			return err
+		}
+		return os.Rename(temp.Name(), path)
+	})
+}
+
+// WriterTarget returns a refresh target that writes each rendered chart to a new writer, i.e. an upload to
+// an s3 compatible object store. The writer is closed after the chart is written.
+func WriterTarget(open func(ctx context.Context) (io.WriteCloser, error)) RefreshTarget {
+	return RefreshTargetFunc(func(ctx context.Context, contents []byte) error {
+		w, err := open(ctx)
+		if err != nil {
+			return err
+		}
+		if _, err = w.Write(contents); err != nil {
+			w.Close()
+			return err
+		}
+		return w.Close()
+	})
+}
+
+// CacheTarget is a refresh target that keeps the latest rendered chart in memory and serves it over http.
+type CacheTarget struct {
+	// ContentType is the served content type; it defaults to the content type of the published chart (when published
+	// by a `Refresher`) or `ContentTypePNG`.
+	ContentType string
+
+	lock        sync.RWMutex
+	contents    []byte
+	contentType string
+	updatedAt   time.Time
+}
+
+// Publish implements `RefreshTarget`.
+func (ct *CacheTarget) Publish(ctx context.Context, contents []byte) error {
+	return ct.PublishContentType(ctx, contents, "")
+}
+
+// PublishContentType implements `ContentTypeTarget`.
+func (ct *CacheTarget) PublishContentType(_ context.Context, contents []byte, contentType string) error {
+	ct.lock.Lock()
+	ct.contents = contents
+	ct.contentType = contentType
+	ct.updatedAt = time.Now().UTC()
+	ct.lock.Unlock()
+	return nil
+}
+
+// Contents returns the latest rendered chart and when it was published.
+func (ct *CacheTarget) Contents() ([]byte, time.Time) {
+	ct.lock.RLock()
+	defer ct.lock.RUnlock()
+	return ct.contents, ct.updatedAt
+}
+
+// ServeHTTP implements `http.Handler`, writing the latest rendered chart or a 503 if nothing has been published.
+func (ct *CacheTarget) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	contents, updatedAt := ct.Contents()
+	if contents == nil {
+		http.Error(rw, "chart has not been rendered yet", http.StatusServiceUnavailable)
+		return
+	}
+	contentType := ct.ContentType
+	if len(contentType) == 0 {
+		ct.lock.RLock()
+		contentType = ct.contentType
+		ct.lock.RUnlock()
+	}
+	if len(contentType) == 0 {
+		contentType = ContentTypePNG
+	}
+	rw.Header().Set("Content-Type", contentType)
+	http.ServeContent(rw, req, "", updatedAt, bytes.NewReader(contents))
+}
+
+// Refresher re-fetches a data source and re-renders a chart on an interval, publishing it to its targets.
+type Refresher struct {
+	Source DataSource
+	// Build returns the chart to render for the fetched series; by default the series are rendered as a `Chart`.
+	Build func(series []Series) RenderableChart
+	// Renderer is the renderer provider to use; it defaults to `PNG`.
+	Renderer RendererProvider
+	Interval time.Duration
+	Targets  []RefreshTarget
+	// Sinks also receive the rendered chart, along with its content type.
+	Sinks []RenderSink
+	// OnError is called with errors from scheduled refreshes; the refresher keeps running after an error.
+	OnError func(err error)
+}
+
+// Refresh fetches, renders and publishes the chart once.
+func (r Refresher) Refresh(ctx context.Context) error {
+	if r.Source == nil {
+		return errors.New("refresher has no data source")
+	}
+	series, err := r.Source.Fetch(ctx)
+	if err != nil {
+		return err
+	}
+
+	var c RenderableChart = Chart{Series: series}
+	if r.Build != nil {
+		c = r.Build(series)
+	}
+	rp, contentType := withContentType(r.Renderer)
+	results := RenderAll([]RenderJob{{Chart: c, Renderer: rp}}, 1)
+	if results[0].Err != nil {
+		return results[0].Err
+	}
+	for _, target := range r.Targets {
+		if ctt, isContentTypeTarget := target.(ContentTypeTarget); isContentTypeTarget {
+			err = ctt.PublishContentType(ctx, results[0].Contents, *contentType)
+		} else {
+			err = target.Publish(ctx, results[0].Contents)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	for _, sink := range r.Sinks {
+		if err = sink.Store(ctx, results[0].Contents, *contentType); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Run refreshes the chart immediately and then on each interval until the context is cancelled,
+// returning the context's error.
+func (r Refresher) Run(ctx context.Context) error {
+	if r.Interval <= 0 {
+		return errors.New("refresher interval must be positive")
+	}
+	ticker := time.NewTicker(r.Interval)
+	defer ticker.Stop()
+	for {
+		if err := r.Refresh(ctx); err != nil && r.OnError != nil && ctx.Err() == nil {
+			r.OnError(err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
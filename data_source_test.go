@@ -0,0 +1,117 @@
+package chart
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/blendlabs/go-assert"
+)
+
+func testDataSource(calls *int) DataSource {
+	return DataSourceFunc(func(_ context.Context) ([]Series, error) {
+		*calls++
+		return []Series{
+			ContinuousSeries{
+				XValues: []float64{1, 2, 3},
+				YValues: []float64{1, float64(*calls), 3},
+			},
+		}, nil
+	})
+}
+
+func TestRefresherRefresh(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "go-chart")
+	assert.Nil(err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "chart.svg")
+
+	var calls int
+	cache := &CacheTarget{}
+	buffer := &BufferSink{}
+	r := Refresher{
+		Source:   testDataSource(&calls),
+		Renderer: SVG,
+		Targets:  []RefreshTarget{FileTarget(path), cache},
+		Sinks:    []RenderSink{buffer},
+	}
+	assert.Nil(r.Refresh(context.Background()))
+	assert.Equal(1, calls)
+
+	contents, err := ioutil.ReadFile(path)
+	assert.Nil(err)
+	assert.Equal("<svg", string(contents[:4]))
+	info, err := os.Stat(path)
+	assert.Nil(err)
+	assert.Equal(os.FileMode(0644), info.Mode().Perm(), "the published chart is readable by everyone")
+
+	cached, updatedAt := cache.Contents()
+	assert.Equal(string(contents), string(cached))
+	assert.False(updatedAt.IsZero())
+	assert.Equal(string(contents), buffer.Buffer.String())
+	assert.Equal(ContentTypeSVG, buffer.ContentType)
+
+	res := httptest.NewRecorder()
+	cache.ServeHTTP(res, httptest.NewRequest("GET", "/chart.svg", nil))
+	assert.Equal(http.StatusOK, res.Code)
+	assert.Equal(ContentTypeSVG, res.Header().Get("Content-Type"))
+	assert.Equal(string(contents), res.Body.String())
+
+	// a replaced file keeps its mode.
+	assert.Nil(os.Chmod(path, 0600))
+	assert.Nil(r.Refresh(context.Background()))
+	info, err = os.Stat(path)
+	assert.Nil(err)
+	assert.Equal(os.FileMode(0600), info.Mode().Perm())
+}
+
+func TestRefresherRefreshError(t *testing.T) {
+	assert := assert.New(t)
+
+	r := Refresher{
+		Source: DataSourceFunc(func(_ context.Context) ([]Series, error) {
+			return nil, errors.New("fetch failed")
+		}),
+	}
+	assert.NotNil(r.Refresh(context.Background()))
+	assert.NotNil(Refresher{}.Refresh(context.Background()))
+
+	res := httptest.NewRecorder()
+	(&CacheTarget{}).ServeHTTP(res, httptest.NewRequest("GET", "/", nil))
+	assert.Equal(http.StatusServiceUnavailable, res.Code)
+}
+
+func TestRefresherRun(t *testing.T) {
+	assert := assert.New(t)
+
+	var calls int
+	published := make(chan struct{}, 8)
+	r := Refresher{
+		Source:   testDataSource(&calls),
+		Interval: time.Millisecond,
+		Targets: []RefreshTarget{RefreshTargetFunc(func(_ context.Context, _ []byte) error {
+			select {
+			case published <- struct{}{}:
+			default:
+			}
+			return nil
+		})},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- r.Run(ctx) }()
+	<-published
+	<-published
+	cancel()
+	assert.Equal(context.Canceled, <-done)
+	assert.True(calls >= 2)
+}
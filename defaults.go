@@ -28,12 +28,24 @@ const (
 	DefaultTitleFontSize = 18.0
 	// DefaultAnnotationDeltaWidth is the width of the left triangle out of annotations.
 	DefaultAnnotationDeltaWidth = 10
+	// DefaultAnnotationMarginGap is the space between the canvas (or the axes beside it) and margin annotations.
+	DefaultAnnotationMarginGap = 5
+	// DefaultCursorDotRadius is the radius of the dots a cursor draws where it crosses each series.
+	DefaultCursorDotRadius = 3.0
+	// DefaultCursorReadoutPadding is the padding of the lines of a cursor readout.
+	DefaultCursorReadoutPadding = 4
+	// DefaultStackTotalLabelMargin is the space between a stacked bar and its total label.
+	DefaultStackTotalLabelMargin = 5
+	// DefaultLabelChipRadius is the corner radius of label chips.
+	DefaultLabelChipRadius = 3
 	// DefaultAnnotationFontSize is the font size of annotations.
 	DefaultAnnotationFontSize = 10.0
 	// DefaultAxisFontSize is the font size of the axis labels.
 	DefaultAxisFontSize = 10.0
 	// DefaultTitleTop is the default distance from the top of the chart to put the title.
 	DefaultTitleTop = 10
+	// DefaultTitleBottom is the default distance between the title and the canvas (or the axes labels above it).
+	DefaultTitleBottom = 10
 
 	// DefaultBackgroundStrokeWidth is the default stroke on the chart background.
 	DefaultBackgroundStrokeWidth = 0.0
@@ -42,6 +54,10 @@ const (
 
 	// DefaultLineSpacing is the default vertical distance between lines of text.
 	DefaultLineSpacing = 5
+	// DefaultTextEllipsis is appended to text that has been truncated to fit.
+	DefaultTextEllipsis = "…"
+	// DefaultAnnotationMinWrapWidth is the narrowest an annotation label will be wrapped to.
+	DefaultAnnotationMinWrapWidth = 50
 
 	// DefaultYAxisMargin is the default distance from the right of the canvas to the y axis labels.
 	DefaultYAxisMargin = 10
@@ -65,6 +81,8 @@ const (
 
 	// DefaultDateFormat is the default date format.
 	DefaultDateFormat = "2006-01-02"
+	// DefaultDateMonthDayFormat is the date format for timestamps where the year is implied.
+	DefaultDateMonthDayFormat = "01-02"
 	// DefaultDateHourFormat is the date format for hour timestamp formats.
 	DefaultDateHourFormat = "01-02 3PM"
 	// DefaultDateMinuteFormat is the date format for minute range timestamp formats.
@@ -78,6 +96,23 @@ const (
 	DefaultBarSpacing = 100
 	// DefaultBarWidth is the default pixel width of bars in a bar chart.
 	DefaultBarWidth = 50
+
+	// DefaultTextMeasureCacheSize is the number of measured strings kept in the shared text measurement cache.
+	DefaultTextMeasureCacheSize = 1 << 14
+
+	// ContentTypePNG is the content type of png output.
+	ContentTypePNG = "image/png"
+	// ContentTypeSVG is the content type of svg output.
+	ContentTypeSVG = "image/svg+xml"
+	// ContentTypeEMF is the content type of emf output.
+	ContentTypeEMF = "image/emf"
+	// ContentTypeJSON is the content type of recorded draw traces.
+	ContentTypeJSON = "application/json"
+	// ContentTypeUnknown is the content type reported for renderers that don't report their own.
+	ContentTypeUnknown = "application/octet-stream"
+
+	// DefaultPaletteSize is the default (and maximum) number of colors in a paletted png.
+	DefaultPaletteSize = 256
 )
 
 var (
@@ -144,6 +179,10 @@ var (
 	DefaultAnnotationFillColor = ColorWhite
 	// DefaultGridLineColor is the default grid line color.
 	DefaultGridLineColor = ColorLightGray
+	// DefaultLabelChipFillColor is the default fill color of label chips.
+	DefaultLabelChipFillColor = ColorLightGray
+	// DefaultNowMarkerColor is the default color of the "now" marker of a relative time axis.
+	DefaultNowMarkerColor = ColorAlternateLightGray
 )
 
 var (
@@ -203,27 +242,23 @@ func GetAlternateColor(index int) drawing.Color {
 var (
 	// DefaultAnnotationPadding is the padding around an annotation.
 	DefaultAnnotationPadding = Box{Top: 5, Left: 5, Right: 5, Bottom: 5}
+	// DefaultLabelChipPadding is the padding around a label inside its chip.
+	DefaultLabelChipPadding = Box{Top: 2, Left: 4, Right: 4, Bottom: 2}
 	// DefaultBackgroundPadding is the default canvas padding config.
 	DefaultBackgroundPadding = Box{Top: 5, Left: 5, Right: 5, Bottom: 5}
 )
 
 var (
-	_defaultFontLock sync.Mutex
+	_defaultFontOnce sync.Once
 	_defaultFont     *truetype.Font
+	_defaultFontErr  error
 )
 
 // GetDefaultFont returns the default font (Roboto-Medium).
+// It is safe to call from multiple goroutines; the font is parsed once and shared.
 func GetDefaultFont() (*truetype.Font, error) {
-	if _defaultFont == nil {
-		_defaultFontLock.Lock()
-		defer _defaultFontLock.Unlock()
-		if _defaultFont == nil {
-			font, err := truetype.Parse(roboto)
-			if err != nil {
-				return nil, err
-			}
-			_defaultFont = font
-		}
-	}
-	return _defaultFont, nil
+	_defaultFontOnce.Do(func() {
+		_defaultFont, _defaultFontErr = truetype.Parse(roboto)
+	})
+	return _defaultFont, _defaultFontErr
 }
@@ -0,0 +1,220 @@
+package chart
+
+import (
+	"fmt"
+	"math"
+)
+
+const (
+	// DefaultDensityBandwidth is the default standard deviation, in pixels, of the density kernel.
+	DefaultDensityBandwidth = 15.0
+	// DefaultDensityCellSize is the default size, in pixels, of the cells the density is evaluated at.
+	DefaultDensityCellSize = 4
+	// DefaultDensityThreshold is the default normalized density below which cells are left empty.
+	DefaultDensityThreshold = 0.05
+)
+
+// DensitySeries draws a (weighted) 2d kernel density estimate of the inner series as a heat layer,
+// or quantized into evenly spaced bands of color if `Bands` is set. Add it before the scatter series it describes so the
+// points are drawn on top of it.
+// The density is estimated in pixel space, so the bandwidth is independent of the units of either axis.
+type DensitySeries struct {
+	Name  string
+	Style Style
+	YAxis YAxisType
+
+	InnerSeries ValueProvider
+	// Weights, if set, weighs each value of the inner series; it must be the same length as the inner series (when
+	// drawn without validating, values past the end of the weights weigh 1).
+	Weights []float64
+
+	// Bandwidth is the standard deviation of the gaussian kernel in pixels.
+	Bandwidth float64
+	// CellSize is the size of the cells the density is evaluated at in pixels.
+	CellSize int
+	// Threshold is the normalized density below which cells are not drawn (ignored if `Bands` is more than 1).
+	Threshold float64
+	// Bands, if set, quantizes the normalized density into as many evenly spaced levels; the lowest band is not drawn
+	// unless it is the only one, in which case the cells over the threshold are drawn.
+	Bands int
+	// ColorMap maps the normalized density to a color, it defaults to `ColorMapBlues`.
+	ColorMap ColorMap
+}
+
+// GetName returns the name of the series.
+func (ds DensitySeries) GetName() string {
+	return ds.Name
+}
+
+// GetStyle returns the series style.
+func (ds DensitySeries) GetStyle() Style {
+	return ds.Style
+}
+
+// GetYAxis returns which YAxis the series draws on.
+func (ds DensitySeries) GetYAxis() YAxisType {
+	return ds.YAxis
+}
+
+// Len returns the number of elements in the series.
+func (ds DensitySeries) Len() int {
+	return ds.InnerSeries.Len()
+}
+
+// GetValue gets a value at a given index.
+func (ds DensitySeries) GetValue(index int) (x, y float64) {
+	return ds.InnerSeries.GetValue(index)
+}
+
+// GetBandwidth returns the kernel bandwidth or a default.
+func (ds DensitySeries) GetBandwidth(defaults ...float64) float64 {
+	if ds.Bandwidth == 0 {
+		if len(defaults) > 0 {
+			return defaults[0]
+		}
+		return DefaultDensityBandwidth
+	}
+	return ds.Bandwidth
+}
+
+// GetCellSize returns the cell size or a default.
+func (ds DensitySeries) GetCellSize(defaults ...int) int {
+	if ds.CellSize == 0 {
+		if len(defaults) > 0 {
+			return defaults[0]
+		}
+		return DefaultDensityCellSize
+	}
+	return ds.CellSize
+}
+
+// GetThreshold returns the threshold or a default.
+func (ds DensitySeries) GetThreshold(defaults ...float64) float64 {
+	if ds.Threshold == 0 {
+		if len(defaults) > 0 {
+			return defaults[0]
+		}
+		return DefaultDensityThreshold
+	}
+	return ds.Threshold
+}
+
+// GetColorMap returns the color map or a default.
+func (ds DensitySeries) GetColorMap() ColorMap {
+	if ds.ColorMap == nil {
+		return ColorMapBlues
+	}
+	return ds.ColorMap
+}
+
+// Render renders the series.
+func (ds DensitySeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
+	cellSize := ds.GetCellSize()
+	density := ds.getDensity(canvasBox, xrange, yrange)
+	cm := ds.GetColorMap()
+
+	for row, cells := range density {
+		top := canvasBox.Top + row*cellSize
+		bottom := Math.MinInt(top+cellSize, canvasBox.Bottom)
+
+		// merge runs of cells of the same level into a single box to keep vector output small.
+		for col := 0; col < len(cells); {
+			level, show := ds.getLevel(cells[col])
+			end := col + 1
+			for end < len(cells) {
+				nextLevel, nextShow := ds.getLevel(cells[end])
+				if nextShow != show || nextLevel != level {
+					break
+				}
+				end++
+			}
+			if show {
+				color := cm(level)
+				Draw.Box(r, Box{
+					Top:    top,
+					Left:   canvasBox.Left + col*cellSize,
+					Right:  Math.MinInt(canvasBox.Left+end*cellSize, canvasBox.Right),
+					Bottom: bottom,
+				}, Style{FillColor: color, StrokeColor: color})
+			}
+			col = end
+		}
+	}
+}
+
+// getLevel returns the color map value of a normalized density, and if the cell should be drawn.
+func (ds DensitySeries) getLevel(v float64) (float64, bool) {
+	if ds.Bands == 1 {
+		return 1, v >= ds.GetThreshold()
+	}
+	if ds.Bands > 1 {
+		band := Math.MinInt(int(math.Floor(v*float64(ds.Bands))), ds.Bands-1)
+		return float64(band+1) / float64(ds.Bands), band > 0
+	}
+	return v, v >= ds.GetThreshold()
+}
+
+// getDensity evaluates the normalized (on [0,1]) density for each cell of the canvas, by row and then column.
+func (ds DensitySeries) getDensity(canvasBox Box, xrange, yrange Range) [][]float64 {
+	cellSize := ds.GetCellSize()
+	cols := (canvasBox.Width() + cellSize - 1) / cellSize
+	rows := (canvasBox.Height() + cellSize - 1) / cellSize
+
+	density := make([][]float64, rows)
+	for row := range density {
+		density[row] = make([]float64, cols)
+	}
+
+	bandwidth := ds.GetBandwidth()
+	reach := 3.0 * bandwidth
+	denominator := 2.0 * bandwidth * bandwidth
+
+	var max float64
+	for index := 0; index < ds.InnerSeries.Len(); index++ {
+		vx, vy := ds.InnerSeries.GetValue(index)
+		weight := 1.0
+		if index < len(ds.Weights) {
+			weight = ds.Weights[index]
+		}
+		if weight == 0 || math.IsNaN(vx) || math.IsNaN(vy) || math.IsNaN(weight) {
+			continue
+		}
+
+		px := float64(xrange.Translate(vx))
+		py := float64(canvasBox.Height() - yrange.Translate(vy))
+
+		minCol := Math.MaxInt(0, int(math.Floor((px-reach)/float64(cellSize))))
+		maxCol := Math.MinInt(cols-1, int(math.Floor((px+reach)/float64(cellSize))))
+		minRow := Math.MaxInt(0, int(math.Floor((py-reach)/float64(cellSize))))
+		maxRow := Math.MinInt(rows-1, int(math.Floor((py+reach)/float64(cellSize))))
+
+		for row := minRow; row <= maxRow; row++ {
+			dy := (float64(row)+0.5)*float64(cellSize) - py
+			for col := minCol; col <= maxCol; col++ {
+				dx := (float64(col)+0.5)*float64(cellSize) - px
+				density[row][col] += weight * math.Exp(-(dx*dx+dy*dy)/denominator)
+				max = math.Max(max, density[row][col])
+			}
+		}
+	}
+
+	if max > 0 {
+		for _, cells := range density {
+			for col := range cells {
+				cells[col] = math.Max(cells[col], 0) / max
+			}
+		}
+	}
+	return density
+}
+
+// Validate validates the series.
+func (ds DensitySeries) Validate() error {
+	if ds.InnerSeries == nil {
+		return fmt.Errorf("density series requires InnerSeries to be set")
+	}
+	if len(ds.Weights) > 0 && len(ds.Weights) != ds.InnerSeries.Len() {
+		return fmt.Errorf("density series weights must be the same length as the inner series")
+	}
+	return nil
+}
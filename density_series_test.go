@@ -0,0 +1,89 @@
+package chart
+
+import (
+	"testing"
+
+	assert "github.com/blendlabs/go-assert"
+)
+
+func TestDensitySeriesGetDensity(t *testing.T) {
+	assert := assert.New(t)
+
+	canvasBox := Box{Top: 0, Left: 0, Right: 100, Bottom: 100}
+	xrange := &ContinuousRange{Min: 0, Max: 100, Domain: 100}
+	yrange := &ContinuousRange{Min: 0, Max: 100, Domain: 100}
+
+	ds := DensitySeries{
+		InnerSeries: ContinuousSeries{XValues: []float64{10, 90}, YValues: []float64{90, 10}},
+		Weights:     []float64{1, 3},
+		CellSize:    10,
+		Bandwidth:   10,
+	}
+	assert.Nil(ds.Validate())
+
+	density := ds.getDensity(canvasBox, xrange, yrange)
+	assert.Len(density, 10)
+	assert.Len(density[0], 10)
+
+	// the point at (90,10) is in the bottom right (y is flipped) and carries the most weight.
+	assert.InDelta(1.0, density[9][9], 0.0001)
+	assert.True(density[0][0] > 0.2 && density[0][0] < 0.4)
+	assert.True(density[0][9] < 0.01)
+	assert.True(density[5][5] < density[0][0])
+}
+
+func TestDensitySeriesGetDensityShortWeights(t *testing.T) {
+	assert := assert.New(t)
+
+	canvasBox := Box{Top: 0, Left: 0, Right: 100, Bottom: 100}
+	xrange := &ContinuousRange{Min: 0, Max: 100, Domain: 100}
+	yrange := &ContinuousRange{Min: 0, Max: 100, Domain: 100}
+
+	ds := DensitySeries{
+		InnerSeries: ContinuousSeries{XValues: []float64{10, 90}, YValues: []float64{90, 10}},
+		Weights:     []float64{1},
+		CellSize:    10,
+		Bandwidth:   10,
+	}
+	assert.NotNil(ds.Validate())
+
+	density := ds.getDensity(canvasBox, xrange, yrange)
+	assert.InDelta(density[0][0], density[9][9], 0.0001)
+}
+
+func TestDensitySeriesGetLevel(t *testing.T) {
+	assert := assert.New(t)
+
+	ds := DensitySeries{}
+	_, show := ds.getLevel(0.01)
+	assert.False(show)
+	level, show := ds.getLevel(0.5)
+	assert.True(show)
+	assert.Equal(0.5, level)
+
+	ds.Bands = 4
+	_, show = ds.getLevel(0.2)
+	assert.False(show, "the lowest band is not drawn")
+	level, show = ds.getLevel(0.6)
+	assert.True(show)
+	assert.Equal(0.75, level)
+	level, _ = ds.getLevel(1.0)
+	assert.Equal(1.0, level)
+
+	ds.Bands = 1
+	_, show = ds.getLevel(0.01)
+	assert.False(show)
+	level, show = ds.getLevel(0.2)
+	assert.True(show, "a single band is drawn")
+	assert.Equal(1.0, level)
+}
+
+func TestDensitySeriesValidate(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.NotNil(DensitySeries{}.Validate())
+	assert.NotNil(DensitySeries{
+		InnerSeries: ContinuousSeries{XValues: []float64{1, 2}, YValues: []float64{1, 2}},
+		Weights:     []float64{1},
+	}.Validate())
+}
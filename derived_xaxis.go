@@ -0,0 +1,133 @@
+package chart
+
+import (
+	"math"
+)
+
+// DerivedXAxis is a secondary x-axis drawn along the top of the canvas that labels the same positions
+// as the primary x-axis in different units, i.e. timestamps on the bottom and hours since start on the top.
+// Because the ticks are positioned by the primary x range it always stays aligned with the primary x-axis.
+type DerivedXAxis struct {
+	Name      string
+	NameStyle Style
+
+	Style          Style
+	ValueFormatter ValueFormatter
+
+	// Convert maps a value on the primary x-axis to the units of this axis.
+	Convert func(v float64) float64
+	// Invert maps a value in the units of this axis back to the primary x-axis. If it is set, the ticks are placed
+	// at round values in this axis' units, otherwise the tick positions of the primary x-axis are labeled.
+	Invert func(v float64) float64
+
+	TickStyle Style
+}
+
+// GetName returns the name.
+func (dxa DerivedXAxis) GetName() string {
+	return dxa.Name
+}
+
+// GetStyle returns the style.
+func (dxa DerivedXAxis) GetStyle() Style {
+	return dxa.Style
+}
+
+// GetValueFormatter returns the value formatter for the axis' units, or `FloatValueFormatter` if unset.
+func (dxa DerivedXAxis) GetValueFormatter() ValueFormatter {
+	if dxa.ValueFormatter != nil {
+		return dxa.ValueFormatter
+	}
+	return FloatValueFormatter
+}
+
+// GetTicks returns the ticks for the axis; the tick values are in the units of the primary x-axis.
+func (dxa DerivedXAxis) GetTicks(r Renderer, ra Range, defaults Style, primaryTicks []Tick) []Tick {
+	if dxa.Convert == nil {
+		return nil
+	}
+	vf := dxa.GetValueFormatter()
+	tickStyle := dxa.Style.InheritFrom(defaults)
+
+	if dxa.Invert != nil {
+		from, to := dxa.Convert(ra.GetMin()), dxa.Convert(ra.GetMax())
+		derived := &ContinuousRange{
+			Min:    math.Min(from, to),
+			Max:    math.Max(from, to),
+			Domain: ra.GetDomain(),
+		}
+		var ticks []Tick
+		for _, t := range GenerateContinuousTicks(r, derived, false, tickStyle, vf) {
+			ticks = append(ticks, Tick{Value: dxa.Invert(t.Value), Label: t.Label})
+		}
+		return ticks
+	}
+
+	if len(primaryTicks) == 0 {
+		primaryTicks = GenerateContinuousTicks(r, ra, false, tickStyle, FloatValueFormatter)
+	}
+	ticks := make([]Tick, len(primaryTicks))
+	for index, t := range primaryTicks {
+		ticks[index] = Tick{Value: t.Value, Label: vf(dxa.Convert(t.Value))}
+	}
+	return ticks
+}
+
+// Measure returns the bounds of the axis.
+func (dxa DerivedXAxis) Measure(r Renderer, canvasBox Box, ra Range, defaults Style, ticks []Tick) Box {
+	tickStyle := dxa.TickStyle.InheritFrom(dxa.Style.InheritFrom(defaults))
+
+	var left, right, top = math.MaxInt32, 0, canvasBox.Top
+	for _, t := range ticks {
+		tb := Draw.MeasureText(r, t.Label, tickStyle.GetTextOptions())
+		tx := canvasBox.Left + ra.Translate(t.Value)
+
+		left = Math.MinInt(left, tx-tb.Width()>>1)
+		right = Math.MaxInt(right, tx+tb.Width()>>1)
+		top = Math.MinInt(top, canvasBox.Top-(DefaultXAxisMargin+tb.Height()))
+	}
+
+	if dxa.NameStyle.Show && len(dxa.Name) > 0 {
+		tb := Draw.MeasureText(r, dxa.Name, dxa.NameStyle.InheritFrom(defaults))
+		top -= DefaultXAxisMargin + tb.Height()
+	}
+
+	return Box{
+		Top:    top,
+		Left:   left,
+		Right:  right,
+		Bottom: canvasBox.Top,
+	}
+}
+
+// Render renders the axis.
+func (dxa DerivedXAxis) Render(r Renderer, canvasBox Box, ra Range, defaults Style, ticks []Tick) {
+	tickStyle := dxa.TickStyle.InheritFrom(dxa.Style.InheritFrom(defaults))
+
+	tickStyle.GetStrokeOptions().WriteToRenderer(r)
+	r.MoveTo(canvasBox.Left, canvasBox.Top)
+	r.LineTo(canvasBox.Right, canvasBox.Top)
+	r.Stroke()
+
+	var maxTextHeight int
+	for _, t := range ticks {
+		tx := canvasBox.Left + ra.Translate(t.Value)
+
+		tickStyle.GetStrokeOptions().WriteToRenderer(r)
+		r.MoveTo(tx, canvasBox.Top)
+		r.LineTo(tx, canvasBox.Top-DefaultVerticalTickHeight)
+		r.Stroke()
+
+		tb := Draw.MeasureText(r, t.Label, tickStyle)
+		Draw.Text(r, t.Label, tx-tb.Width()>>1, canvasBox.Top-DefaultXAxisMargin, tickStyle)
+		maxTextHeight = Math.MaxInt(maxTextHeight, tb.Height())
+	}
+
+	nameStyle := dxa.NameStyle.InheritFrom(defaults)
+	if dxa.NameStyle.Show && len(dxa.Name) > 0 {
+		tb := Draw.MeasureText(r, dxa.Name, nameStyle)
+		tx := canvasBox.Right - (canvasBox.Width()>>1 + tb.Width()>>1)
+		ty := canvasBox.Top - (DefaultXAxisMargin + maxTextHeight + DefaultXAxisMargin)
+		Draw.Text(r, dxa.Name, tx, ty, nameStyle)
+	}
+}
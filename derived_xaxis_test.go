@@ -0,0 +1,93 @@
+package chart
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/blendlabs/go-assert"
+)
+
+func TestDerivedXAxisGetTicks(t *testing.T) {
+	assert := assert.New(t)
+
+	r, err := PNG(1024, 1024)
+	assert.Nil(err)
+	f, err := GetDefaultFont()
+	assert.Nil(err)
+	defaults := Style{Font: f, FontSize: 10.0}
+
+	xr := &ContinuousRange{Min: 10, Max: 100, Domain: 1024}
+	primary := []Tick{{Value: 10, Label: "10"}, {Value: 55, Label: "55"}, {Value: 100, Label: "100"}}
+
+	dxa := DerivedXAxis{
+		Convert: func(v float64) float64 { return v * 2.0 },
+	}
+	ticks := dxa.GetTicks(r, xr, defaults, primary)
+	assert.Len(ticks, 3)
+	assert.Equal(55.0, ticks[1].Value)
+	assert.Equal("110.00", ticks[1].Label)
+
+	dxa.Invert = func(v float64) float64 { return v / 2.0 }
+	ticks = dxa.GetTicks(r, xr, defaults, primary)
+	assert.NotEmpty(ticks)
+	assert.Equal(10.0, ticks[0].Value)
+	assert.Equal("20.00", ticks[0].Label)
+	assert.Equal(100.0, ticks[len(ticks)-1].Value)
+	assert.Equal("200.00", ticks[len(ticks)-1].Label)
+
+	assert.Empty(DerivedXAxis{}.GetTicks(r, xr, defaults, primary))
+}
+
+func TestDerivedXAxisMeasure(t *testing.T) {
+	assert := assert.New(t)
+
+	r, err := PNG(100, 100)
+	assert.Nil(err)
+	f, err := GetDefaultFont()
+	assert.Nil(err)
+	defaults := Style{Font: f, FontSize: 10.0}
+
+	dxa := DerivedXAxis{}
+	xr := &ContinuousRange{Min: 0, Max: 10, Domain: 50}
+	ticks := []Tick{{Value: 0, Label: "0"}, {Value: 10, Label: "10"}}
+	canvasBox := Box{Top: 30, Left: 10, Right: 60, Bottom: 90}
+
+	box := dxa.Measure(r, canvasBox, xr, defaults, ticks)
+	assert.Equal(30, box.Bottom)
+	assert.True(box.Top < 30-DefaultXAxisMargin)
+}
+
+func TestChartDerivedXAxis(t *testing.T) {
+	assert := assert.New(t)
+
+	render := func(showDerived bool) Box {
+		c := Chart{
+			XAxis: XAxis{Style: StyleShow()},
+			XAxisSecondary: DerivedXAxis{
+				Style:   Style{Show: showDerived},
+				Convert: func(v float64) float64 { return v * 60.0 },
+			},
+			Series: []Series{
+				ContinuousSeries{
+					XValues: []float64{1.0, 2.0, 3.0},
+					YValues: []float64{1.0, 2.0, 3.0},
+				},
+			},
+		}
+		r, err := PNG(c.GetWidth(), c.GetHeight())
+		assert.Nil(err)
+		defaultFont, err := GetDefaultFont()
+		assert.Nil(err)
+		c.defaultFont = defaultFont
+
+		xr, yr, yra := c.getRanges()
+		canvasBox := c.getDefaultCanvasBox()
+		xr, yr, yra = c.setRangeDomains(canvasBox, xr, yr, yra)
+		xt, yt, yta := c.getAxesTicks(r, xr, yr, yra, FloatValueFormatter, FloatValueFormatter, FloatValueFormatter)
+		assert.Nil(c.Render(PNG, bytes.NewBuffer([]byte{})))
+		return c.getAxesAdjustedCanvasBox(r, canvasBox, xr, yr, yra, xt, yt, yta)
+	}
+
+	// the derived axis reserves space along the top of the canvas.
+	assert.True(render(true).Top > render(false).Top)
+}
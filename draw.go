@@ -10,49 +10,114 @@ var (
 type draw struct{}
 
 // LineSeries draws a line series with a renderer.
+// Styles with `FastPath` set draw without anti-aliasing (on renderers that support it) and simplified to the
+// first, lowest, highest and last point of each pixel column.
 func (d draw) LineSeries(r Renderer, canvasBox Box, xrange, yrange Range, style Style, vs ValueProvider) {
 	if vs.Len() == 0 {
 		return
 	}
+	if style.FastPath {
+		if aar, isAntiAliasRenderer := r.(AntiAliasRenderer); isAntiAliasRenderer {
+			aar.SetAntiAlias(false)
+			defer aar.SetAntiAlias(true)
+		}
+	}
 
-	cb := canvasBox.Bottom
-	cl := canvasBox.Left
+	points := d.linePoints(canvasBox, xrange, yrange, style, vs)
+	d.lineSeriesFill(r, canvasBox, yrange, style, points)
 
-	v0x, v0y := vs.GetValue(0)
-	x0 := cl + xrange.Translate(v0x)
-	y0 := cb - yrange.Translate(v0y)
+	style.GetStrokeOptions().WriteToRenderer(r)
+	r.MoveTo(points[0].X, points[0].Y)
+	for _, p := range points[1:] {
+		r.LineTo(p.X, p.Y)
+	}
+	r.Stroke()
+}
+
+// LineSeriesFill draws only the area fill of a line series, down to the zero line (or the bottom of the canvas).
+func (d draw) LineSeriesFill(r Renderer, canvasBox Box, xrange, yrange Range, style Style, vs ValueProvider) {
+	if vs.Len() == 0 || style.GetFillColor().IsZero() {
+		return
+	}
+	if style.FastPath {
+		if aar, isAntiAliasRenderer := r.(AntiAliasRenderer); isAntiAliasRenderer {
+			aar.SetAntiAlias(false)
+			defer aar.SetAntiAlias(true)
+		}
+	}
+	d.lineSeriesFill(r, canvasBox, yrange, style, d.linePoints(canvasBox, xrange, yrange, style, vs))
+}
+
+func (d draw) lineSeriesFill(r Renderer, canvasBox Box, yrange Range, style Style, points []Point) {
+	if style.GetFillColor().IsZero() {
+		return
+	}
 
+	cb := canvasBox.Bottom
 	yv0 := yrange.Translate(0)
+	first, last := points[0], points[len(points)-1]
 
-	var vx, vy float64
-	var x, y int
+	style.GetFillOptions().WriteToRenderer(r)
+	r.MoveTo(first.X, first.Y)
+	for _, p := range points[1:] {
+		r.LineTo(p.X, p.Y)
+	}
+	r.LineTo(last.X, Math.MinInt(cb, cb-yv0))
+	r.LineTo(first.X, Math.MinInt(cb, cb-yv0))
+	r.LineTo(first.X, first.Y)
+	r.Fill()
+}
+
+// linePoints returns the canvas positions of a series' values, simplified to the first, lowest, highest and last
+// point of each pixel column for styles with `FastPath` set.
+func (d draw) linePoints(canvasBox Box, xrange, yrange Range, style Style, vs ValueProvider) []Point {
+	cb := canvasBox.Bottom
+	cl := canvasBox.Left
 
-	fill := style.GetFillColor()
-	if !fill.IsZero() {
-		style.GetFillOptions().WriteToRenderer(r)
-		r.MoveTo(x0, y0)
-		for i := 1; i < vs.Len(); i++ {
+	var vx, vy float64
+	points := make([]Point, 0, vs.Len())
+	if !style.FastPath {
+		for i := 0; i < vs.Len(); i++ {
 			vx, vy = vs.GetValue(i)
-			x = cl + xrange.Translate(vx)
-			y = cb - yrange.Translate(vy)
-			r.LineTo(x, y)
+			points = append(points, Point{X: cl + xrange.Translate(vx), Y: cb - yrange.Translate(vy)})
 		}
-		r.LineTo(x, Math.MinInt(cb, cb-yv0))
-		r.LineTo(x0, Math.MinInt(cb, cb-yv0))
-		r.LineTo(x0, y0)
-		r.Fill()
+		return points
 	}
 
-	style.GetStrokeOptions().WriteToRenderer(r)
-
-	r.MoveTo(x0, y0)
-	for i := 1; i < vs.Len(); i++ {
+	var column [4]Point // first, lowest, highest, last
+	var lowIndex, highIndex int
+	flush := func() {
+		ordered := []Point{column[0], column[1], column[2], column[3]}
+		if highIndex < lowIndex {
+			ordered[1], ordered[2] = column[2], column[1]
+		}
+		for _, p := range ordered {
+			if len(points) == 0 || !points[len(points)-1].Equals(p) {
+				points = append(points, p)
+			}
+		}
+	}
+	for i := 0; i < vs.Len(); i++ {
 		vx, vy = vs.GetValue(i)
-		x = cl + xrange.Translate(vx)
-		y = cb - yrange.Translate(vy)
-		r.LineTo(x, y)
+		p := Point{X: cl + xrange.Translate(vx), Y: cb - yrange.Translate(vy)}
+		if i > 0 && p.X == column[0].X {
+			if p.Y > column[1].Y {
+				column[1], lowIndex = p, i
+			}
+			if p.Y < column[2].Y {
+				column[2], highIndex = p, i
+			}
+			column[3] = p
+			continue
+		}
+		if i > 0 {
+			flush()
+		}
+		column = [4]Point{p, p, p, p}
+		lowIndex, highIndex = i, i
 	}
-	r.Stroke()
+	flush()
+	return points
 }
 
 // BoundedSeries draws a series that implements BoundedValueProvider.
@@ -137,12 +202,56 @@ func (d draw) HistogramSeries(r Renderer, canvasBox Box, xrange, yrange Range, s
 	}
 }
 
+// HistogramOutline draws a value provider as a single step outline from 0; the steps meet halfway between the x values
+// and the outer steps are as wide as the bars of `HistogramSeries`.
+func (d draw) HistogramOutline(r Renderer, canvasBox Box, xrange, yrange Range, style Style, vs ValueProvider, barWidths ...int) {
+	if vs.Len() == 0 {
+		return
+	}
+
+	seriesLength := vs.Len()
+	barWidth := int(math.Floor(float64(xrange.GetDomain()) / float64(seriesLength)))
+	if len(barWidths) > 0 {
+		barWidth = barWidths[0]
+	}
+
+	cb := canvasBox.Bottom
+	cl := canvasBox.Left
+	y0 := cb - yrange.Translate(0)
+
+	xs := make([]int, seriesLength)
+	for index := range xs {
+		vx, _ := vs.GetValue(index)
+		xs[index] = cl + xrange.Translate(vx)
+	}
+
+	style.GetFillAndStrokeOptions().WriteToRenderer(r)
+	defer r.ResetStyle()
+
+	left := xs[0] - (barWidth >> 1)
+	r.MoveTo(left, y0)
+	for index := 0; index < seriesLength; index++ {
+		_, vy := vs.GetValue(index)
+		right := xs[index] + (barWidth >> 1)
+		if index < seriesLength-1 {
+			right = (xs[index] + xs[index+1]) >> 1
+		}
+		y := cb - yrange.Translate(vy)
+		r.LineTo(left, y)
+		r.LineTo(right, y)
+		left = right
+	}
+	r.LineTo(left, y0)
+	r.FillStroke()
+}
+
 // MeasureAnnotation measures how big an annotation would be.
 func (d draw) MeasureAnnotation(r Renderer, canvasBox Box, style Style, lx, ly int, label string) Box {
 	style.WriteToRenderer(r)
 	defer r.ResetStyle()
 
-	textBox := r.MeasureText(label)
+	lines := d.annotationLines(r, canvasBox, style, lx, label)
+	textBox := Text.MeasureLines(r, lines, style)
 	textWidth := textBox.Width()
 	textHeight := textBox.Height()
 	halfTextHeight := textHeight >> 1
@@ -168,10 +277,21 @@ func (d draw) MeasureAnnotation(r Renderer, canvasBox Box, style Style, lx, ly i
 
 // Annotation draws an anotation with a renderer.
 func (d draw) Annotation(r Renderer, canvasBox Box, style Style, lx, ly int, label string) {
+	d.annotation(r, canvasBox, style, lx, ly, label, false)
+}
+
+// RoundedAnnotation draws an annotation as a rounded chip beside its anchor, rather than a tag pointing at it.
+// It measures the same as `Annotation`.
+func (d draw) RoundedAnnotation(r Renderer, canvasBox Box, style Style, lx, ly int, label string) {
+	d.annotation(r, canvasBox, style, lx, ly, label, true)
+}
+
+func (d draw) annotation(r Renderer, canvasBox Box, style Style, lx, ly int, label string, rounded bool) {
 	style.GetTextOptions().WriteToRenderer(r)
 	defer r.ResetStyle()
 
-	textBox := r.MeasureText(label)
+	lines := d.annotationLines(r, canvasBox, style, lx, label)
+	textBox := Text.MeasureLines(r, lines, style)
 	textWidth := textBox.Width()
 	halfTextHeight := textBox.Height() >> 1
 
@@ -183,7 +303,7 @@ func (d draw) Annotation(r Renderer, canvasBox Box, style Style, lx, ly int, lab
 	pb := style.Padding.GetBottom(DefaultAnnotationPadding.Bottom)
 
 	textX := lx + pl + DefaultAnnotationDeltaWidth
-	textY := ly + halfTextHeight
+	textY := ly + halfTextHeight - textBox.Height()
 
 	ltx := lx + DefaultAnnotationDeltaWidth
 	lty := ly - (pt + halfTextHeight)
@@ -197,17 +317,38 @@ func (d draw) Annotation(r Renderer, canvasBox Box, style Style, lx, ly int, lab
 	lbx := lx + DefaultAnnotationDeltaWidth
 	lby := ly + (pb + halfTextHeight)
 
-	r.MoveTo(lx, ly)
-	r.LineTo(ltx, lty)
-	r.LineTo(rtx, rty)
-	r.LineTo(rbx, rby)
-	r.LineTo(lbx, lby)
-	r.LineTo(lx, ly)
-	r.Close()
+	if rounded {
+		d.roundedBoxPath(r, Box{Top: lty, Left: ltx, Right: rbx, Bottom: rby}, DefaultLabelChipRadius)
+	} else {
+		r.MoveTo(lx, ly)
+		r.LineTo(ltx, lty)
+		r.LineTo(rtx, rty)
+		r.LineTo(rbx, rby)
+		r.LineTo(lbx, lby)
+		r.LineTo(lx, ly)
+		r.Close()
+	}
 	r.FillStroke()
 
 	style.GetTextOptions().WriteToRenderer(r)
-	r.Text(label, textX, textY)
+	for _, line := range lines {
+		lineHeight := r.MeasureText(line).Height()
+		r.Text(line, textX, textY+lineHeight)
+		textY += lineHeight + style.GetTextLineSpacing()
+	}
+}
+
+// annotationLines wraps an annotation label if the style has a wrap option set.
+// Unless the style sets a max width, labels are wrapped to the space left between the annotation and the
+// right edge of the canvas.
+func (d draw) annotationLines(r Renderer, canvasBox Box, style Style, lx int, label string) []string {
+	if style.TextWrap == TextWrapUnset {
+		return []string{label}
+	}
+	pl := style.Padding.GetLeft(DefaultAnnotationPadding.Left)
+	pr := style.Padding.GetRight(DefaultAnnotationPadding.Right)
+	width := style.GetTextMaxWidth(Math.MaxInt(canvasBox.Right-(lx+pl+pr+DefaultAnnotationDeltaWidth), DefaultAnnotationMinWrapWidth))
+	return Text.Wrap(r, label, width, style)
 }
 
 // Box draws a box with a given style.
@@ -223,6 +364,74 @@ func (d draw) Box(r Renderer, b Box, s Style) {
 	r.FillStroke()
 }
 
+// RoundedBox draws a box with rounded corners with a given style.
+func (d draw) RoundedBox(r Renderer, b Box, radius int, s Style) {
+	s.GetFillAndStrokeOptions().WriteToRenderer(r)
+	defer r.ResetStyle()
+
+	d.roundedBoxPath(r, b, radius)
+	r.FillStroke()
+}
+
+// LabelChip draws a filled, rounded chip behind a label's text box; the style's padding (or
+// `DefaultLabelChipPadding`) is added around the text box.
+func (d draw) LabelChip(r Renderer, textBox Box, s Style) {
+	padding := labelChipPadding(s)
+	d.RoundedBox(r, Box{
+		Top:    textBox.Top - padding.Top,
+		Left:   textBox.Left - padding.Left,
+		Right:  textBox.Right + padding.Right,
+		Bottom: textBox.Bottom + padding.Bottom,
+	}, DefaultLabelChipRadius, s.InheritFrom(Style{FillColor: DefaultLabelChipFillColor}))
+}
+
+// labelChipPadding returns the padding of a label chip style.
+func labelChipPadding(s Style) Box {
+	return Box{
+		Top:    s.Padding.GetTop(DefaultLabelChipPadding.Top),
+		Left:   s.Padding.GetLeft(DefaultLabelChipPadding.Left),
+		Right:  s.Padding.GetRight(DefaultLabelChipPadding.Right),
+		Bottom: s.Padding.GetBottom(DefaultLabelChipPadding.Bottom),
+	}
+}
+
+func (d draw) roundedBoxPath(r Renderer, b Box, radius int) {
+	radius = Math.MinInt(radius, Math.MinInt(b.Width(), b.Height())>>1)
+	r.MoveTo(b.Left+radius, b.Top)
+	r.LineTo(b.Right-radius, b.Top)
+	r.QuadCurveTo(b.Right, b.Top, b.Right, b.Top+radius)
+	r.LineTo(b.Right, b.Bottom-radius)
+	r.QuadCurveTo(b.Right, b.Bottom, b.Right-radius, b.Bottom)
+	r.LineTo(b.Left+radius, b.Bottom)
+	r.QuadCurveTo(b.Left, b.Bottom, b.Left, b.Bottom-radius)
+	r.LineTo(b.Left, b.Top+radius)
+	r.QuadCurveTo(b.Left, b.Top, b.Left+radius, b.Top)
+	r.Close()
+}
+
+// BoxBorder draws the shown sides of a border around a box; the sides inherit their stroke from the defaults.
+func (d draw) BoxBorder(r Renderer, b Box, border Border, defaults Style) {
+	sides := []struct {
+		style          Style
+		x0, y0, x1, y1 int
+	}{
+		{border.Top, b.Left, b.Top, b.Right, b.Top},
+		{border.Right, b.Right, b.Top, b.Right, b.Bottom},
+		{border.Bottom, b.Right, b.Bottom, b.Left, b.Bottom},
+		{border.Left, b.Left, b.Bottom, b.Left, b.Top},
+	}
+	for _, side := range sides {
+		if !side.style.Show {
+			continue
+		}
+		side.style.InheritFrom(defaults).GetStrokeOptions().WriteToRenderer(r)
+		r.MoveTo(side.x0, side.y0)
+		r.LineTo(side.x1, side.y1)
+		r.Stroke()
+		r.ResetStyle()
+	}
+}
+
 func (d draw) BoxRotated(r Renderer, b Box, thetaDegrees float64, s Style) {
 	d.BoxCorners(r, b.Corners().Rotate(thetaDegrees), s)
 }
@@ -0,0 +1,77 @@
+package chart
+
+import (
+	"testing"
+
+	"github.com/blendlabs/go-assert"
+	"github.com/wcharczuk/go-chart/drawing"
+)
+
+func TestDrawLinePointsFastPath(t *testing.T) {
+	assert := assert.New(t)
+
+	xrange := &ContinuousRange{Min: 0, Max: 9, Domain: 3}
+	yrange := &ContinuousRange{Min: 0, Max: 10, Domain: 10}
+	vs := ContinuousSeries{
+		XValues: []float64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9},
+		YValues: []float64{5, 9, 1, 5, 5, 5, 2, 8, 3, 4},
+	}
+	canvasBox := Box{Top: 0, Left: 0, Right: 3, Bottom: 10}
+
+	full := Draw.linePoints(canvasBox, xrange, yrange, Style{}, vs)
+	assert.Len(full, 10)
+
+	fast := Draw.linePoints(canvasBox, xrange, yrange, Style{FastPath: true}, vs)
+	assert.True(len(fast) < len(full))
+	assert.Equal(full[0], fast[0])
+	assert.Equal(full[len(full)-1], fast[len(fast)-1])
+
+	// each pixel column keeps its lowest and highest point.
+	for _, p := range full {
+		var top, bottom = p.Y, p.Y
+		for _, f := range fast {
+			if f.X == p.X {
+				top, bottom = Math.MinInt(top, f.Y), Math.MaxInt(bottom, f.Y)
+			}
+		}
+		assert.True(top <= p.Y && bottom >= p.Y)
+	}
+}
+
+func TestDrawLineSeriesFastPathAliased(t *testing.T) {
+	assert := assert.New(t)
+
+	c := Chart{
+		Width:  64,
+		Height: 64,
+		Background: Style{
+			Padding: Box{Top: 1, Left: 1, Right: 1, Bottom: 1},
+		},
+		XAxis: XAxis{ValueFormatter: FloatValueFormatter},
+		YAxis: YAxis{ValueFormatter: FloatValueFormatter},
+		Series: []Series{
+			ContinuousSeries{
+				Style:   Style{Show: true, StrokeWidth: 3, StrokeColor: drawing.ColorBlack, FastPath: true},
+				XValues: []float64{0, 1},
+				YValues: []float64{0, 0.7},
+			},
+		},
+	}
+
+	iw := &ImageWriter{}
+	assert.Nil(c.Render(PNG, iw))
+	img, err := iw.Image()
+	assert.Nil(err)
+
+	var partial int
+	bounds := img.Bounds()
+	for x := bounds.Min.X; x < bounds.Max.X; x++ {
+		for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+			r, _, _, _ := img.At(x, y).RGBA()
+			if r != 0 && r != 0xffff {
+				partial++
+			}
+		}
+	}
+	assert.Zero(partial, "an aliased line has no partially covered pixels")
+}
@@ -0,0 +1,39 @@
+package drawing
+
+import "github.com/golang/freetype/raster"
+
+// AliasedPainter is a painter that turns off anti-aliasing, painting spans that are at least half covered
+// fully opaque and dropping the rest.
+type AliasedPainter struct {
+	Painter
+
+	spans []raster.Span
+}
+
+// Paint implements raster.Painter by thresholding each span's alpha.
+func (ap *AliasedPainter) Paint(ss []raster.Span, done bool) {
+	ap.spans = ap.spans[:0]
+	for _, s := range ss {
+		if s.Alpha >= 0x8000 {
+			ap.spans = append(ap.spans, raster.Span{Y: s.Y, X0: s.X0, X1: s.X1, Alpha: 0xffff})
+		}
+	}
+	if len(ap.spans) > 0 || done {
+		ap.Painter.Paint(ap.spans, done)
+	}
+}
+
+// SetAntiAlias turns anti-aliasing of subsequent drawing on or off; it is on by default.
+func (rgc *RasterGraphicContext) SetAntiAlias(antiAlias bool) {
+	// the aliasing painter goes under the clip painter, if any, so clipping can still be reset.
+	target := &rgc.painter
+	if cp, isClipped := rgc.painter.(*ClipPainter); isClipped {
+		target = &cp.Painter
+	}
+	ap, isAliased := (*target).(*AliasedPainter)
+	if antiAlias && isAliased {
+		*target = ap.Painter
+	} else if !antiAlias && !isAliased {
+		*target = &AliasedPainter{Painter: *target}
+	}
+}
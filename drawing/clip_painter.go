@@ -0,0 +1,74 @@
+package drawing
+
+import (
+	"image"
+
+	"github.com/golang/freetype/raster"
+)
+
+// ClipPainter is a painter that clips the spans it paints to an alpha mask.
+type ClipPainter struct {
+	Painter
+	Mask *image.Alpha
+
+	spans []raster.Span
+}
+
+// Paint implements raster.Painter by scaling each span's alpha by the mask alpha under it.
+func (cp *ClipPainter) Paint(ss []raster.Span, done bool) {
+	cp.spans = cp.spans[:0]
+	bounds := cp.Mask.Bounds()
+	for _, s := range ss {
+		if s.Y < bounds.Min.Y || s.Y >= bounds.Max.Y {
+			continue
+		}
+		x0, x1 := s.X0, s.X1
+		if x0 < bounds.Min.X {
+			x0 = bounds.Min.X
+		}
+		if x1 > bounds.Max.X {
+			x1 = bounds.Max.X
+		}
+		for x := x0; x < x1; {
+			m := cp.Mask.AlphaAt(x, s.Y).A
+			end := x + 1
+			for end < x1 && cp.Mask.AlphaAt(end, s.Y).A == m {
+				end++
+			}
+			if m != 0 {
+				cp.spans = append(cp.spans, raster.Span{Y: s.Y, X0: x, X1: end, Alpha: s.Alpha * uint32(m) / 0xff})
+			}
+			x = end
+		}
+	}
+	if len(cp.spans) > 0 || done {
+		cp.Painter.Paint(cp.spans, done)
+	}
+}
+
+// Clip clips subsequent drawing to the current path, and clears the path.
+// A clip replaces any previous clip rather than intersecting it.
+func (rgc *RasterGraphicContext) Clip(paths ...*Path) {
+	rgc.ResetClip()
+
+	paths = append(paths, rgc.current.Path)
+	rgc.fillRasterizer.UseNonZeroWinding = rgc.current.FillRule == FillRuleWinding
+	flattener := Transformer{Tr: rgc.current.Tr, Flattener: FtLineBuilder{Adder: rgc.fillRasterizer}}
+	for _, p := range paths {
+		Flatten(p, flattener, rgc.current.Tr.GetScale())
+	}
+
+	mask := image.NewAlpha(rgc.img.Bounds())
+	rgc.fillRasterizer.Rasterize(raster.NewAlphaSrcPainter(mask))
+	rgc.fillRasterizer.Clear()
+	rgc.current.Path.Clear()
+
+	rgc.painter = &ClipPainter{Painter: rgc.painter, Mask: mask}
+}
+
+// ResetClip removes the clip, if any.
+func (rgc *RasterGraphicContext) ResetClip() {
+	if cp, isClipped := rgc.painter.(*ClipPainter); isClipped {
+		rgc.painter = cp.Painter
+	}
+}
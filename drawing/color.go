@@ -2,6 +2,7 @@ package drawing
 
 import (
 	"fmt"
+	"math"
 	"strconv"
 )
 
@@ -88,6 +89,83 @@ func (c Color) WithAlpha(a uint8) Color {
 	}
 }
 
+// RotateHue returns a copy of the color with its hue rotated by a number of degrees, keeping its saturation and lightness.
+func (c Color) RotateHue(degrees float64) Color {
+	r, g, b := float64(c.R)/255.0, float64(c.G)/255.0, float64(c.B)/255.0
+	max, min := math.Max(r, math.Max(g, b)), math.Min(r, math.Min(g, b))
+	l := (max + min) / 2.0
+	if max == min {
+		return c
+	}
+
+	d := max - min
+	s := d / (1.0 - math.Abs(2.0*l-1.0))
+	var h float64
+	switch max {
+	case r:
+		h = math.Mod((g-b)/d, 6.0)
+	case g:
+		h = (b-r)/d + 2.0
+	default:
+		h = (r-g)/d + 4.0
+	}
+	h = math.Mod(h*60.0+degrees, 360.0)
+	if h < 0 {
+		h += 360.0
+	}
+
+	chroma := (1.0 - math.Abs(2.0*l-1.0)) * s
+	x := chroma * (1.0 - math.Abs(math.Mod(h/60.0, 2.0)-1.0))
+	m := l - chroma/2.0
+	var r1, g1, b1 float64
+	switch {
+	case h < 60:
+		r1, g1 = chroma, x
+	case h < 120:
+		r1, g1 = x, chroma
+	case h < 180:
+		g1, b1 = chroma, x
+	case h < 240:
+		g1, b1 = x, chroma
+	case h < 300:
+		r1, b1 = x, chroma
+	default:
+		r1, b1 = chroma, x
+	}
+	return Color{
+		R: uint8(math.Floor((r1+m)*255.0 + 0.5)),
+		G: uint8(math.Floor((g1+m)*255.0 + 0.5)),
+		B: uint8(math.Floor((b1+m)*255.0 + 0.5)),
+		A: c.A,
+	}
+}
+
+// Luminance returns the relative luminance of the color on the interval [0,1], as defined by WCAG 2.0, ignoring alpha.
+func (c Color) Luminance() float64 {
+	channel := func(v uint8) float64 {
+		fv := float64(v) / 255.0
+		if fv <= 0.03928 {
+			return fv / 12.92
+		}
+		return math.Pow((fv+0.055)/1.055, 2.4)
+	}
+	return 0.2126*channel(c.R) + 0.7152*channel(c.G) + 0.0722*channel(c.B)
+}
+
+// BlendOver returns the opaque color seen when the color is drawn over a background color.
+func (c Color) BlendOver(background Color) Color {
+	alpha := float64(c.A) / 255.0
+	blend := func(fg, bg uint8) uint8 {
+		return uint8(math.Floor(float64(fg)*alpha + float64(bg)*(1.0-alpha) + 0.5))
+	}
+	return Color{
+		R: blend(c.R, background.R),
+		G: blend(c.G, background.G),
+		B: blend(c.B, background.B),
+		A: 255,
+	}
+}
+
 // String returns a css string representation of the color.
 func (c Color) String() string {
 	fa := float64(c.A) / float64(255)
@@ -0,0 +1,153 @@
+package drawing
+
+import "math"
+
+// The D65 reference white used for the CIE L*a*b* conversions.
+const (
+	labWhiteX = 0.95047
+	labWhiteY = 1.0
+	labWhiteZ = 1.08883
+)
+
+// ColorFromLab returns a color from CIE L*a*b* components, clamping colors outside of the srgb gamut.
+func ColorFromLab(l, a, b float64, alpha uint8) Color {
+	r, g, bl, _ := labToRGB(l, a, b)
+	return Color{R: colorChannel(r), G: colorChannel(g), B: colorChannel(bl), A: alpha}
+}
+
+// ColorFromHCL returns a color from CIE LCh(ab) components (hue in degrees, chroma and lightness on the L*a*b* scale).
+// Colors outside of the srgb gamut keep their hue and lightness and have their chroma reduced until they fit.
+func ColorFromHCL(h, c, l float64, alpha uint8) Color {
+	a, b := hclToLab(h, c)
+	if _, _, _, ok := labToRGB(l, a, b); ok || c <= 0 {
+		return ColorFromLab(l, a, b, alpha)
+	}
+
+	low, high := 0.0, c
+	for x := 0; x < 16; x++ {
+		mid := (low + high) / 2.0
+		a, b = hclToLab(h, mid)
+		if _, _, _, ok := labToRGB(l, a, b); ok {
+			low = mid
+		} else {
+			high = mid
+		}
+	}
+	a, b = hclToLab(h, low)
+	return ColorFromLab(l, a, b, alpha)
+}
+
+// Lab returns the CIE L*a*b* components of the color, ignoring alpha. Lightness is on the interval [0,100].
+func (c Color) Lab() (l, a, b float64) {
+	r, g, bl := linearChannel(c.R), linearChannel(c.G), linearChannel(c.B)
+	x := (0.4124564*r + 0.3575761*g + 0.1804375*bl) / labWhiteX
+	y := (0.2126729*r + 0.7151522*g + 0.0721750*bl) / labWhiteY
+	z := (0.0193339*r + 0.1191920*g + 0.9503041*bl) / labWhiteZ
+
+	fx, fy, fz := labF(x), labF(y), labF(z)
+	return 116.0*fy - 16.0, 500.0 * (fx - fy), 200.0 * (fy - fz)
+}
+
+// HCL returns the CIE LCh(ab) components of the color: hue in degrees on [0,360), chroma and lightness.
+// Grays have a hue of 0.
+func (c Color) HCL() (h, chroma, l float64) {
+	l, a, b := c.Lab()
+	chroma = math.Sqrt(a*a + b*b)
+	if chroma < 1e-9 {
+		return 0, 0, l
+	}
+	h = math.Mod(math.Atan2(b, a)*180.0/math.Pi+360.0, 360.0)
+	return
+}
+
+// Lighten returns a copy of the color with its L*a*b* lightness raised by a share (on the interval [0,1]) of the
+// full lightness range; negative amounts darken the color.
+func (c Color) Lighten(amount float64) Color {
+	h, chroma, l := c.HCL()
+	return ColorFromHCL(h, chroma, math.Max(0, math.Min(100, l+amount*100.0)), c.A)
+}
+
+// Darken returns a copy of the color with its L*a*b* lightness lowered by a share of the full lightness range.
+func (c Color) Darken(amount float64) Color {
+	return c.Lighten(-amount)
+}
+
+// InterpolateLab returns the color a share t (on the interval [0,1]) of the way to another color in L*a*b* space.
+func (c Color) InterpolateLab(to Color, t float64) Color {
+	l1, a1, b1 := c.Lab()
+	l2, a2, b2 := to.Lab()
+	return ColorFromLab(lerp(l1, l2, t), lerp(a1, a2, t), lerp(b1, b2, t), uint8(math.Floor(lerp(float64(c.A), float64(to.A), t)+0.5)))
+}
+
+// InterpolateHCL returns the color a share t (on the interval [0,1]) of the way to another color in HCL space,
+// taking the shorter way around the hue circle. Grays take on the hue of the other color.
+func (c Color) InterpolateHCL(to Color, t float64) Color {
+	h1, c1, l1 := c.HCL()
+	h2, c2, l2 := to.HCL()
+	if c1 == 0 {
+		h1 = h2
+	}
+	if c2 == 0 {
+		h2 = h1
+	}
+	dh := math.Mod(h2-h1+540.0, 360.0) - 180.0
+	return ColorFromHCL(h1+dh*t, lerp(c1, c2, t), lerp(l1, l2, t), uint8(math.Floor(lerp(float64(c.A), float64(to.A), t)+0.5)))
+}
+
+func hclToLab(h, c float64) (a, b float64) {
+	radians := h * math.Pi / 180.0
+	return c * math.Cos(radians), c * math.Sin(radians)
+}
+
+// labToRGB returns the srgb components of a L*a*b* color on the interval [0,1], and if they are within the gamut.
+func labToRGB(l, a, b float64) (r, g, bl float64, ok bool) {
+	fy := (l + 16.0) / 116.0
+	fx := fy + a/500.0
+	fz := fy - b/200.0
+	x, y, z := labFInverse(fx)*labWhiteX, labFInverse(fy)*labWhiteY, labFInverse(fz)*labWhiteZ
+
+	r = gammaChannel(3.2404542*x - 1.5371385*y - 0.4985314*z)
+	g = gammaChannel(-0.9692660*x + 1.8760108*y + 0.0415560*z)
+	bl = gammaChannel(0.0556434*x - 0.2040259*y + 1.0572252*z)
+
+	const epsilon = 0.5 / 255.0
+	ok = r >= -epsilon && r <= 1+epsilon && g >= -epsilon && g <= 1+epsilon && bl >= -epsilon && bl <= 1+epsilon
+	return
+}
+
+func labF(t float64) float64 {
+	if t > 216.0/24389.0 {
+		return math.Cbrt(t)
+	}
+	return (24389.0/27.0*t + 16.0) / 116.0
+}
+
+func labFInverse(t float64) float64 {
+	if t3 := t * t * t; t3 > 216.0/24389.0 {
+		return t3
+	}
+	return (116.0*t - 16.0) * 27.0 / 24389.0
+}
+
+func linearChannel(v uint8) float64 {
+	fv := float64(v) / 255.0
+	if fv <= 0.04045 {
+		return fv / 12.92
+	}
+	return math.Pow((fv+0.055)/1.055, 2.4)
+}
+
+func gammaChannel(v float64) float64 {
+	if v <= 0.0031308 {
+		return 12.92 * v
+	}
+	return 1.055*math.Pow(v, 1.0/2.4) - 0.055
+}
+
+func colorChannel(v float64) uint8 {
+	return uint8(math.Floor(math.Max(0, math.Min(1, v))*255.0 + 0.5))
+}
+
+func lerp(from, to, t float64) float64 {
+	return from + (to-from)*t
+}
@@ -39,3 +39,83 @@ func TestColorFromHex(t *testing.T) {
 	shortBlue := ColorFromHex("00F")
 	assert.Equal(ColorBlue, shortBlue)
 }
+
+func TestColorRotateHue(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(ColorGreen, ColorRed.RotateHue(120))
+	assert.Equal(ColorBlue, ColorRed.RotateHue(240))
+	assert.Equal(ColorBlue, ColorRed.RotateHue(-120))
+	assert.Equal(ColorRed, ColorRed.RotateHue(360))
+	assert.Equal(ColorWhite, ColorWhite.RotateHue(90), "grays have no hue to rotate")
+
+	faded := ColorRed.WithAlpha(64).RotateHue(120)
+	assert.Equal(uint8(64), faded.A)
+}
+
+func TestColorLuminanceAndBlendOver(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.InDelta(1.0, ColorWhite.Luminance(), 0.0001)
+	assert.InDelta(0.0, ColorBlack.Luminance(), 0.0001)
+	assert.InDelta(0.7152, ColorGreen.Luminance(), 0.0001)
+
+	assert.Equal(ColorRed, ColorRed.BlendOver(ColorBlue))
+	assert.Equal(Color{R: 128, G: 0, B: 127, A: 255}, ColorRed.WithAlpha(128).BlendOver(ColorBlue))
+	assert.Equal(ColorBlue, ColorTransparent.BlendOver(ColorBlue))
+}
+
+func TestColorLabAndHCL(t *testing.T) {
+	assert := assert.New(t)
+
+	l, a, b := ColorWhite.Lab()
+	assert.InDelta(100.0, l, 0.01)
+	assert.InDelta(0.0, a, 0.01)
+	assert.InDelta(0.0, b, 0.01)
+
+	l, a, b = ColorRed.Lab()
+	assert.InDelta(53.24, l, 0.01)
+	assert.InDelta(80.09, a, 0.01)
+	assert.InDelta(67.20, b, 0.01)
+
+	for _, c := range []Color{ColorRed, ColorGreen, ColorBlue, ColorFromHex("417CBF"), ColorFromHex("E69F00")} {
+		l, a, b = c.Lab()
+		assert.Equal(c, ColorFromLab(l, a, b, 255))
+		h, chroma, l := c.HCL()
+		assert.Equal(c, ColorFromHCL(h, chroma, l, 255))
+	}
+
+	h, chroma, _ := ColorBlack.HCL()
+	assert.Zero(h)
+	assert.Zero(chroma)
+}
+
+func TestColorLightenDarken(t *testing.T) {
+	assert := assert.New(t)
+
+	base := ColorFromHex("417CBF").WithAlpha(128)
+	_, _, l := base.HCL()
+	_, _, lighter := base.Lighten(0.2).HCL()
+	_, _, darker := base.Darken(0.2).HCL()
+	assert.InDelta(l+20, lighter, 0.5)
+	assert.InDelta(l-20, darker, 0.5)
+	assert.Equal(uint8(128), base.Lighten(0.2).A)
+	assert.Equal(ColorWhite, ColorWhite.Lighten(0.5))
+}
+
+func TestColorInterpolate(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(ColorRed, ColorRed.InterpolateHCL(ColorBlue, 0))
+	assert.Equal(ColorBlue, ColorRed.InterpolateHCL(ColorBlue, 1))
+	assert.Equal(ColorBlue, ColorRed.InterpolateLab(ColorBlue, 1))
+
+	_, _, l1 := ColorBlack.HCL()
+	_, _, l2 := ColorWhite.HCL()
+	_, _, mid := ColorBlack.InterpolateLab(ColorWhite, 0.5).HCL()
+	assert.InDelta((l1+l2)/2, mid, 0.5)
+
+	// the hue takes the short way around from red (~40 degrees) to magenta (~328 degrees).
+	h, _, _ := ColorRed.InterpolateHCL(ColorFromHex("FF00FF"), 0.5).HCL()
+	assert.True(h < 40 || h > 328)
+}
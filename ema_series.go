@@ -42,6 +42,16 @@ func (ema EMASeries) GetPeriod() int {
 	return ema.Period
 }
 
+// GetInnerSeries implements `DerivedSeries`.
+func (ema EMASeries) GetInnerSeries() ValueProvider {
+	return ema.InnerSeries
+}
+
+// GetDerivation implements `DerivedSeries`.
+func (ema EMASeries) GetDerivation() string {
+	return fmt.Sprintf("EMA(%d)", ema.GetPeriod())
+}
+
 // Len returns the number of elements in the series.
 func (ema EMASeries) Len() int {
 	return ema.InnerSeries.Len()
@@ -0,0 +1,454 @@
+package chart
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"math"
+	"unicode/utf16"
+
+	"golang.org/x/image/font"
+
+	"github.com/golang/freetype/truetype"
+	"github.com/wcharczuk/go-chart/drawing"
+)
+
+// emf record types, see [MS-EMF] 2.1.1.
+const (
+	emrHeader                = 1
+	emrEOF                   = 14
+	emrSetBkMode             = 18
+	emrSetPolyFillMode       = 19
+	emrSetTextAlign          = 22
+	emrSetTextColor          = 24
+	emrMoveToEx              = 27
+	emrSelectObject          = 37
+	emrCreateBrushIndirect   = 39
+	emrDeleteObject          = 40
+	emrEllipse               = 42
+	emrLineTo                = 54
+	emrBeginPath             = 59
+	emrEndPath               = 60
+	emrCloseFigure           = 61
+	emrFillPath              = 62
+	emrStrokeAndFillPath     = 63
+	emrStrokePath            = 64
+	emrSelectClipPath        = 67
+	emrExtSelectClipRgn      = 75
+	emrExtCreateFontIndirect = 82
+	emrExtTextOutW           = 84
+	emrPolyBezierTo16        = 88
+	emrExtCreatePen          = 95
+)
+
+// emf object handles; the renderer only ever holds one pen, brush and font at a time.
+const (
+	emfPen   = 1
+	emfBrush = 2
+	emfFont  = 3
+
+	emfNullBrush  = 0x80000005
+	emfNullPen    = 0x80000008
+	emfSystemFont = 0x8000000D
+)
+
+// EMF returns a new enhanced metafile renderer, for pasting charts into office documents as vector graphics.
+// Colors are drawn opaque (blended over white) as emf has no alpha channel, and text uses the font's family name
+// so it is drawn in an installed font of the same name.
+func EMF(width, height int) (Renderer, error) {
+	return &emfRenderer{
+		b:      bytes.NewBuffer([]byte{}),
+		s:      &Style{},
+		width:  width,
+		height: height,
+	}, nil
+}
+
+// emfRenderer renders chart commands to an enhanced metafile.
+type emfRenderer struct {
+	dpi     float64
+	b       *bytes.Buffer
+	records int
+	s       *Style
+	fc      *font.Drawer
+
+	width, height int
+	textTheta     *float64
+
+	inPath        bool
+	x, y          int
+	isInitialized bool
+	hasClipSet    bool
+}
+
+func (er *emfRenderer) ResetStyle() {
+	er.s = &Style{Font: er.s.Font}
+	er.fc = nil
+}
+
+// GetDPI returns the dpi.
+func (er *emfRenderer) GetDPI() float64 {
+	return er.dpi
+}
+
+// SetDPI implements the interface method.
+func (er *emfRenderer) SetDPI(dpi float64) {
+	er.dpi = dpi
+}
+
+// SetStrokeColor implements the interface method.
+func (er *emfRenderer) SetStrokeColor(c drawing.Color) {
+	er.s.StrokeColor = c
+}
+
+// SetFillColor implements the interface method.
+func (er *emfRenderer) SetFillColor(c drawing.Color) {
+	er.s.FillColor = c
+}
+
+// SetStrokeWidth implements the interface method.
+func (er *emfRenderer) SetStrokeWidth(width float64) {
+	er.s.StrokeWidth = width
+}
+
+// SetStrokeDashArray implements the interface method.
+func (er *emfRenderer) SetStrokeDashArray(dashArray []float64) {
+	er.s.StrokeDashArray = dashArray
+}
+
+// MoveTo implements the interface method.
+func (er *emfRenderer) MoveTo(x, y int) {
+	er.beginPath()
+	er.record(emrMoveToEx, int32(x), int32(y))
+	er.x, er.y = x, y
+}
+
+// LineTo implements the interface method.
+func (er *emfRenderer) LineTo(x, y int) {
+	er.beginPath()
+	er.record(emrLineTo, int32(x), int32(y))
+	er.x, er.y = x, y
+}
+
+// QuadCurveTo draws a quad curve as the equivalent cubic bezier.
+func (er *emfRenderer) QuadCurveTo(cx, cy, x, y int) {
+	er.beginPath()
+	c1x, c1y := er.x+(2*(cx-er.x))/3, er.y+(2*(cy-er.y))/3
+	c2x, c2y := x+(2*(cx-x))/3, y+(2*(cy-y))/3
+	er.record(emrPolyBezierTo16, er.getBounds(), uint32(3), []int16{
+		int16(c1x), int16(c1y), int16(c2x), int16(c2y), int16(x), int16(y),
+	})
+	er.x, er.y = x, y
+}
+
+// ArcTo draws an arc as line segments, one every few degrees.
+func (er *emfRenderer) ArcTo(cx, cy int, rx, ry, startAngle, delta float64) {
+	startAngle = Math.RadianAdd(startAngle, _pi2)
+
+	startx := cx + int(rx*math.Sin(startAngle))
+	starty := cy - int(ry*math.Cos(startAngle))
+	if er.inPath {
+		er.LineTo(startx, starty)
+	} else {
+		er.MoveTo(startx, starty)
+	}
+
+	steps := int(math.Ceil(math.Abs(Math.RadiansToDegrees(delta)) / 5.0))
+	for step := 1; step <= steps; step++ {
+		angle := startAngle + delta*float64(step)/float64(steps)
+		er.LineTo(cx+int(rx*math.Sin(angle)), cy-int(ry*math.Cos(angle)))
+	}
+}
+
+// Close closes a shape.
+func (er *emfRenderer) Close() {
+	er.beginPath()
+	er.record(emrCloseFigure)
+}
+
+// Stroke draws the path with no fill.
+func (er *emfRenderer) Stroke() {
+	er.drawPath(emrStrokePath, er.s.GetStrokeOptions())
+}
+
+// Fill draws the path with no stroke.
+func (er *emfRenderer) Fill() {
+	er.drawPath(emrFillPath, er.s.GetFillOptions())
+}
+
+// FillStroke draws the path with both fill and stroke.
+func (er *emfRenderer) FillStroke() {
+	er.drawPath(emrStrokeAndFillPath, er.s.GetFillAndStrokeOptions())
+}
+
+// drawPath ends the current path and draws it with a given style.
+func (er *emfRenderer) drawPath(recordType uint32, s Style) {
+	if !er.inPath {
+		return
+	}
+	er.endPath()
+	er.selectObjects(s)
+	er.record(recordType, er.getBounds())
+	er.deleteObjects()
+}
+
+// Circle implements the interface method.
+func (er *emfRenderer) Circle(radius float64, x, y int) {
+	er.init()
+	r := int(radius)
+	er.selectObjects(er.s.GetFillAndStrokeOptions())
+	er.record(emrEllipse, int32(x-r), int32(y-r), int32(x+r), int32(y+r))
+	er.deleteObjects()
+}
+
+// SetFont implements the interface method.
+func (er *emfRenderer) SetFont(f *truetype.Font) {
+	er.s.Font = f
+}
+
+// SetFontColor implements the interface method.
+func (er *emfRenderer) SetFontColor(c drawing.Color) {
+	er.s.FontColor = c
+}
+
+// SetFontSize implements the interface method.
+func (er *emfRenderer) SetFontSize(size float64) {
+	er.s.FontSize = size
+}
+
+// Text draws a text blob with its baseline at y; the advance of each character is written so the
+// text is as wide as it was measured to be.
+func (er *emfRenderer) Text(body string, x, y int) {
+	er.init()
+	f := er.s.GetFont()
+	if f == nil || len(body) == 0 {
+		return
+	}
+
+	var escapement int32
+	if er.textTheta != nil {
+		escapement = int32(-Math.RadiansToDegrees(*er.textTheta) * 10)
+	}
+	var faceName [32]uint16
+	copy(faceName[:31], utf16.Encode([]rune(f.Name(truetype.NameIDFontFamily))))
+	er.record(emrExtCreateFontIndirect, uint32(emfFont),
+		int32(-drawing.PointsToPixels(er.dpi, er.s.FontSize)), int32(0), escapement, escapement, int32(400),
+		[]uint8{0, 0, 0, 1, 0, 0, 5, 0}, faceName)
+	er.record(emrSelectObject, uint32(emfFont))
+	er.record(emrSetTextColor, er.getColorRef(er.s.FontColor))
+
+	face := truetype.NewFace(f, &truetype.Options{DPI: er.dpi, Size: er.s.FontSize})
+	var chars []uint16
+	var dx []int32
+	for _, r := range body {
+		advance, _ := face.GlyphAdvance(r)
+		encoded := utf16.Encode([]rune{r})
+		chars = append(chars, encoded...)
+		dx = append(dx, int32(advance.Round()))
+		for i := 1; i < len(encoded); i++ {
+			dx = append(dx, 0)
+		}
+	}
+	if len(chars)%2 == 1 {
+		chars = append(chars, 0)
+	}
+
+	const textHeaderSize = 76
+	offString := uint32(textHeaderSize)
+	offDx := offString + uint32(2*len(chars))
+	er.record(emrExtTextOutW, er.getBounds(), uint32(1), float32(0), float32(0),
+		int32(x), int32(y), uint32(len(dx)), offString, uint32(0x100), [4]int32{}, offDx,
+		chars, dx)
+
+	er.record(emrSelectObject, uint32(emfSystemFont))
+	er.record(emrDeleteObject, uint32(emfFont))
+}
+
+// MeasureText uses the truetype font drawer to measure the width of text.
+func (er *emfRenderer) MeasureText(body string) (box Box) {
+	if er.s.GetFont() != nil {
+		key := textMeasureKey{font: er.s.GetFont(), size: er.s.FontSize, dpi: er.dpi, text: body}
+		w, ok := _textMeasureCache.get(key)
+		if !ok {
+			er.fc = &font.Drawer{
+				Face: truetype.NewFace(er.s.GetFont(), &truetype.Options{
+					DPI:  er.dpi,
+					Size: er.s.FontSize,
+				}),
+			}
+			w = er.fc.MeasureString(body).Ceil()
+			_textMeasureCache.set(key, w)
+		}
+
+		box.Right = w
+		box.Bottom = int(drawing.PointsToPixels(er.dpi, er.s.FontSize))
+		if er.textTheta == nil {
+			return
+		}
+		box = box.Corners().Rotate(Math.RadiansToDegrees(*er.textTheta)).Box()
+	}
+	return
+}
+
+// SetTextRotation sets the text rotation.
+func (er *emfRenderer) SetTextRotation(radians float64) {
+	er.textTheta = &radians
+}
+
+// ClearTextRotation clears the text rotation.
+func (er *emfRenderer) ClearTextRotation() {
+	er.textTheta = nil
+}
+
+// Clip implements `ClipRenderer`.
+func (er *emfRenderer) Clip() {
+	if !er.inPath {
+		return
+	}
+	er.endPath()
+	er.record(emrSelectClipPath, uint32(5)) // RGN_COPY
+	er.hasClipSet = true
+}
+
+// ResetClip implements `ClipRenderer`.
+func (er *emfRenderer) ResetClip() {
+	if er.hasClipSet {
+		er.record(emrExtSelectClipRgn, uint32(0), uint32(5)) // no region with RGN_COPY removes the clip.
+		er.hasClipSet = false
+	}
+}
+
+// getSize implements `sizedRenderer`.
+func (er *emfRenderer) getSize() (width, height int) {
+	return er.width, er.height
+}
+
+// GetContentType implements `ContentTypeRenderer`.
+func (er *emfRenderer) GetContentType() string {
+	return ContentTypeEMF
+}
+
+// Save writes the header, the recorded drawing and the end of file record.
+func (er *emfRenderer) Save(w io.Writer) error {
+	er.init()
+	er.ResetClip()
+	er.record(emrEOF, uint32(0), uint32(16), uint32(20))
+
+	dpi := er.dpi
+	if dpi == 0 {
+		dpi = DefaultDPI
+	}
+	const headerSize = 108
+	header := bytes.NewBuffer([]byte{})
+	binary.Write(header, binary.LittleEndian, []uint32{emrHeader, headerSize})
+	binary.Write(header, binary.LittleEndian, er.getBounds())
+	// the frame is in hundredths of millimeters.
+	binary.Write(header, binary.LittleEndian, [4]int32{0, 0, int32(float64(er.width) * 2540 / dpi), int32(float64(er.height) * 2540 / dpi)})
+	binary.Write(header, binary.LittleEndian, []uint32{
+		0x464D4520, // " EMF"
+		0x10000,
+		uint32(headerSize + er.b.Len()),
+		uint32(er.records + 1),
+	})
+	binary.Write(header, binary.LittleEndian, []uint16{4, 0})
+	binary.Write(header, binary.LittleEndian, []uint32{0, 0, 0})
+	binary.Write(header, binary.LittleEndian, []int32{
+		int32(er.width), int32(er.height),
+		int32(float64(er.width) * 25.4 / dpi), int32(float64(er.height) * 25.4 / dpi),
+		0, 0, 0,
+		int32(float64(er.width) * 25400 / dpi), int32(float64(er.height) * 25400 / dpi),
+	})
+
+	if _, err := w.Write(header.Bytes()); err != nil {
+		return err
+	}
+	_, err := w.Write(er.b.Bytes())
+	return err
+}
+
+// init writes the drawing state used by every chart: transparent text backgrounds, baseline aligned text and
+// nonzero winding fills.
+func (er *emfRenderer) init() {
+	if er.isInitialized {
+		return
+	}
+	er.isInitialized = true
+	er.record(emrSetBkMode, uint32(1))       // TRANSPARENT
+	er.record(emrSetTextAlign, uint32(24))   // TA_BASELINE | TA_LEFT
+	er.record(emrSetPolyFillMode, uint32(2)) // WINDING
+}
+
+func (er *emfRenderer) beginPath() {
+	er.init()
+	if !er.inPath {
+		er.record(emrBeginPath)
+		er.inPath = true
+	}
+}
+
+func (er *emfRenderer) endPath() {
+	er.record(emrEndPath)
+	er.inPath = false
+}
+
+// selectObjects creates and selects a pen and a brush for a style; unset colors select the null pen or brush.
+func (er *emfRenderer) selectObjects(s Style) {
+	if s.StrokeColor.IsTransparent() || s.StrokeWidth == 0 {
+		er.record(emrSelectObject, uint32(emfNullPen))
+	} else {
+		penStyle := uint32(0x10000) // PS_GEOMETRIC
+		var dashes []uint32
+		for _, d := range s.StrokeDashArray {
+			dashes = append(dashes, uint32(math.Max(1, math.Floor(d+0.5))))
+		}
+		if len(dashes) > 0 {
+			penStyle |= 7 // PS_USERSTYLE
+		}
+		width := uint32(math.Max(1, math.Floor(s.StrokeWidth+0.5)))
+		er.record(emrExtCreatePen, uint32(emfPen), uint32(0), uint32(0), uint32(0), uint32(0),
+			penStyle, width, uint32(0), er.getColorRef(s.StrokeColor), uint32(0), uint32(len(dashes)), dashes)
+		er.record(emrSelectObject, uint32(emfPen))
+	}
+
+	if s.FillColor.IsTransparent() {
+		er.record(emrSelectObject, uint32(emfNullBrush))
+	} else {
+		er.record(emrCreateBrushIndirect, uint32(emfBrush), uint32(0), er.getColorRef(s.FillColor), uint32(0))
+		er.record(emrSelectObject, uint32(emfBrush))
+	}
+}
+
+// deleteObjects deselects and deletes the pen and brush made by `selectObjects`.
+func (er *emfRenderer) deleteObjects() {
+	er.record(emrSelectObject, uint32(emfNullPen))
+	er.record(emrSelectObject, uint32(emfNullBrush))
+	er.record(emrDeleteObject, uint32(emfPen))
+	er.record(emrDeleteObject, uint32(emfBrush))
+}
+
+// getColorRef returns a color as an opaque `COLORREF`.
+func (er *emfRenderer) getColorRef(c drawing.Color) uint32 {
+	if c.A < 255 {
+		c = c.BlendOver(drawing.ColorWhite)
+	}
+	return uint32(c.R) | uint32(c.G)<<8 | uint32(c.B)<<16
+}
+
+// getBounds returns the bounds of the image as an inclusive `RECTL`.
+func (er *emfRenderer) getBounds() [4]int32 {
+	return [4]int32{0, 0, int32(er.width - 1), int32(er.height - 1)}
+}
+
+// record writes a record, padding its data to a multiple of 4 bytes.
+func (er *emfRenderer) record(recordType uint32, data ...interface{}) {
+	body := bytes.NewBuffer([]byte{})
+	for _, d := range data {
+		binary.Write(body, binary.LittleEndian, d)
+	}
+	for body.Len()%4 != 0 {
+		body.WriteByte(0)
+	}
+	binary.Write(er.b, binary.LittleEndian, []uint32{recordType, uint32(8 + body.Len())})
+	er.b.Write(body.Bytes())
+	er.records++
+}
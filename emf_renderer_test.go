@@ -0,0 +1,47 @@
+package chart
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	assert "github.com/blendlabs/go-assert"
+)
+
+func TestEMFRenderer(t *testing.T) {
+	assert := assert.New(t)
+
+	c := Chart{
+		Title:      "EMF",
+		TitleStyle: StyleShow(),
+		XAxis:      XAxis{Style: StyleShow()},
+		YAxis:      YAxis{Style: StyleShow()},
+		Series: []Series{
+			ContinuousSeries{XValues: []float64{1, 2, 3}, YValues: []float64{1, 3, 2}},
+		},
+	}
+	buffer := bytes.NewBuffer([]byte{})
+	assert.Nil(c.Render(EMF, buffer))
+
+	contents := buffer.Bytes()
+	assert.Equal(uint32(emrHeader), binary.LittleEndian.Uint32(contents[0:]))
+	assert.Equal(uint32(0x464D4520), binary.LittleEndian.Uint32(contents[40:]))
+	assert.Equal(uint32(len(contents)), binary.LittleEndian.Uint32(contents[48:]))
+
+	var records, lastType uint32
+	var hasText bool
+	for offset := 0; offset < len(contents); {
+		recordType := binary.LittleEndian.Uint32(contents[offset:])
+		size := binary.LittleEndian.Uint32(contents[offset+4:])
+		assert.Zero(size % 4)
+		if recordType == emrExtTextOutW {
+			hasText = true
+		}
+		records++
+		lastType = recordType
+		offset += int(size)
+	}
+	assert.Equal(binary.LittleEndian.Uint32(contents[52:]), records)
+	assert.Equal(uint32(emrEOF), lastType)
+	assert.True(hasText)
+}
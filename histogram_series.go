@@ -1,6 +1,23 @@
 package chart
 
-import "fmt"
+import (
+	"fmt"
+	"math"
+)
+
+// HistogramNormalization is how the counts of a histogram are scaled.
+type HistogramNormalization int
+
+const (
+	// HistogramCount draws the inner series values as they are.
+	HistogramCount HistogramNormalization = 0
+	// HistogramDensity divides each value by the total and the bin width, so the bars have a total area of 1.
+	HistogramDensity HistogramNormalization = 1
+	// HistogramProbability divides each value by the total, so the bars sum to 1.
+	HistogramProbability HistogramNormalization = 2
+	// HistogramCumulative draws the running share of the total, ending at 1.
+	HistogramCumulative HistogramNormalization = 3
+)
 
 // HistogramSeries is a special type of series that draws as a histogram.
 // Some peculiarities; it will always be lower bounded at 0 (at the very least).
@@ -10,6 +27,17 @@ type HistogramSeries struct {
 	Style       Style
 	YAxis       YAxisType
 	InnerSeries ValueProvider
+
+	// Normalization scales the values, so histograms of different sample sizes can be compared.
+	Normalization HistogramNormalization
+	// Outline draws the histogram as a single step outline rather than a box per bar.
+	Outline bool
+	// FillAlpha, if set and the style has no fill color, fills the bars with the stroke color at this alpha,
+	// so overlaid histograms show through each other.
+	FillAlpha uint8
+
+	// totals are the running totals of the inner series values, computed once per render for the normalizations.
+	totals []float64
 }
 
 // GetName implements Series.GetName.
@@ -34,12 +62,68 @@ func (hs HistogramSeries) Len() int {
 
 // GetValue implements ValueProvider.GetValue.
 func (hs HistogramSeries) GetValue(index int) (x, y float64) {
-	return hs.InnerSeries.GetValue(index)
+	x, y = hs.InnerSeries.GetValue(index)
+	if hs.Normalization == HistogramCount {
+		return
+	}
+
+	totals := hs.getTotals()
+	total := totals[len(totals)-1]
+	if total == 0 {
+		return x, 0
+	}
+	switch hs.Normalization {
+	case HistogramDensity:
+		y = y / (total * hs.getBinWidth())
+	case HistogramProbability:
+		y = y / total
+	case HistogramCumulative:
+		y = totals[index] / total
+	}
+	return
+}
+
+// getTotals returns the running totals of the inner series values, from the cache if they were computed for
+// the render.
+func (hs HistogramSeries) getTotals() []float64 {
+	if len(hs.totals) > 0 && len(hs.totals) == hs.InnerSeries.Len() {
+		return hs.totals
+	}
+	totals := make([]float64, hs.InnerSeries.Len())
+	var total float64
+	for index := range totals {
+		_, vy := hs.InnerSeries.GetValue(index)
+		total += vy
+		totals[index] = total
+	}
+	return totals
+}
+
+// withCachedValues implements `cachedSeries`.
+func (hs HistogramSeries) withCachedValues() Series {
+	if hs.InnerSeries != nil && hs.Normalization != HistogramCount {
+		hs.totals = hs.getTotals()
+	}
+	return hs
+}
+
+// getBinWidth returns the mean distance between the x values, or 1 for a single bin.
+func (hs HistogramSeries) getBinWidth() float64 {
+	length := hs.InnerSeries.Len()
+	if length < 2 {
+		return 1
+	}
+	first, _ := hs.InnerSeries.GetValue(0)
+	last, _ := hs.InnerSeries.GetValue(length - 1)
+	if width := math.Abs(last-first) / float64(length-1); width > 0 {
+		return width
+	}
+	return 1
 }
 
 // GetBoundedValue implements BoundedValueProvider.GetBoundedValue
 func (hs HistogramSeries) GetBoundedValue(index int) (x, y1, y2 float64) {
-	vx, vy := hs.InnerSeries.GetValue(index)
+	vx, vy := hs.GetValue(index)
 
 	x = vx
 
@@ -54,7 +138,17 @@ func (hs HistogramSeries) GetBoundedValue(index int) (x, y1, y2 float64) {
 
 // Render implements Series.Render.
 func (hs HistogramSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
+	if len(hs.totals) == 0 {
+		hs = hs.withCachedValues().(HistogramSeries)
+	}
 	style := hs.Style.InheritFrom(defaults)
+	if hs.FillAlpha > 0 && hs.Style.FillColor.IsZero() {
+		style.FillColor = style.GetStrokeColor().WithAlpha(hs.FillAlpha)
+	}
+	if hs.Outline {
+		Draw.HistogramOutline(r, canvasBox, xrange, yrange, style, hs)
+		return
+	}
 	Draw.HistogramSeries(r, canvasBox, xrange, yrange, style, hs)
 }
 
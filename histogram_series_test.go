@@ -1,6 +1,7 @@
 package chart
 
 import (
+	"bytes"
 	"testing"
 
 	assert "github.com/blendlabs/go-assert"
@@ -29,3 +30,76 @@ func TestHistogramSeries(t *testing.T) {
 		assert.True(csy > 0 || (csy < 0 && csy == hsy2))
 	}
 }
+
+func TestHistogramSeriesNormalization(t *testing.T) {
+	assert := assert.New(t)
+
+	cs := ContinuousSeries{
+		XValues: []float64{0, 2, 4, 6},
+		YValues: []float64{1, 3, 4, 2},
+	}
+
+	values := func(normalization HistogramNormalization) []float64 {
+		hs := HistogramSeries{InnerSeries: cs, Normalization: normalization}
+		var ys []float64
+		for index := 0; index < hs.Len(); index++ {
+			_, y := hs.GetValue(index)
+			ys = append(ys, y)
+		}
+		return ys
+	}
+
+	assert.Equal([]float64{1, 3, 4, 2}, values(HistogramCount))
+	assert.Equal([]float64{0.1, 0.3, 0.4, 0.2}, values(HistogramProbability))
+	assert.Equal([]float64{0.05, 0.15, 0.2, 0.1}, values(HistogramDensity))
+
+	cumulative := values(HistogramCumulative)
+	assert.InDelta(0.1, cumulative[0], 0.0001)
+	assert.InDelta(0.4, cumulative[1], 0.0001)
+	assert.InDelta(0.8, cumulative[2], 0.0001)
+	assert.InDelta(1.0, cumulative[3], 0.0001)
+
+	_, y1, _ := HistogramSeries{InnerSeries: cs, Normalization: HistogramProbability}.GetBoundedValue(1)
+	assert.Equal(0.3, y1)
+}
+
+type countingValueProvider struct {
+	ValueProvider
+	calls *int
+}
+
+func (cvp countingValueProvider) GetValue(index int) (x, y float64) {
+	*cvp.calls++
+	return cvp.ValueProvider.GetValue(index)
+}
+
+func TestHistogramSeriesCachedTotals(t *testing.T) {
+	assert := assert.New(t)
+
+	var calls int
+	cs := countingValueProvider{
+		ValueProvider: ContinuousSeries{XValues: Sequence.Float64(1.0, 100.0), YValues: Sequence.Float64(1.0, 100.0)},
+		calls:         &calls,
+	}
+	hs := HistogramSeries{InnerSeries: cs, Normalization: HistogramCumulative}
+	cached := hs.withCachedValues().(HistogramSeries)
+
+	calls = 0
+	for index := 0; index < hs.Len(); index++ {
+		_, y := hs.GetValue(index)
+		_, cy := cached.GetValue(index)
+		assert.Equal(y, cy)
+	}
+	assert.True(calls > 100*100, "the uncached series sums the inner series on each access")
+
+	calls = 0
+	for index := 0; index < cached.Len(); index++ {
+		cached.GetValue(index)
+	}
+	assert.Equal(100, calls)
+
+	c := Chart{Series: []Series{HistogramSeries{InnerSeries: cs, Normalization: HistogramProbability}}}
+	calls = 0
+	assert.Nil(c.Render(PNG, bytes.NewBuffer(nil)))
+	assert.True(calls < 100*100, "the chart caches the totals for the render")
+}
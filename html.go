@@ -0,0 +1,129 @@
+package chart
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"io"
+)
+
+const (
+	// DefaultHTMLPointRadius is the radius of the (invisible) hover target drawn over each value in html output.
+	DefaultHTMLPointRadius = 4
+)
+
+// RenderHTML renders the chart as a standalone html page; the svg has a hover target for each value, with its series
+// and labels as data attributes, and a small script shows a tooltip and a crosshair for the value nearest the pointer.
+func (c Chart) RenderHTML(w io.Writer) error {
+	info := &RenderInfo{IncludePoints: true}
+	c.Info = info
+
+	svg := bytes.NewBuffer([]byte{})
+	if err := c.Render(SVG, svg); err != nil {
+		return err
+	}
+	contents := svg.Bytes()
+	end := bytes.LastIndex(contents, []byte("</svg>"))
+	if end < 0 {
+		return fmt.Errorf("html export requires svg output")
+	}
+
+	fmt.Fprintf(w, `<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>%s</title>
+<style>%s</style>
+</head>
+<body>
+<div class="chart" data-canvas="%d,%d,%d,%d" style="width:%dpx;height:%dpx">
+`, html.EscapeString(c.Title), htmlStyle, info.Canvas.Left, info.Canvas.Top, info.Canvas.Right, info.Canvas.Bottom, info.Width, info.Height)
+
+	w.Write(contents[:end])
+	io.WriteString(w, `<g class="chart-points">`)
+	for _, s := range info.Series {
+		for _, p := range s.Points {
+			fmt.Fprintf(w, `<circle class="chart-point" cx="%d" cy="%d" r="%d" data-series="%s" data-x="%s" data-y="%s"/>`,
+				p.Left, p.Top, DefaultHTMLPointRadius, html.EscapeString(s.Name), html.EscapeString(p.XLabel), html.EscapeString(p.YLabel))
+		}
+	}
+	io.WriteString(w, "</g>")
+	w.Write(contents[end:])
+
+	_, err := fmt.Fprintf(w, `
+<div class="chart-crosshair-x"></div>
+<div class="chart-crosshair-y"></div>
+<div class="chart-tooltip"></div>
+<script>%s</script>
+</div>
+</body>
+</html>
+`, htmlScript)
+	return err
+}
+
+const htmlStyle = `
+.chart { position: relative; font-family: sans-serif; font-size: 12px; }
+.chart-point { fill: transparent; stroke: none; }
+.chart-crosshair-x, .chart-crosshair-y, .chart-tooltip { position: absolute; display: none; pointer-events: none; }
+.chart-crosshair-x { width: 0; border-left: 1px dashed #888; }
+.chart-crosshair-y { height: 0; border-top: 1px dashed #888; }
+.chart-tooltip { padding: 4px 6px; background: #fff; border: 1px solid #ccc; border-radius: 3px; white-space: nowrap; }
+`
+
+const htmlScript = `
+(function() {
+	var root = document.currentScript.parentNode;
+	var svg = root.querySelector("svg");
+	var points = root.querySelectorAll(".chart-point");
+	var crosshairX = root.querySelector(".chart-crosshair-x");
+	var crosshairY = root.querySelector(".chart-crosshair-y");
+	var tooltip = root.querySelector(".chart-tooltip");
+	var canvas = root.getAttribute("data-canvas").split(",").map(Number);
+
+	function hide() {
+		crosshairX.style.display = crosshairY.style.display = tooltip.style.display = "none";
+	}
+
+	svg.addEventListener("mousemove", function(e) {
+		var bounds = svg.getBoundingClientRect();
+		var mx = e.clientX - bounds.left, my = e.clientY - bounds.top;
+		if (mx < canvas[0] || my < canvas[1] || mx > canvas[2] || my > canvas[3]) {
+			return hide();
+		}
+		var nearest = null, nearestDistance = Infinity;
+		for (var i = 0; i < points.length; i++) {
+			var dx = points[i].getAttribute("cx") - mx, dy = points[i].getAttribute("cy") - my;
+			if (dx * dx + dy * dy < nearestDistance) {
+				nearest = points[i];
+				nearestDistance = dx * dx + dy * dy;
+			}
+		}
+		if (!nearest) {
+			return hide();
+		}
+		var x = Number(nearest.getAttribute("cx")), y = Number(nearest.getAttribute("cy"));
+
+		crosshairX.style.left = x + "px";
+		crosshairX.style.top = canvas[1] + "px";
+		crosshairX.style.height = (canvas[3] - canvas[1]) + "px";
+		crosshairY.style.left = canvas[0] + "px";
+		crosshairY.style.top = y + "px";
+		crosshairY.style.width = (canvas[2] - canvas[0]) + "px";
+
+		var name = nearest.getAttribute("data-series");
+		tooltip.textContent = (name ? name + ": " : "") + nearest.getAttribute("data-x") + ", " + nearest.getAttribute("data-y");
+		crosshairX.style.display = crosshairY.style.display = tooltip.style.display = "block";
+		var left = x + 10, top = y + 10;
+		if (left + tooltip.offsetWidth > svg.clientWidth) {
+			left = x - 10 - tooltip.offsetWidth;
+		}
+		if (top + tooltip.offsetHeight > svg.clientHeight) {
+			top = y - 10 - tooltip.offsetHeight;
+		}
+		tooltip.style.left = left + "px";
+		tooltip.style.top = top + "px";
+	});
+	svg.addEventListener("mouseleave", hide);
+})();
+`
@@ -0,0 +1,52 @@
+package chart
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	assert "github.com/blendlabs/go-assert"
+)
+
+func TestChartRenderHTML(t *testing.T) {
+	assert := assert.New(t)
+
+	c := Chart{
+		Title: "Latency <p99>",
+		Series: []Series{
+			ContinuousSeries{Name: "foo & bar", XValues: []float64{1, 2, 3}, YValues: []float64{4, 5, 6}},
+		},
+	}
+	buffer := bytes.NewBuffer([]byte{})
+	assert.Nil(c.RenderHTML(buffer))
+
+	page := buffer.String()
+	assert.True(strings.HasPrefix(page, "<!DOCTYPE html>"))
+	assert.True(strings.Contains(page, "<title>Latency &lt;p99&gt;</title>"))
+	assert.Equal(3, strings.Count(page, `class="chart-point"`))
+	assert.True(strings.Contains(page, `data-series="foo &amp; bar"`))
+	assert.True(strings.Contains(page, `data-y="5.00"`))
+	assert.True(strings.Index(page, `class="chart-point"`) < strings.Index(page, "</svg>"))
+	assert.True(strings.Contains(page, "<script>"))
+}
+
+func TestRenderInfoIncludePoints(t *testing.T) {
+	assert := assert.New(t)
+
+	info := RenderInfo{IncludePoints: true}
+	c := Chart{
+		Info: &info,
+		Series: []Series{
+			ContinuousSeries{XValues: []float64{1, 2, 3}, YValues: []float64{4, 5, 6}},
+		},
+	}
+	assert.Nil(c.Render(PNG, bytes.NewBuffer([]byte{})))
+	assert.Len(info.Series[0].Points, 3)
+	assert.Equal(info.Canvas.Left, info.Series[0].Points[0].Left)
+	assert.Equal(info.Canvas.Bottom, info.Series[0].Points[0].Top)
+	assert.Equal(info.Canvas.Top, info.Series[0].Points[2].Top)
+
+	info.IncludePoints = false
+	assert.Nil(c.Render(PNG, bytes.NewBuffer([]byte{})))
+	assert.Empty(info.Series[0].Points)
+}
@@ -0,0 +1,152 @@
+package chart
+
+import (
+	"fmt"
+	"html"
+	"io"
+	"strconv"
+	"strings"
+)
+
+const (
+	// ImageMapAreaPoint is the kind of image map areas around series values.
+	ImageMapAreaPoint = "point"
+	// ImageMapAreaBar is the kind of image map areas over bars.
+	ImageMapAreaBar = "bar"
+	// ImageMapAreaLegend is the kind of image map areas over legend entries.
+	ImageMapAreaLegend = "legend"
+)
+
+// ImageMapArea is a clickable (or hoverable) region of a rendered raster chart.
+type ImageMapArea struct {
+	Kind   string
+	Series string
+	// Title is the area's tooltip.
+	Title string
+	// Shape is the html area shape, `rect` (coords left, top, right, bottom) or `circle` (coords x, y, radius).
+	Shape  string
+	Coords []int
+}
+
+// areaRecorder is implemented by renderers that record the areas of legend entries and bars for image maps.
+type areaRecorder interface {
+	addArea(area ImageMapArea)
+	getAreas() []ImageMapArea
+}
+
+// recordArea records an image map area of a box, if the renderer records areas.
+func recordArea(r Renderer, kind, series, title string, box Box) {
+	if ar, isAreaRecorder := r.(areaRecorder); isAreaRecorder {
+		ar.addArea(ImageMapArea{
+			Kind:   kind,
+			Series: series,
+			Title:  title,
+			Shape:  "rect",
+			Coords: []int{box.Left, box.Top, box.Right, box.Bottom},
+		})
+	}
+}
+
+// RenderWithImageMap renders the chart and writes an html `<map>` with an area for each drawn value and legend
+// entry to `imageMap`, so a raster chart embedded in a page (with `usemap="#name"`) gets hoverable regions.
+// Legend entries are only mapped by the raster renderers; the renderer provider defaults to `PNG`.
+func (c Chart) RenderWithImageMap(rp RendererProvider, w io.Writer, name string, imageMap io.Writer) error {
+	info := &RenderInfo{IncludePoints: true}
+	c.Info = info
+	rp, r := withRecordedRenderer(rp)
+	if err := c.Render(rp, w); err != nil {
+		return err
+	}
+
+	var areas []ImageMapArea
+	if ar, isAreaRecorder := (*r).(areaRecorder); isAreaRecorder {
+		areas = append(areas, ar.getAreas()...)
+	}
+	for _, s := range info.Series {
+		for _, p := range s.Points {
+			areas = append(areas, ImageMapArea{
+				Kind:   ImageMapAreaPoint,
+				Series: s.Name,
+				Title:  imageMapPointTitle(s.Name, p),
+				Shape:  "circle",
+				Coords: []int{p.Left, p.Top, DefaultHTMLPointRadius},
+			})
+		}
+	}
+	return WriteImageMap(imageMap, name, areas)
+}
+
+// RenderWithImageMap renders the bar chart and writes an html `<map>` with an area for each bar to `imageMap`.
+// Bars are only mapped by the raster renderers; the renderer provider defaults to `PNG`.
+func (bc BarChart) RenderWithImageMap(rp RendererProvider, w io.Writer, name string, imageMap io.Writer) error {
+	rp, r := withRecordedRenderer(rp)
+	if err := bc.Render(rp, w); err != nil {
+		return err
+	}
+	var areas []ImageMapArea
+	if ar, isAreaRecorder := (*r).(areaRecorder); isAreaRecorder {
+		areas = ar.getAreas()
+	}
+	return WriteImageMap(imageMap, name, areas)
+}
+
+// RenderWithImageMap renders the stacked bar chart and writes an html `<map>` with an area for each bar segment
+// to `imageMap`. Segments are only mapped by the raster renderers; the renderer provider defaults to `PNG`.
+func (sbc StackedBarChart) RenderWithImageMap(rp RendererProvider, w io.Writer, name string, imageMap io.Writer) error {
+	rp, r := withRecordedRenderer(rp)
+	if err := sbc.Render(rp, w); err != nil {
+		return err
+	}
+	var areas []ImageMapArea
+	if ar, isAreaRecorder := (*r).(areaRecorder); isAreaRecorder {
+		areas = ar.getAreas()
+	}
+	return WriteImageMap(imageMap, name, areas)
+}
+
+// WriteImageMap writes an html `<map>` of a set of areas; earlier areas take precedence where areas overlap.
+func WriteImageMap(w io.Writer, name string, areas []ImageMapArea) error {
+	if _, err := fmt.Fprintf(w, "<map name=\"%s\">\n", html.EscapeString(name)); err != nil {
+		return err
+	}
+	for _, area := range areas {
+		coords := make([]string, len(area.Coords))
+		for index, coord := range area.Coords {
+			coords[index] = strconv.Itoa(coord)
+		}
+		title := html.EscapeString(area.Title)
+		if _, err := fmt.Fprintf(w, "<area shape=\"%s\" coords=\"%s\" title=\"%s\" alt=\"%s\" data-kind=\"%s\" data-series=\"%s\">\n",
+			area.Shape, strings.Join(coords, ","), title, title, area.Kind, html.EscapeString(area.Series)); err != nil {
+			return err
+		}
+	}
+	_, err := io.WriteString(w, "</map>\n")
+	return err
+}
+
+func imageMapPointTitle(series string, p PointInfo) string {
+	if len(series) == 0 {
+		return p.XLabel + ", " + p.YLabel
+	}
+	return series + ": " + p.XLabel + ", " + p.YLabel
+}
+
+func imageMapBarTitle(label, value string) string {
+	if len(label) == 0 {
+		return value
+	}
+	return label + ": " + value
+}
+
+// withRecordedRenderer wraps a renderer provider to capture the renderer it provides.
+func withRecordedRenderer(rp RendererProvider) (RendererProvider, *Renderer) {
+	if rp == nil {
+		rp = PNG
+	}
+	var recorded Renderer
+	return func(width, height int) (Renderer, error) {
+		r, err := rp(width, height)
+		recorded = r
+		return r, err
+	}, &recorded
+}
@@ -0,0 +1,66 @@
+package chart
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/blendlabs/go-assert"
+)
+
+func TestChartRenderWithImageMap(t *testing.T) {
+	assert := assert.New(t)
+
+	c := Chart{
+		Series: []Series{
+			ContinuousSeries{Name: "CPU", XValues: []float64{1, 2, 3}, YValues: []float64{1, 3, 2}},
+			ContinuousSeries{Name: "Memory", XValues: []float64{1, 2, 3}, YValues: []float64{2, 1, 3}},
+		},
+	}
+	c.Elements = []Renderable{Legend(&c)}
+
+	var png, imageMap bytes.Buffer
+	assert.Nil(c.RenderWithImageMap(PNG, &png, "usage", &imageMap))
+	assert.NotZero(png.Len())
+
+	contents := imageMap.String()
+	assert.True(strings.HasPrefix(contents, `<map name="usage">`))
+	assert.True(strings.HasSuffix(contents, "</map>\n"))
+	assert.Equal(6, strings.Count(contents, `data-kind="point"`))
+	assert.Equal(2, strings.Count(contents, `data-kind="legend"`))
+	assert.True(strings.Contains(contents, `shape="circle"`))
+	assert.True(strings.Contains(contents, `title="CPU: 2.00, 3.00"`))
+	assert.True(strings.Index(contents, `data-kind="legend"`) < strings.Index(contents, `data-kind="point"`), "legend entries take precedence")
+
+	imageMap.Reset()
+	assert.Nil(c.RenderWithImageMap(SVG, &png, "usage", &imageMap))
+	assert.Zero(strings.Count(imageMap.String(), `data-kind="legend"`), "only raster renderers record legend areas")
+}
+
+func TestBarChartRenderWithImageMap(t *testing.T) {
+	assert := assert.New(t)
+
+	bc := BarChart{
+		Bars: []Value{
+			{Label: "Blue", Value: 1.0},
+			{Label: "Green", Value: 2.5},
+		},
+	}
+
+	var png, imageMap bytes.Buffer
+	assert.Nil(bc.RenderWithImageMap(nil, &png, "bars", &imageMap))
+	contents := imageMap.String()
+	assert.Equal(2, strings.Count(contents, `data-kind="bar"`))
+	assert.True(strings.Contains(contents, `title="Green: 2.50"`))
+	assert.True(strings.Contains(contents, `shape="rect"`))
+}
+
+func TestWriteImageMap(t *testing.T) {
+	assert := assert.New(t)
+
+	var buffer bytes.Buffer
+	assert.Nil(WriteImageMap(&buffer, "a&b", []ImageMapArea{
+		{Kind: ImageMapAreaBar, Series: "<s>", Title: "t\"1", Shape: "rect", Coords: []int{1, 2, 3, 4}},
+	}))
+	assert.Equal("<map name=\"a&amp;b\">\n<area shape=\"rect\" coords=\"1,2,3,4\" title=\"t&#34;1\" alt=\"t&#34;1\" data-kind=\"bar\" data-series=\"&lt;s&gt;\">\n</map>\n", buffer.String())
+}
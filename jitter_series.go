@@ -0,0 +1,89 @@
+package chart
+
+import "fmt"
+
+// JitterSeries is a series that displaces the values of its inner series by a small pseudo-random amount,
+// i.e. to separate overlapping values. The displacement is derived from the seed and each value's index, so the
+// same seed always jitters the same way.
+type JitterSeries struct {
+	Name  string
+	Style Style
+	YAxis YAxisType
+
+	InnerSeries ValueProvider
+
+	// XJitter and YJitter are the largest displacements either way, in data units.
+	XJitter float64
+	YJitter float64
+
+	// Seed seeds the displacements; if it is unset the chart's seed is used.
+	Seed int64
+}
+
+// GetName returns the name of the time series.
+func (js JitterSeries) GetName() string {
+	return js.Name
+}
+
+// GetStyle returns the line style.
+func (js JitterSeries) GetStyle() Style {
+	return js.Style
+}
+
+// GetYAxis returns which YAxis the series draws on.
+func (js JitterSeries) GetYAxis() YAxisType {
+	return js.YAxis
+}
+
+// WithSeed implements `SeededSeries`.
+func (js JitterSeries) WithSeed(seed int64) Series {
+	if js.Seed == 0 {
+		js.Seed = seed
+	}
+	return js
+}
+
+// Len returns the number of elements in the series.
+func (js JitterSeries) Len() int {
+	return js.InnerSeries.Len()
+}
+
+// GetValue gets a value at a given index.
+func (js JitterSeries) GetValue(index int) (x, y float64) {
+	x, y = js.InnerSeries.GetValue(index)
+	x += js.XJitter * seededUnit(js.Seed, index, 0)
+	y += js.YJitter * seededUnit(js.Seed, index, 1)
+	return
+}
+
+// GetValueFormatters returns the value formatters of the inner series, if it provides them.
+func (js JitterSeries) GetValueFormatters() (x, y ValueFormatter) {
+	if vfp, isVfp := js.InnerSeries.(ValueFormatterProvider); isVfp {
+		return vfp.GetValueFormatters()
+	}
+	return FloatValueFormatter, FloatValueFormatter
+}
+
+// Render renders the series.
+func (js JitterSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
+	style := js.Style.InheritFrom(defaults)
+	Draw.LineSeries(r, canvasBox, xrange, yrange, style, js)
+}
+
+// Validate validates the series.
+func (js JitterSeries) Validate() error {
+	if js.InnerSeries == nil {
+		return fmt.Errorf("jitter series requires InnerSeries to be set")
+	}
+	return nil
+}
+
+// seededUnit returns a pseudo-random value in [-1, 1) for a seed, an index and a salt (to draw independent values
+// for the same index); it is a splitmix64 hash, so it needs no generator state.
+func seededUnit(seed int64, index, salt int) float64 {
+	z := uint64(seed) + uint64(index)*0x9e3779b97f4a7c15 + uint64(salt)*0xbf58476d1ce4e5b9
+	z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+	z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+	z = z ^ (z >> 31)
+	return float64(z>>11)/float64(1<<52) - 1
+}
@@ -0,0 +1,63 @@
+package chart
+
+import (
+	"bytes"
+	"testing"
+
+	assert "github.com/blendlabs/go-assert"
+)
+
+func TestJitterSeries(t *testing.T) {
+	assert := assert.New(t)
+
+	js := JitterSeries{
+		InnerSeries: ContinuousSeries{XValues: []float64{1, 2, 3}, YValues: []float64{1, 1, 1}},
+		YJitter:     0.25,
+		Seed:        7,
+	}
+	assert.Nil(js.Validate())
+	assert.NotNil(JitterSeries{}.Validate())
+
+	var distinct bool
+	for index := 0; index < js.Len(); index++ {
+		x, y := js.GetValue(index)
+		assert.Equal(float64(index+1), x, "x is not jittered")
+		assert.InDelta(1.0, y, 0.25)
+		_, again := js.GetValue(index)
+		assert.Equal(y, again, "jitter is deterministic")
+		if y != 1.0 {
+			distinct = true
+		}
+	}
+	assert.True(distinct)
+
+	_, seven := js.GetValue(0)
+	_, eight := js.WithSeed(8).(JitterSeries).GetValue(0)
+	assert.Equal(seven, eight, "the series' own seed wins")
+
+	js.Seed = 0
+	c := Chart{Seed: 100, Series: []Series{js}}
+	seeded := c.seededSeries(js, 0).(JitterSeries)
+	assert.Equal(int64(100), seeded.Seed)
+	assert.Nil(c.Render(PNG, bytes.NewBuffer(nil)))
+}
+
+func TestChartSeedRenderInfo(t *testing.T) {
+	assert := assert.New(t)
+
+	js := JitterSeries{
+		InnerSeries: ContinuousSeries{XValues: []float64{1, 2, 3}, YValues: []float64{1, 2, 3}},
+		YJitter:     0.25,
+	}
+	info := &RenderInfo{IncludePoints: true}
+	c := Chart{Seed: 100, Info: info, Series: []Series{js}}
+	assert.Nil(c.Render(PNG, bytes.NewBuffer(nil)))
+
+	// the render info describes the seeded values that are drawn.
+	seeded := c.seededSeries(js, 0).(JitterSeries)
+	assert.Len(info.Series[0].Points, 3)
+	for index, p := range info.Series[0].Points {
+		_, y := seeded.GetValue(index)
+		assert.Equal(y, p.Y)
+	}
+}
@@ -0,0 +1,144 @@
+package chart
+
+import (
+	"math/rand"
+	"sort"
+)
+
+// LabelOverlap is an enum for how overlapping labels are resolved.
+type LabelOverlap int
+
+const (
+	// LabelOverlapUnset is the unset state, labels are drawn where they are with no overlap resolution.
+	LabelOverlapUnset LabelOverlap = 0
+	// LabelOverlapAllow draws labels where they are with no overlap resolution.
+	LabelOverlapAllow LabelOverlap = 1
+	// LabelOverlapNudge moves overlapping labels vertically into free space, connected to their anchor
+	// with a leader line. Labels that cannot be moved into free space are left where they are.
+	LabelOverlapNudge LabelOverlap = 2
+	// LabelOverlapHide hides the lower priority label of any overlapping labels.
+	LabelOverlapHide LabelOverlap = 3
+	// LabelOverlapNudgeOrHide is like `LabelOverlapNudge` but hides labels that cannot be moved into free space.
+	LabelOverlapNudgeOrHide LabelOverlap = 4
+)
+
+const (
+	// DefaultLabelNudgeSteps is the number of positions above and below the preferred position a label is nudged to.
+	DefaultLabelNudgeSteps = 4
+	// DefaultLabelSpacing is the vertical space kept between nudged labels.
+	DefaultLabelSpacing = 2
+	// DefaultLabelLeaderOffset is how far nudged labels are moved to the right so their leader line is visible.
+	DefaultLabelLeaderOffset = 10
+)
+
+// LabelCandidate is a label to be placed; its box is the preferred position in absolute coordinates.
+type LabelCandidate struct {
+	Anchor   Point
+	Box      Box
+	Priority int
+}
+
+// LabelPlacement is where a label candidate was placed.
+type LabelPlacement struct {
+	Anchor Point
+	Box    Box
+	// Offset is how far the box was moved from its preferred position.
+	Offset Point
+	Hidden bool
+}
+
+// HasLeader returns if the label was moved away from its preferred position and should be connected to its anchor.
+func (lp LabelPlacement) HasLeader() bool {
+	return !lp.Hidden && (lp.Offset.X != 0 || lp.Offset.Y != 0)
+}
+
+// PlaceLabels resolves overlaps between labels, keeping nudged labels within the vertical extent of the bounds.
+// Labels are placed in priority order (highest first, ties broken by input order) so lower priority labels are the
+// ones that are moved or hidden. The placements are returned in the same order as the candidates.
+func PlaceLabels(bounds Box, candidates []LabelCandidate, overlap LabelOverlap) []LabelPlacement {
+	return PlaceLabelsWithSeed(bounds, candidates, overlap, 0)
+}
+
+// PlaceLabelsWithSeed is like `PlaceLabels` but a non-zero seed breaks priority ties (and picks whether a label is
+// nudged up or down first) pseudo-randomly; the same seed always places the labels the same way.
+func PlaceLabelsWithSeed(bounds Box, candidates []LabelCandidate, overlap LabelOverlap, seed int64) []LabelPlacement {
+	placements := make([]LabelPlacement, len(candidates))
+	for index, c := range candidates {
+		placements[index] = LabelPlacement{Anchor: c.Anchor, Box: c.Box}
+	}
+	if overlap == LabelOverlapUnset || overlap == LabelOverlapAllow {
+		return placements
+	}
+
+	var rnd *rand.Rand
+	order := make([]int, len(candidates))
+	for index := range order {
+		order[index] = index
+	}
+	if seed != 0 {
+		rnd = rand.New(rand.NewSource(seed))
+		order = rnd.Perm(len(candidates))
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return candidates[order[i]].Priority > candidates[order[j]].Priority
+	})
+
+	nudge := overlap == LabelOverlapNudge || overlap == LabelOverlapNudgeOrHide
+	hide := overlap == LabelOverlapHide || overlap == LabelOverlapNudgeOrHide
+
+	var placed []Box
+	for _, index := range order {
+		c := candidates[index]
+		if !labelOverlaps(c.Box, placed) {
+			placed = append(placed, c.Box)
+			continue
+		}
+
+		if nudge {
+			if offset, ok := labelNudge(bounds, c.Box, placed, rnd); ok {
+				placements[index].Box = c.Box.Shift(offset.X, offset.Y)
+				placements[index].Offset = offset
+				placed = append(placed, placements[index].Box)
+				continue
+			}
+		}
+
+		if hide {
+			placements[index].Hidden = true
+			continue
+		}
+		placed = append(placed, c.Box)
+	}
+	return placements
+}
+
+// labelNudge finds the closest free position above or below a box, trying above first unless randomized.
+func labelNudge(bounds, box Box, placed []Box, rnd *rand.Rand) (Point, bool) {
+	step := box.Height() + DefaultLabelSpacing
+	directions := []int{-1, 1}
+	if rnd != nil && rnd.Intn(2) == 1 {
+		directions = []int{1, -1}
+	}
+	for x := 1; x <= DefaultLabelNudgeSteps; x++ {
+		for _, direction := range directions {
+			offset := Point{X: DefaultLabelLeaderOffset, Y: direction * x * step}
+			candidate := box.Shift(offset.X, offset.Y)
+			if candidate.Top < bounds.Top || candidate.Bottom > bounds.Bottom {
+				continue
+			}
+			if !labelOverlaps(candidate, placed) {
+				return offset, true
+			}
+		}
+	}
+	return Point{}, false
+}
+
+func labelOverlaps(box Box, placed []Box) bool {
+	for _, p := range placed {
+		if box.Intersects(p) {
+			return true
+		}
+	}
+	return false
+}
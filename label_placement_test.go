@@ -0,0 +1,114 @@
+package chart
+
+import (
+	"testing"
+
+	"github.com/blendlabs/go-assert"
+)
+
+func TestPlaceLabelsAllow(t *testing.T) {
+	assert := assert.New(t)
+
+	bounds := Box{Top: 0, Left: 0, Right: 100, Bottom: 100}
+	candidates := []LabelCandidate{
+		{Anchor: Point{10, 50}, Box: Box{Top: 45, Left: 10, Right: 40, Bottom: 55}},
+		{Anchor: Point{20, 50}, Box: Box{Top: 45, Left: 20, Right: 50, Bottom: 55}},
+	}
+	placements := PlaceLabels(bounds, candidates, LabelOverlapAllow)
+	assert.Len(placements, 2)
+	for index, p := range placements {
+		assert.False(p.Hidden)
+		assert.False(p.HasLeader())
+		assert.True(p.Box.Equals(candidates[index].Box))
+	}
+}
+
+func TestPlaceLabelsNudge(t *testing.T) {
+	assert := assert.New(t)
+
+	bounds := Box{Top: 0, Left: 0, Right: 100, Bottom: 100}
+	candidates := []LabelCandidate{
+		{Anchor: Point{10, 50}, Box: Box{Top: 45, Left: 10, Right: 40, Bottom: 55}},
+		{Anchor: Point{20, 50}, Box: Box{Top: 45, Left: 20, Right: 50, Bottom: 55}},
+		{Anchor: Point{30, 50}, Box: Box{Top: 45, Left: 30, Right: 60, Bottom: 55}},
+	}
+	placements := PlaceLabels(bounds, candidates, LabelOverlapNudge)
+	assert.Len(placements, 3)
+	assert.False(placements[0].HasLeader())
+	assert.True(placements[1].HasLeader())
+	assert.True(placements[2].HasLeader())
+	assert.Equal(-(10 + DefaultLabelSpacing), placements[1].Offset.Y)
+	assert.Equal(10+DefaultLabelSpacing, placements[2].Offset.Y)
+
+	for i := range placements {
+		for j := i + 1; j < len(placements); j++ {
+			assert.False(placements[i].Box.Intersects(placements[j].Box))
+		}
+	}
+}
+
+func TestPlaceLabelsHidePriority(t *testing.T) {
+	assert := assert.New(t)
+
+	bounds := Box{Top: 0, Left: 0, Right: 100, Bottom: 100}
+	candidates := []LabelCandidate{
+		{Anchor: Point{10, 50}, Box: Box{Top: 45, Left: 10, Right: 40, Bottom: 55}},
+		{Anchor: Point{20, 50}, Box: Box{Top: 45, Left: 20, Right: 50, Bottom: 55}, Priority: 1},
+	}
+	placements := PlaceLabels(bounds, candidates, LabelOverlapHide)
+	assert.True(placements[0].Hidden)
+	assert.False(placements[1].Hidden)
+}
+
+func TestPlaceLabelsNudgeOrHide(t *testing.T) {
+	assert := assert.New(t)
+
+	// no room above or below to nudge into.
+	bounds := Box{Top: 40, Left: 0, Right: 100, Bottom: 60}
+	candidates := []LabelCandidate{
+		{Anchor: Point{10, 50}, Box: Box{Top: 45, Left: 10, Right: 40, Bottom: 55}},
+		{Anchor: Point{20, 50}, Box: Box{Top: 45, Left: 20, Right: 50, Bottom: 55}},
+	}
+	nudged := PlaceLabels(bounds, candidates, LabelOverlapNudge)
+	assert.False(nudged[1].Hidden)
+	assert.False(nudged[1].HasLeader())
+
+	hidden := PlaceLabels(bounds, candidates, LabelOverlapNudgeOrHide)
+	assert.False(hidden[0].Hidden)
+	assert.True(hidden[1].Hidden)
+	assert.False(hidden[1].HasLeader())
+}
+
+func TestPlaceLabelsWithSeed(t *testing.T) {
+	assert := assert.New(t)
+
+	bounds := Box{Top: 0, Left: 0, Right: 100, Bottom: 100}
+	candidates := []LabelCandidate{
+		{Anchor: Point{10, 50}, Box: Box{Top: 45, Left: 10, Right: 40, Bottom: 55}},
+		{Anchor: Point{20, 50}, Box: Box{Top: 45, Left: 20, Right: 50, Bottom: 55}},
+		{Anchor: Point{30, 50}, Box: Box{Top: 45, Left: 30, Right: 60, Bottom: 55}},
+	}
+
+	unseeded := PlaceLabelsWithSeed(bounds, candidates, LabelOverlapHide, 0)
+	assert.False(unseeded[0].Hidden)
+	assert.True(unseeded[1].Hidden)
+
+	// the same seed places the labels the same way, and some seed keeps another label than the first.
+	var varied bool
+	for seed := int64(1); seed < 10; seed++ {
+		first := PlaceLabelsWithSeed(bounds, candidates, LabelOverlapHide, seed)
+		second := PlaceLabelsWithSeed(bounds, candidates, LabelOverlapHide, seed)
+		var shown int
+		for index := range first {
+			assert.Equal(first[index].Hidden, second[index].Hidden)
+			if !first[index].Hidden {
+				shown++
+			}
+		}
+		assert.Equal(1, shown)
+		if first[0].Hidden {
+			varied = true
+		}
+	}
+	assert.True(varied)
+}
@@ -2,6 +2,36 @@ package chart
 
 import "github.com/wcharczuk/go-chart/drawing"
 
+// LegendDerived is which of derived series and their inner series are shown in legends.
+type LegendDerived int
+
+const (
+	// LegendDerivedBoth shows both derived series and their inner series.
+	LegendDerivedBoth LegendDerived = 0
+	// LegendDerivedOnly shows derived series but hides their inner series.
+	LegendDerivedOnly LegendDerived = 1
+	// LegendDerivedInnerOnly shows the inner series but hides the series derived from them.
+	LegendDerivedInnerOnly LegendDerived = 2
+)
+
+// GetLegendName returns the name a series is shown with in legends; derived series without a name of their own are
+// named after their derivation and their inner series, i.e. "SMA(20) of CPU".
+func GetLegendName(s Series) string {
+	if name := s.GetName(); len(name) > 0 {
+		return name
+	}
+	if ds, isDerivedSeries := s.(DerivedSeries); isDerivedSeries {
+		if np, isNameProvider := ds.GetInnerSeries().(NameProvider); isNameProvider && len(np.GetName()) > 0 {
+			return ds.GetDerivation() + " of " + np.GetName()
+		}
+		return ds.GetDerivation()
+	}
+	return ""
+}
+
+// LegendFunc returns a legend element for a chart, i.e. `Legend`, `LegendThin` or `LegendLeft`.
+type LegendFunc func(c *Chart, userDefaults ...Style) Renderable
+
 // Legend returns a legend renderable function.
 func Legend(c *Chart, userDefaults ...Style) Renderable {
 	return func(r Renderer, cb Box, chartDefaults Style) {
@@ -20,6 +50,8 @@ func Legend(c *Chart, userDefaults ...Style) Renderable {
 			legendStyle = chartDefaults.InheritFrom(legendDefaults)
 		}
 
+		mirrored := c.IsRTL || legendStyle.TextHorizontalAlign == TextHorizontalAlignRight
+
 		// DEFAULTS
 		legendPadding := Box{
 			Top:    5,
@@ -30,16 +62,7 @@ func Legend(c *Chart, userDefaults ...Style) Renderable {
 		lineTextGap := 5
 		lineLengthMinimum := 25
 
-		var labels []string
-		var lines []Style
-		for index, s := range c.Series {
-			if s.GetStyle().IsZero() || s.GetStyle().Show {
-				if _, isAnnotationSeries := s.(AnnotationSeries); !isAnnotationSeries {
-					labels = append(labels, s.GetName())
-					lines = append(lines, s.GetStyle().InheritFrom(c.styleDefaultsSeries(index)))
-				}
-			}
-		}
+		labels, lines := c.getLegendEntries(c.getLayoutSeries(getLayout(r, Box{Right: c.GetWidth(), Bottom: c.GetHeight()})))
 
 		legend := Box{
 			Top:  cb.Top,
@@ -55,12 +78,13 @@ func Legend(c *Chart, userDefaults ...Style) Renderable {
 		}
 
 		legendStyle.GetTextOptions().WriteToRenderer(r)
+		labelLines := legendLabelLines(r, labels, legendStyle.GetTextMaxWidth(cb.Width()>>2), legendStyle)
 
 		// measure
 		labelCount := 0
 		for x := 0; x < len(labels); x++ {
 			if len(labels[x]) > 0 {
-				tb := r.MeasureText(labels[x])
+				tb := Text.MeasureLines(r, labelLines[x], legendStyle)
 				if labelCount > 0 {
 					legendContent.Bottom += DefaultMinimumTickVerticalSpacing
 				}
@@ -75,6 +99,12 @@ func Legend(c *Chart, userDefaults ...Style) Renderable {
 		legend.Right = legendContent.Right + legendPadding.Right
 		legend.Bottom = legendContent.Bottom + legendPadding.Bottom
 
+		if c.IsRTL {
+			offset := cb.Right - legend.Right
+			legend = legend.Shift(offset, 0)
+			legendContent = legendContent.Shift(offset, 0)
+		}
+
 		Draw.Box(r, legend, legendStyle)
 
 		legendStyle.GetTextOptions().WriteToRenderer(r)
@@ -90,16 +120,33 @@ func Legend(c *Chart, userDefaults ...Style) Renderable {
 					ycursor += DefaultMinimumTickVerticalSpacing
 				}
 
-				tb := r.MeasureText(label)
+				tb := Text.MeasureLines(r, labelLines[x], legendStyle)
+				fb := r.MeasureText(labelLines[x][0])
 
-				ty := ycursor + tb.Height()
-				r.Text(label, tx, ty)
+				ty := ycursor + fb.Height()
+				th2 := fb.Height() >> 1
+				ly := ty - th2
 
-				th2 := tb.Height() >> 1
+				var lx, lx2 int
+				if mirrored {
+					lx = legendContent.Left + legendPadding.Left
+					lx2 = legendContent.Right - (tb.Width() + lineTextGap)
+				} else {
+					lx = tx + tb.Width() + lineTextGap
+					lx2 = legendContent.Right - legendPadding.Right
+				}
 
-				lx := tx + tb.Width() + lineTextGap
-				ly := ty - th2
-				lx2 := legendContent.Right - legendPadding.Right
+				lty := ycursor
+				for _, line := range labelLines[x] {
+					lb := r.MeasureText(line)
+					lty += lb.Height()
+					if mirrored {
+						r.Text(line, legendContent.Right-lb.Width(), lty)
+					} else {
+						r.Text(line, tx, lty)
+					}
+					lty += legendStyle.GetTextLineSpacing()
+				}
 
 				r.SetStrokeColor(lines[x].GetStrokeColor())
 				r.SetStrokeWidth(lines[x].GetStrokeWidth())
@@ -108,6 +155,7 @@ func Legend(c *Chart, userDefaults ...Style) Renderable {
 				r.MoveTo(lx, ly)
 				r.LineTo(lx2, ly)
 				r.Stroke()
+				recordArea(r, ImageMapAreaLegend, label, label, Box{Top: ycursor, Left: legendContent.Left, Right: legendContent.Right, Bottom: ycursor + tb.Height()})
 
 				ycursor += tb.Height()
 				legendCount++
@@ -144,14 +192,11 @@ func LegendThin(c *Chart, userDefaults ...Style) Renderable {
 		r.SetFontColor(legendStyle.GetFontColor())
 		r.SetFontSize(legendStyle.GetFontSize())
 
-		var labels []string
-		var lines []Style
-		for index, s := range c.Series {
-			if s.GetStyle().IsZero() || s.GetStyle().Show {
-				if _, isAnnotationSeries := s.(AnnotationSeries); !isAnnotationSeries {
-					labels = append(labels, s.GetName())
-					lines = append(lines, s.GetStyle().InheritFrom(c.styleDefaultsSeries(index)))
-				}
+		labels, lines := c.getLegendEntries(c.getLayoutSeries(getLayout(r, Box{Right: c.GetWidth(), Bottom: c.GetHeight()})))
+
+		if maxWidth := legendStyle.GetTextMaxWidth(); maxWidth > 0 {
+			for x := 0; x < len(labels); x++ {
+				labels[x] = Text.Ellipsize(r, labels[x], maxWidth, legendStyle)
 			}
 		}
 
@@ -186,7 +231,12 @@ func LegendThin(c *Chart, userDefaults ...Style) Renderable {
 		lineTextGap := 5
 		lineLengthMinimum := 25
 
+		mirrored := c.IsRTL || legendStyle.TextHorizontalAlign == TextHorizontalAlignRight
+
 		tx := legendBox.Left + legendStyle.Padding.Left
+		if mirrored {
+			tx = legendBox.Right - legendStyle.Padding.Right
+		}
 		ty := legendYMargin + legendStyle.Padding.Top + textHeight
 		var label string
 		var lx, ly int
@@ -195,11 +245,18 @@ func LegendThin(c *Chart, userDefaults ...Style) Renderable {
 			label = labels[index]
 			if len(label) > 0 {
 				textBox = r.MeasureText(label)
-				r.Text(label, tx, ty)
-
-				lx = tx + textBox.Width() + lineTextGap
 				ly = ty - th2
 
+				itemWidth := textBox.Width() + DefaultMinimumTickHorizontalSpacing + lineTextGap + lineLengthMinimum
+				if mirrored {
+					// items flow from the right edge, with the line to the left of the label.
+					r.Text(label, tx-textBox.Width(), ty)
+					lx = tx - (textBox.Width() + lineTextGap + lineLengthMinimum)
+				} else {
+					r.Text(label, tx, ty)
+					lx = tx + textBox.Width() + lineTextGap
+				}
+
 				r.SetStrokeColor(lines[index].GetStrokeColor())
 				r.SetStrokeWidth(lines[index].GetStrokeWidth())
 				r.SetStrokeDashArray(lines[index].GetStrokeDashArray())
@@ -207,8 +264,17 @@ func LegendThin(c *Chart, userDefaults ...Style) Renderable {
 				r.MoveTo(lx, ly)
 				r.LineTo(lx+lineLengthMinimum, ly)
 				r.Stroke()
+				if mirrored {
+					recordArea(r, ImageMapAreaLegend, label, label, Box{Top: legendBox.Top, Left: lx, Right: tx, Bottom: legendBox.Bottom})
+				} else {
+					recordArea(r, ImageMapAreaLegend, label, label, Box{Top: legendBox.Top, Left: tx, Right: lx + lineLengthMinimum, Bottom: legendBox.Bottom})
+				}
 
-				tx += textBox.Width() + DefaultMinimumTickHorizontalSpacing + lineTextGap + lineLengthMinimum
+				if mirrored {
+					tx -= itemWidth
+				} else {
+					tx += itemWidth
+				}
 			}
 		}
 	}
@@ -232,6 +298,8 @@ func LegendLeft(c *Chart, userDefaults ...Style) Renderable {
 			legendStyle = chartDefaults.InheritFrom(legendDefaults)
 		}
 
+		mirrored := c.IsRTL || legendStyle.TextHorizontalAlign == TextHorizontalAlignRight
+
 		// DEFAULTS
 		legendPadding := Box{
 			Top:    5,
@@ -242,16 +310,7 @@ func LegendLeft(c *Chart, userDefaults ...Style) Renderable {
 		lineTextGap := 5
 		lineLengthMinimum := 25
 
-		var labels []string
-		var lines []Style
-		for index, s := range c.Series {
-			if s.GetStyle().IsZero() || s.GetStyle().Show {
-				if _, isAnnotationSeries := s.(AnnotationSeries); !isAnnotationSeries {
-					labels = append(labels, s.GetName())
-					lines = append(lines, s.GetStyle().InheritFrom(c.styleDefaultsSeries(index)))
-				}
-			}
-		}
+		labels, lines := c.getLegendEntries(c.getLayoutSeries(getLayout(r, Box{Right: c.GetWidth(), Bottom: c.GetHeight()})))
 
 		legend := Box{
 			Top:  5,
@@ -267,12 +326,13 @@ func LegendLeft(c *Chart, userDefaults ...Style) Renderable {
 		}
 
 		legendStyle.GetTextOptions().WriteToRenderer(r)
+		labelLines := legendLabelLines(r, labels, legendStyle.GetTextMaxWidth(cb.Width()>>2), legendStyle)
 
 		// measure
 		labelCount := 0
 		for x := 0; x < len(labels); x++ {
 			if len(labels[x]) > 0 {
-				tb := r.MeasureText(labels[x])
+				tb := Text.MeasureLines(r, labelLines[x], legendStyle)
 				if labelCount > 0 {
 					legendContent.Bottom += DefaultMinimumTickVerticalSpacing
 				}
@@ -287,6 +347,12 @@ func LegendLeft(c *Chart, userDefaults ...Style) Renderable {
 		legend.Right = legendContent.Right + legendPadding.Right
 		legend.Bottom = legendContent.Bottom + legendPadding.Bottom
 
+		if c.IsRTL {
+			offset := (c.GetWidth() - 5) - legend.Right
+			legend = legend.Shift(offset, 0)
+			legendContent = legendContent.Shift(offset, 0)
+		}
+
 		Draw.Box(r, legend, legendStyle)
 
 		legendStyle.GetTextOptions().WriteToRenderer(r)
@@ -302,16 +368,33 @@ func LegendLeft(c *Chart, userDefaults ...Style) Renderable {
 					ycursor += DefaultMinimumTickVerticalSpacing
 				}
 
-				tb := r.MeasureText(label)
+				tb := Text.MeasureLines(r, labelLines[x], legendStyle)
+				fb := r.MeasureText(labelLines[x][0])
 
-				ty := ycursor + tb.Height()
-				r.Text(label, tx, ty)
+				ty := ycursor + fb.Height()
+				th2 := fb.Height() >> 1
+				ly := ty - th2
 
-				th2 := tb.Height() >> 1
+				var lx, lx2 int
+				if mirrored {
+					lx = legendContent.Left + legendPadding.Left
+					lx2 = legendContent.Right - (tb.Width() + lineTextGap)
+				} else {
+					lx = tx + tb.Width() + lineTextGap
+					lx2 = legendContent.Right - legendPadding.Right
+				}
 
-				lx := tx + tb.Width() + lineTextGap
-				ly := ty - th2
-				lx2 := legendContent.Right - legendPadding.Right
+				lty := ycursor
+				for _, line := range labelLines[x] {
+					lb := r.MeasureText(line)
+					lty += lb.Height()
+					if mirrored {
+						r.Text(line, legendContent.Right-lb.Width(), lty)
+					} else {
+						r.Text(line, tx, lty)
+					}
+					lty += legendStyle.GetTextLineSpacing()
+				}
 
 				r.SetStrokeColor(lines[x].GetStrokeColor())
 				r.SetStrokeWidth(lines[x].GetStrokeWidth())
@@ -320,6 +403,7 @@ func LegendLeft(c *Chart, userDefaults ...Style) Renderable {
 				r.MoveTo(lx, ly)
 				r.LineTo(lx2, ly)
 				r.Stroke()
+				recordArea(r, ImageMapAreaLegend, label, label, Box{Top: ycursor, Left: legendContent.Left, Right: legendContent.Right, Bottom: ycursor + tb.Height()})
 
 				ycursor += tb.Height()
 				legendCount++
@@ -327,3 +411,50 @@ func LegendLeft(c *Chart, userDefaults ...Style) Renderable {
 		}
 	}
 }
+
+// legendLabelLines splits the legend labels into lines, wrapping them to a width if the style has a wrap option set.
+func legendLabelLines(r Renderer, labels []string, width int, style Style) [][]string {
+	output := make([][]string, len(labels))
+	for index, label := range labels {
+		if style.TextWrap == TextWrapUnset {
+			output[index] = []string{label}
+			continue
+		}
+		output[index] = Text.Wrap(r, label, width, style)
+	}
+	return output
+}
+
+// getLegendEntries returns the label and line style of each series shown in legends.
+// Inner series are matched to the chart's series by name, so an unnamed inner series is always shown.
+func (c Chart) getLegendEntries(series []Series) (labels []string, lines []Style) {
+	inner := map[string]bool{}
+	if c.LegendDerived == LegendDerivedOnly {
+		for _, s := range series {
+			if ds, isDerivedSeries := s.(DerivedSeries); isDerivedSeries {
+				if np, isNameProvider := ds.GetInnerSeries().(NameProvider); isNameProvider && len(np.GetName()) > 0 {
+					inner[np.GetName()] = true
+				}
+			}
+		}
+	}
+
+	for index, s := range series {
+		if !s.GetStyle().IsZero() && !s.GetStyle().Show {
+			continue
+		}
+		if _, isAnnotationSeries := s.(AnnotationSeries); isAnnotationSeries {
+			continue
+		}
+		_, isDerivedSeries := s.(DerivedSeries)
+		if isDerivedSeries && c.LegendDerived == LegendDerivedInnerOnly {
+			continue
+		}
+		if !isDerivedSeries && inner[s.GetName()] {
+			continue
+		}
+		labels = append(labels, GetLegendName(s))
+		lines = append(lines, s.GetStyle().InheritFrom(c.styleDefaultsSeries(index)))
+	}
+	return
+}
@@ -29,3 +29,65 @@ func TestLegend(t *testing.T) {
 	assert.Nil(err)
 	assert.NotZero(buf.Len())
 }
+
+func TestLegendRTL(t *testing.T) {
+	assert := assert.New(t)
+
+	graph := Chart{
+		IsRTL: true,
+		Series: []Series{
+			ContinuousSeries{
+				Name:    "סדרה",
+				XValues: []float64{1.0, 2.0, 3.0, 4.0, 5.0},
+				YValues: []float64{1.0, 2.0, 3.0, 4.0, 5.0},
+			},
+		},
+	}
+
+	graph.Elements = []Renderable{
+		Legend(&graph),
+		LegendThin(&graph),
+		LegendLeft(&graph),
+	}
+	buf := bytes.NewBuffer([]byte{})
+	err := graph.Render(PNG, buf)
+	assert.Nil(err)
+	assert.NotZero(buf.Len())
+}
+
+func TestGetLegendName(t *testing.T) {
+	assert := assert.New(t)
+
+	cpu := ContinuousSeries{Name: "CPU", XValues: []float64{1, 2, 3}, YValues: []float64{1, 2, 3}}
+	assert.Equal("CPU", GetLegendName(cpu))
+	assert.Equal("SMA(20) of CPU", GetLegendName(SMASeries{Period: 20, InnerSeries: cpu}))
+	assert.Equal("EMA(12) of CPU", GetLegendName(&EMASeries{InnerSeries: cpu}))
+	assert.Equal("Bollinger(10, 2) of CPU", GetLegendName(&BollingerBandsSeries{Period: 10, InnerSeries: cpu}))
+	assert.Equal("Trend", GetLegendName(&LinearRegressionSeries{Name: "Trend", InnerSeries: cpu}))
+	assert.Equal("Max", GetLegendName(&MaxSeries{InnerSeries: ContinuousSeries{}}))
+}
+
+func TestChartLegendDerived(t *testing.T) {
+	assert := assert.New(t)
+
+	cpu := ContinuousSeries{Name: "CPU", XValues: []float64{1, 2, 3}, YValues: []float64{1, 2, 3}}
+	c := Chart{
+		Series: []Series{
+			cpu,
+			SMASeries{Period: 2, InnerSeries: cpu},
+			ContinuousSeries{Name: "Memory", XValues: []float64{1, 2, 3}, YValues: []float64{3, 2, 1}},
+		},
+	}
+
+	labels, _ := c.getLegendEntries(c.Series)
+	assert.Equal([]string{"CPU", "SMA(2) of CPU", "Memory"}, labels)
+
+	c.LegendDerived = LegendDerivedOnly
+	labels, lines := c.getLegendEntries(c.Series)
+	assert.Equal([]string{"SMA(2) of CPU", "Memory"}, labels)
+	assert.Equal(c.styleDefaultsSeries(1).StrokeColor, lines[0].StrokeColor)
+
+	c.LegendDerived = LegendDerivedInnerOnly
+	labels, _ = c.getLegendEntries(c.Series)
+	assert.Equal([]string{"CPU", "Memory"}, labels)
+}
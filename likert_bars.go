@@ -0,0 +1,142 @@
+package chart
+
+import (
+	"fmt"
+
+	"github.com/wcharczuk/go-chart/drawing"
+)
+
+// LikertBar is the set of responses for a single likert item (i.e. a survey question).
+// The values are ordered from the most negative response to the most positive response.
+type LikertBar struct {
+	Name   string
+	Width  int
+	Values []Value
+}
+
+// LikertBars returns the bars of a diverging (likert) stacked bar chart, to be drawn
+// by a horizontal `StackedBarChart`.
+//
+// Each bar is normalized to percentages and then padded with transparent segments so that
+// the center of the neutral response lines up across every bar. If `neutralIndex` is
+// out of bounds (ex. -1), the scale is treated as having no neutral response and
+// the bars are centered on the boundary between the lower and upper half of the responses.
+//
+// Values without a style are given a diverging default color, and values without a label
+// are labeled with their percentage.
+func LikertBars(neutralIndex int, bars ...LikertBar) []StackedBar {
+	lefts := make([]float64, len(bars))
+	normalized := make([][]float64, len(bars))
+
+	var maxLeft, maxRight float64
+	for index, bar := range bars {
+		normalized[index] = likertNormalize(bar.Values)
+		lefts[index] = likertLeftExtent(normalized[index], neutralIndex)
+		maxLeft = Math.Max(maxLeft, lefts[index])
+		maxRight = Math.Max(maxRight, 1.0-lefts[index])
+	}
+
+	output := make([]StackedBar, len(bars))
+	for index, bar := range bars {
+		values := []Value{likertSpacer(maxLeft - lefts[index])}
+		for valueIndex, v := range bar.Values {
+			pct := normalized[index][valueIndex]
+
+			style := v.Style
+			if style.IsZero() {
+				color := likertColor(valueIndex, neutralIndex, len(bar.Values))
+				style = Style{FillColor: color, StrokeColor: color}
+			}
+
+			label := v.Label
+			if len(label) == 0 {
+				label = fmt.Sprintf("%0.0f%%", pct*100)
+			}
+
+			values = append(values, Value{Style: style, Label: label, Value: pct})
+		}
+		values = append(values, likertSpacer(maxRight-(1.0-lefts[index])))
+
+		output[index] = StackedBar{
+			Name:   bar.Name,
+			Width:  bar.Width,
+			Values: values,
+		}
+	}
+	return output
+}
+
+// likertNormalize returns the values as fractions of the total of the (positive) values.
+func likertNormalize(values []Value) []float64 {
+	var total float64
+	for _, v := range values {
+		if v.Value > 0 {
+			total += v.Value
+		}
+	}
+
+	output := make([]float64, len(values))
+	if total == 0 {
+		return output
+	}
+	for index, v := range values {
+		if v.Value > 0 {
+			output[index] = v.Value / total
+		}
+	}
+	return output
+}
+
+// likertLeftExtent returns the fraction of the bar that falls before the center.
+func likertLeftExtent(normalized []float64, neutralIndex int) float64 {
+	hasNeutral := neutralIndex >= 0 && neutralIndex < len(normalized)
+	center := neutralIndex
+	if !hasNeutral {
+		center = len(normalized) >> 1
+	}
+
+	var left float64
+	for index := 0; index < center; index++ {
+		left += normalized[index]
+	}
+	if hasNeutral {
+		left += normalized[neutralIndex] / 2.0
+	}
+	return left
+}
+
+func likertSpacer(value float64) Value {
+	return Value{
+		Style: Style{
+			FillColor:   ColorTransparent,
+			StrokeColor: ColorTransparent,
+		},
+		Value: value,
+	}
+}
+
+// likertColor returns a default color for a response; negative responses are shades of red,
+// positive responses are shades of blue, and the neutral response is gray.
+func likertColor(index, neutralIndex, count int) drawing.Color {
+	hasNeutral := neutralIndex >= 0 && neutralIndex < count
+	if hasNeutral && index == neutralIndex {
+		return ColorAlternateLightGray
+	}
+
+	center := float64(count) / 2.0
+	if hasNeutral {
+		center = float64(neutralIndex) + 0.5
+	}
+
+	position := float64(index) + 0.5
+	base := ColorBlue
+	distance := position - center
+	if distance < 0 {
+		base = ColorRed
+		distance = -distance
+	}
+
+	// the farther from the center, the more saturated the color.
+	intensity := 0.4 + 0.6*(distance/Math.Max(center, float64(count)-center))
+	return colorLerp(ColorWhite, base, intensity)
+}
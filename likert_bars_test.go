@@ -0,0 +1,67 @@
+package chart
+
+import (
+	"bytes"
+	"testing"
+
+	assert "github.com/blendlabs/go-assert"
+)
+
+func TestLikertBarsCentered(t *testing.T) {
+	assert := assert.New(t)
+
+	bars := LikertBars(2,
+		LikertBar{Name: "Q1", Values: []Value{{Value: 1}, {Value: 1}, {Value: 2}, {Value: 3}, {Value: 3}}},
+		LikertBar{Name: "Q2", Values: []Value{{Value: 4}, {Value: 2}, {Value: 2}, {Value: 1}, {Value: 1}}},
+	)
+	assert.Len(bars, 2)
+
+	// the offset to the center of the neutral value should be the same for both bars.
+	centerOf := func(bar StackedBar) float64 {
+		var center float64
+		for index := 0; index < 3; index++ { // spacer + the first two responses.
+			center += bar.Values[index].Value
+		}
+		return center + bar.Values[3].Value/2.0
+	}
+	assert.InDelta(centerOf(bars[0]), centerOf(bars[1]), 0.0001)
+
+	// every bar has the same total so the bars line up regardless of normalization.
+	assert.InDelta(Math.Sum(Values(bars[0].Values).Values()...), Math.Sum(Values(bars[1].Values).Values()...), 0.0001)
+
+	assert.Equal("10%", bars[0].Values[1].Label)
+	assert.False(bars[0].Values[1].Style.IsZero())
+	assert.True(bars[0].Values[0].Style.FillColor.IsTransparent())
+}
+
+func TestLikertBarsNoNeutral(t *testing.T) {
+	assert := assert.New(t)
+
+	bars := LikertBars(-1,
+		LikertBar{Values: []Value{{Value: 1}, {Value: 1}, {Value: 1}, {Value: 1}}},
+		LikertBar{Values: []Value{{Value: 3}, {Value: 1}, {Value: 0}, {Value: 0}}},
+	)
+
+	left0 := bars[0].Values[0].Value + bars[0].Values[1].Value + bars[0].Values[2].Value
+	left1 := bars[1].Values[0].Value + bars[1].Values[1].Value + bars[1].Values[2].Value
+	assert.InDelta(left0, left1, 0.0001)
+	assert.InDelta(1.0, left1, 0.0001)
+}
+
+func TestLikertBarsRender(t *testing.T) {
+	assert := assert.New(t)
+
+	sbc := StackedBarChart{
+		IsHorizontal:      true,
+		YAxis:             StyleShow(),
+		SegmentLabelStyle: StyleShow(),
+		Bars: LikertBars(1,
+			LikertBar{Name: "Q1", Values: []Value{{Value: 1}, {Value: 2}, {Value: 3}}},
+			LikertBar{Name: "Q2", Values: []Value{{Value: 3}, {Value: 2}, {Value: 1}}},
+		),
+	}
+
+	buf := bytes.NewBuffer([]byte{})
+	assert.Nil(sbc.Render(PNG, buf))
+	assert.NotZero(buf.Len())
+}
@@ -29,6 +29,16 @@ func (lrs LinearRegressionSeries) GetStyle() Style {
 	return lrs.Style
 }
 
+// GetInnerSeries implements `DerivedSeries`.
+func (lrs LinearRegressionSeries) GetInnerSeries() ValueProvider {
+	return lrs.InnerSeries
+}
+
+// GetDerivation implements `DerivedSeries`.
+func (lrs LinearRegressionSeries) GetDerivation() string {
+	return "Linear regression"
+}
+
 // GetYAxis returns which YAxis the series draws on.
 func (lrs LinearRegressionSeries) GetYAxis() YAxisType {
 	return lrs.YAxis
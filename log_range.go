@@ -0,0 +1,236 @@
+package chart
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+)
+
+const (
+	// DefaultLogBase is the default base of a log range.
+	DefaultLogBase = 10.0
+)
+
+// LogLabelStyle is how the major ticks of a log range are labeled.
+type LogLabelStyle int
+
+const (
+	// LogLabelSuperscript labels ticks as powers with superscript exponents, i.e. 10⁰, 10¹, 10².
+	LogLabelSuperscript LogLabelStyle = 0
+	// LogLabelExponent labels ticks in exponent notation, i.e. 1e0, 1e1, 1e2 (or 2^3 for other bases).
+	LogLabelExponent LogLabelStyle = 1
+	// LogLabelValue labels ticks with the value formatter of the axis, i.e. 1.00, 10.00, 100.00.
+	LogLabelValue LogLabelStyle = 2
+)
+
+// LogRange is a range that maps values logarithmically, with major ticks at the powers of its base
+// and (optionally) minor ticks at the multiples of each power in between.
+// Values that are not positive are drawn at the bottom of the range.
+//
+// If the range is left unset it is fit to the series, with the bounds rounded out to powers of the base.
+type LogRange struct {
+	Min        float64
+	Max        float64
+	Domain     int
+	Descending bool
+
+	// Base is the base of the logarithm, it defaults to 10.
+	Base       float64
+	LabelStyle LogLabelStyle
+	// MinorTicks adds unlabeled ticks (and minor grid lines) at 2x, 3x .. 9x each power of the base.
+	MinorTicks bool
+}
+
+// GetBase returns the base or a default.
+func (lr LogRange) GetBase() float64 {
+	if lr.Base <= 1 {
+		return DefaultLogBase
+	}
+	return lr.Base
+}
+
+// IsDescending returns if the range is descending.
+func (lr LogRange) IsDescending() bool {
+	return lr.Descending
+}
+
+// IsZero returns if the range has been set or not.
+func (lr LogRange) IsZero() bool {
+	return (lr.Min == 0 || math.IsNaN(lr.Min)) &&
+		(lr.Max == 0 || math.IsNaN(lr.Max)) &&
+		lr.Domain == 0
+}
+
+// GetMin gets the min value for the range.
+func (lr LogRange) GetMin() float64 {
+	return lr.Min
+}
+
+// SetMin sets the min value, rounded down to a power of the base; values that are not positive are ignored.
+func (lr *LogRange) SetMin(min float64) {
+	if min <= 0 || math.IsNaN(min) || math.IsInf(min, 0) {
+		return
+	}
+	lr.Min = math.Pow(lr.GetBase(), math.Floor(lr.log(min)+1e-9))
+}
+
+// GetMax returns the max value for the range.
+func (lr LogRange) GetMax() float64 {
+	return lr.Max
+}
+
+// SetMax sets the max value, rounded up to a power of the base; values that are not positive are ignored.
+func (lr *LogRange) SetMax(max float64) {
+	if max <= 0 || math.IsNaN(max) || math.IsInf(max, 0) {
+		return
+	}
+	lr.Max = math.Pow(lr.GetBase(), math.Ceil(lr.log(max)-1e-9))
+}
+
+// GetDelta returns the difference between the min and max value.
+func (lr LogRange) GetDelta() float64 {
+	return lr.Max - lr.Min
+}
+
+// GetDomain returns the range domain.
+func (lr LogRange) GetDomain() int {
+	return lr.Domain
+}
+
+// SetDomain sets the range domain.
+func (lr *LogRange) SetDomain(domain int) {
+	lr.Domain = domain
+}
+
+// String returns a simple string for the range.
+func (lr LogRange) String() string {
+	return fmt.Sprintf("LogRange [%.2f,%.2f] => %d", lr.Min, lr.Max, lr.Domain)
+}
+
+// Translate maps a given value into the range space.
+func (lr LogRange) Translate(value float64) int {
+	min, max := lr.getLogBounds()
+	logValue := min
+	if value > 0 {
+		logValue = lr.log(value)
+	}
+	// the logarithms are inexact, so nudge exact powers back before rounding up.
+	scaled := math.Ceil(((logValue-min)/(max-min))*float64(lr.Domain) - 1e-9)
+
+	if lr.IsDescending() {
+		return lr.Domain - int(scaled)
+	}
+	return int(scaled)
+}
+
+// GetTicks returns the ticks at the powers of the base (and their multiples if minor ticks are enabled).
+// If the labels of every power don't fit the domain, only every n-th power is labeled.
+func (lr *LogRange) GetTicks(r Renderer, defaults Style, vf ValueFormatter) []Tick {
+	min, max := lr.getLogBounds()
+	first, last := int(math.Ceil(min-1e-9)), int(math.Floor(max+1e-9))
+
+	defaults.GetTextOptions().WriteToRenderer(r)
+	var labelSize int
+	for exponent := first; exponent <= last; exponent++ {
+		tb := r.MeasureText(lr.getLabel(exponent, vf))
+		// the range doesn't know which axis it is on, so make room for the labels along either.
+		labelSize = Math.MaxInt(labelSize, tb.Height()+DefaultMinimumTickVerticalSpacing, tb.Width()+(DefaultMinimumTickHorizontalSpacing>>1))
+	}
+
+	step := 1
+	if powers := last - first + 1; powers > 1 && lr.Domain > 0 {
+		available := lr.Domain / Math.MaxInt(labelSize, 1)
+		if available < powers {
+			step = int(math.Ceil(float64(powers) / float64(Math.MaxInt(available, 1))))
+		}
+	}
+
+	base := lr.GetBase()
+	var ticks []Tick
+	for exponent := first; exponent <= last; exponent++ {
+		if (exponent-first)%step == 0 {
+			ticks = append(ticks, Tick{Value: math.Pow(base, float64(exponent)), Label: lr.getLabel(exponent, vf)})
+		}
+	}
+
+	if lr.MinorTicks && step == 1 {
+		for exponent := first - 1; exponent <= last; exponent++ {
+			power := math.Pow(base, float64(exponent))
+			for multiple := 2; float64(multiple) < base; multiple++ {
+				value := float64(multiple) * power
+				if value >= lr.Min && value <= lr.Max {
+					ticks = append(ticks, Tick{Value: value})
+				}
+			}
+		}
+	}
+	return ticks
+}
+
+// GetGridLines returns major grid lines at the labeled ticks and minor grid lines at the unlabeled ticks.
+func (lr LogRange) GetGridLines(ticks []Tick, isVertical bool, majorStyle, minorStyle Style) []GridLine {
+	var gl []GridLine
+	for _, t := range ticks {
+		if t.Value <= lr.Min || t.Value >= lr.Max {
+			continue
+		}
+		isMinor := len(t.Label) == 0
+		style := majorStyle
+		if isMinor {
+			style = minorStyle
+		}
+		gl = append(gl, GridLine{IsMinor: isMinor, Style: style, Value: t.Value})
+	}
+	return gl
+}
+
+func (lr LogRange) getLabel(exponent int, vf ValueFormatter) string {
+	switch lr.LabelStyle {
+	case LogLabelExponent:
+		if lr.GetBase() == 10 {
+			return "1e" + strconv.Itoa(exponent)
+		}
+		return lr.formatBase() + "^" + strconv.Itoa(exponent)
+	case LogLabelValue:
+		return vf(math.Pow(lr.GetBase(), float64(exponent)))
+	}
+	return lr.formatBase() + logSuperscript(exponent)
+}
+
+func (lr LogRange) formatBase() string {
+	if lr.GetBase() == math.E {
+		return "e"
+	}
+	return strconv.FormatFloat(lr.GetBase(), 'f', -1, 64)
+}
+
+// getLogBounds returns the bounds of the range in log space, falling back to a single power if they're not usable.
+func (lr LogRange) getLogBounds() (min, max float64) {
+	if lr.Max <= 0 {
+		return 0, 1
+	}
+	max = lr.log(lr.Max)
+	if lr.Min <= 0 || lr.Min >= lr.Max {
+		return max - 1, max
+	}
+	return lr.log(lr.Min), max
+}
+
+func (lr LogRange) log(value float64) float64 {
+	return math.Log(value) / math.Log(lr.GetBase())
+}
+
+var logSuperscriptDigits = []rune("⁰¹²³⁴⁵⁶⁷⁸⁹")
+
+// logSuperscript returns an integer written in superscript digits.
+func logSuperscript(value int) string {
+	var output []rune
+	if value < 0 {
+		output = append(output, '⁻')
+		value = -value
+	}
+	for _, digit := range strconv.Itoa(value) {
+		output = append(output, logSuperscriptDigits[digit-'0'])
+	}
+	return string(output)
+}
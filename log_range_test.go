@@ -0,0 +1,93 @@
+package chart
+
+import (
+	"testing"
+
+	assert "github.com/blendlabs/go-assert"
+)
+
+func TestLogRangeTranslate(t *testing.T) {
+	assert := assert.New(t)
+
+	lr := &LogRange{Min: 1, Max: 1000, Domain: 300}
+	assert.Equal(0, lr.Translate(1))
+	assert.Equal(100, lr.Translate(10))
+	assert.Equal(200, lr.Translate(100))
+	assert.Equal(300, lr.Translate(1000))
+	assert.Equal(0, lr.Translate(0), "values that are not positive are drawn at the bottom")
+
+	lr.Descending = true
+	assert.Equal(300, lr.Translate(1))
+	assert.Equal(0, lr.Translate(1000))
+}
+
+func TestLogRangeSetMinMax(t *testing.T) {
+	assert := assert.New(t)
+
+	lr := &LogRange{}
+	assert.True(lr.IsZero())
+	lr.SetMin(0.5)
+	lr.SetMax(1500)
+	assert.InDelta(0.1, lr.GetMin(), 0.0000001)
+	assert.InDelta(10000, lr.GetMax(), 0.0000001)
+
+	lr.SetMin(0)
+	lr.SetMin(-10)
+	assert.InDelta(0.1, lr.GetMin(), 0.0000001, "values that are not positive are ignored")
+
+	lr = &LogRange{Base: 2}
+	lr.SetMin(5)
+	lr.SetMax(5)
+	assert.Equal(4.0, lr.GetMin())
+	assert.Equal(8.0, lr.GetMax())
+}
+
+func TestLogRangeGetTicks(t *testing.T) {
+	assert := assert.New(t)
+
+	f, err := GetDefaultFont()
+	assert.Nil(err)
+	r, err := PNG(1024, 1024)
+	assert.Nil(err)
+	defaults := Style{Font: f, FontSize: 10.0}
+
+	lr := &LogRange{Min: 0.1, Max: 100, Domain: 500}
+	ticks := lr.GetTicks(r, defaults, FloatValueFormatter)
+	assert.Len(ticks, 4)
+	assert.Equal("10⁻¹", ticks[0].Label)
+	assert.Equal("10⁰", ticks[1].Label)
+	assert.Equal("10²", ticks[3].Label)
+
+	lr.LabelStyle = LogLabelExponent
+	ticks = lr.GetTicks(r, defaults, FloatValueFormatter)
+	assert.Equal("1e-1", ticks[0].Label)
+	assert.Equal("1e2", ticks[3].Label)
+
+	lr.LabelStyle = LogLabelValue
+	ticks = lr.GetTicks(r, defaults, FloatValueFormatter)
+	assert.Equal("100.00", ticks[3].Label)
+
+	lr.MinorTicks = true
+	ticks = lr.GetTicks(r, defaults, FloatValueFormatter)
+	assert.Len(ticks, 4+8*3)
+	assert.Empty(ticks[4].Label)
+	assert.InDelta(0.2, ticks[4].Value, 0.0000001)
+
+	gridLines := lr.GetGridLines(ticks, false, Style{StrokeWidth: 2}, Style{StrokeWidth: 1})
+	assert.Len(gridLines, 2+8*3)
+	assert.False(gridLines[0].IsMinor)
+	assert.True(gridLines[len(gridLines)-1].IsMinor)
+
+	lr = &LogRange{Min: 1e-10, Max: 1e10, Domain: 100}
+	ticks = lr.GetTicks(r, defaults, FloatValueFormatter)
+	assert.True(len(ticks) < 21, "labels are thinned out to fit the domain")
+	assert.True(len(ticks) > 1)
+}
+
+func TestLogSuperscript(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal("⁰", logSuperscript(0))
+	assert.Equal("¹²", logSuperscript(12))
+	assert.Equal("⁻³", logSuperscript(-3))
+}
@@ -0,0 +1,27 @@
+package chart
+
+// Logger receives the layout decisions of a render (the resolved ranges, the tick counts and the canvas box after
+// each adjustment pass) as a message and alternating keys and values; a `*slog.Logger` satisfies it.
+type Logger interface {
+	Debug(msg string, args ...interface{})
+}
+
+// log logs a layout decision, if the chart has a logger.
+func (c Chart) log(msg string, args ...interface{}) {
+	if c.Logger != nil {
+		c.Logger.Debug(msg, args...)
+	}
+}
+
+// logTicks logs the tick counts of the axes after a layout pass.
+func (c Chart) logTicks(pass string, xt, yt, yta []Tick) {
+	c.log("chart ticks", "pass", pass, "x", len(xt), "y", len(yt), "y_secondary", len(yta))
+}
+
+// logCanvasBox logs the canvas box after a layout pass.
+func (c Chart) logCanvasBox(pass string, canvasBox Box) {
+	if c.Logger == nil {
+		return
+	}
+	c.log("chart canvas box", "pass", pass, "box", canvasBox.String(), "width", canvasBox.Width(), "height", canvasBox.Height())
+}
@@ -0,0 +1,77 @@
+package chart
+
+import (
+	"bytes"
+	"testing"
+
+	assert "github.com/blendlabs/go-assert"
+)
+
+type testLogger struct {
+	messages []string
+	args     [][]interface{}
+}
+
+func (tl *testLogger) Debug(msg string, args ...interface{}) {
+	tl.messages = append(tl.messages, msg)
+	tl.args = append(tl.args, args)
+}
+
+func (tl *testLogger) count(msg string) (count int) {
+	for _, m := range tl.messages {
+		if m == msg {
+			count++
+		}
+	}
+	return
+}
+
+func TestChartLogger(t *testing.T) {
+	assert := assert.New(t)
+
+	logger := &testLogger{}
+	c := Chart{
+		Logger: logger,
+		XAxis:  XAxis{Style: StyleShow()},
+		YAxis:  YAxis{Style: StyleShow()},
+		Series: []Series{
+			ContinuousSeries{XValues: Sequence.Float64(0, 999), YValues: Sequence.Float64(0, 999)},
+		},
+	}
+	assert.Nil(c.Render(PNG, bytes.NewBuffer(nil)))
+
+	assert.Equal(1, logger.count("chart ranges"))
+	assert.Equal(2, logger.count("chart ticks"))
+	assert.Equal(3, logger.count("chart canvas box"))
+	assert.Equal("x", logger.args[0][0])
+
+	for index, message := range logger.messages {
+		assert.Zero(len(logger.args[index])%2, message)
+	}
+
+	logger.messages = nil
+	assert.Nil(c.Preview(100, 20).Render(PNG, bytes.NewBuffer(nil)))
+	assert.Equal(1, logger.count("chart preview downsampled series"))
+
+	// the ranges are only reused once a render has published them.
+	reused := func() interface{} {
+		for index, message := range logger.messages {
+			if message == "chart ranges" {
+				args := logger.args[index]
+				return args[len(args)-1]
+			}
+		}
+		return nil
+	}
+	c.Ranges = &ChartRanges{}
+	logger.messages, logger.args = nil, nil
+	assert.Nil(c.Render(PNG, bytes.NewBuffer(nil)))
+	assert.Equal(false, reused())
+	logger.messages, logger.args = nil, nil
+	assert.Nil(c.Render(PNG, bytes.NewBuffer(nil)))
+	assert.Equal(true, reused())
+
+	// no logger is fine.
+	c.Logger = nil
+	assert.Nil(c.Render(PNG, bytes.NewBuffer(nil)))
+}
@@ -141,6 +141,17 @@ func (macds MACDSignalSeries) GetPeriods() (w1, w2, sig int) {
 	return
 }
 
+// GetInnerSeries implements `DerivedSeries`.
+func (macds MACDSignalSeries) GetInnerSeries() ValueProvider {
+	return macds.InnerSeries
+}
+
+// GetDerivation implements `DerivedSeries`.
+func (macds MACDSignalSeries) GetDerivation() string {
+	w1, w2, sig := macds.GetPeriods()
+	return fmt.Sprintf("MACD signal(%d, %d, %d)", w1, w2, sig)
+}
+
 // GetName returns the name of the time series.
 func (macds MACDSignalSeries) GetName() string {
 	return macds.Name
@@ -244,6 +255,17 @@ func (macdl MACDLineSeries) GetPeriods() (w1, w2 int) {
 	return
 }
 
+// GetInnerSeries implements `DerivedSeries`.
+func (macdl MACDLineSeries) GetInnerSeries() ValueProvider {
+	return macdl.InnerSeries
+}
+
+// GetDerivation implements `DerivedSeries`.
+func (macdl MACDLineSeries) GetDerivation() string {
+	w1, w2 := macdl.GetPeriods()
+	return fmt.Sprintf("MACD(%d, %d)", w1, w2)
+}
+
 // Len returns the number of elements in the series.
 func (macdl *MACDLineSeries) Len() int {
 	if macdl.InnerSeries == nil {
@@ -3,6 +3,8 @@ package chart
 import (
 	"fmt"
 	"math"
+
+	"github.com/wcharczuk/go-chart/seriesutil"
 )
 
 // MinSeries draws a horizontal line at the minimum value of the inner series.
@@ -25,6 +27,16 @@ func (ms MinSeries) GetStyle() Style {
 	return ms.Style
 }
 
+// GetInnerSeries implements `DerivedSeries`.
+func (ms MinSeries) GetInnerSeries() ValueProvider {
+	return ms.InnerSeries
+}
+
+// GetDerivation implements `DerivedSeries`.
+func (ms MinSeries) GetDerivation() string {
+	return "Min"
+}
+
 // GetYAxis returns which YAxis the series draws on.
 func (ms MinSeries) GetYAxis() YAxisType {
 	return ms.YAxis
@@ -51,13 +63,9 @@ func (ms *MinSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, def
 
 func (ms *MinSeries) ensureMinValue() {
 	if ms.minValue == nil {
-		minValue := math.MaxFloat64
-		var y float64
-		for x := 0; x < ms.InnerSeries.Len(); x++ {
-			_, y = ms.InnerSeries.GetValue(x)
-			if y < minValue {
-				minValue = y
-			}
+		minValue := seriesutil.Min(ms.InnerSeries, seriesutil.NaNSkip)
+		if math.IsNaN(minValue) {
+			minValue = math.MaxFloat64
 		}
 		ms.minValue = &minValue
 	}
@@ -91,6 +99,16 @@ func (ms MaxSeries) GetStyle() Style {
 	return ms.Style
 }
 
+// GetInnerSeries implements `DerivedSeries`.
+func (ms MaxSeries) GetInnerSeries() ValueProvider {
+	return ms.InnerSeries
+}
+
+// GetDerivation implements `DerivedSeries`.
+func (ms MaxSeries) GetDerivation() string {
+	return "Max"
+}
+
 // GetYAxis returns which YAxis the series draws on.
 func (ms MaxSeries) GetYAxis() YAxisType {
 	return ms.YAxis
@@ -117,13 +135,9 @@ func (ms *MaxSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, def
 
 func (ms *MaxSeries) ensureMaxValue() {
 	if ms.maxValue == nil {
-		maxValue := -math.MaxFloat64
-		var y float64
-		for x := 0; x < ms.InnerSeries.Len(); x++ {
-			_, y = ms.InnerSeries.GetValue(x)
-			if y > maxValue {
-				maxValue = y
-			}
+		maxValue := seriesutil.Max(ms.InnerSeries, seriesutil.NaNSkip)
+		if math.IsNaN(maxValue) {
+			maxValue = -math.MaxFloat64
 		}
 		ms.maxValue = &maxValue
 	}
@@ -0,0 +1,86 @@
+package chart
+
+import "fmt"
+
+// OffsetSeries is a series that shifts its inner series along the x-axis by a constant offset at render time.
+// It is useful for overlaying periods on the same axis, i.e. "this week" vs. "last week", without mutating the
+// underlying data.
+type OffsetSeries struct {
+	Name  string
+	Style Style
+	YAxis YAxisType
+
+	// XOffset is added to every x value of the inner series.
+	// For time series the offset is in nanoseconds, i.e. `float64(7 * 24 * time.Hour)`.
+	XOffset     float64
+	InnerSeries ValueProvider
+}
+
+// GetName returns the name of the time series.
+func (ofs OffsetSeries) GetName() string {
+	return ofs.Name
+}
+
+// GetStyle returns the line style.
+func (ofs OffsetSeries) GetStyle() Style {
+	return ofs.Style
+}
+
+// GetYAxis returns which YAxis the series draws on.
+func (ofs OffsetSeries) GetYAxis() YAxisType {
+	return ofs.YAxis
+}
+
+// Len returns the number of elements in the series.
+func (ofs OffsetSeries) Len() int {
+	if ofs.InnerSeries == nil {
+		return 0
+	}
+	return ofs.InnerSeries.Len()
+}
+
+// GetValue gets a value at a given index.
+func (ofs OffsetSeries) GetValue(index int) (x, y float64) {
+	if ofs.InnerSeries == nil {
+		return
+	}
+	x, y = ofs.InnerSeries.GetValue(index)
+	x = x + ofs.XOffset
+	return
+}
+
+// GetLastValue returns the last value of the inner series, shifted by the offset.
+func (ofs OffsetSeries) GetLastValue() (x, y float64) {
+	if ofs.InnerSeries == nil {
+		return
+	}
+	if lvp, isLvp := ofs.InnerSeries.(LastValueProvider); isLvp {
+		x, y = lvp.GetLastValue()
+	} else {
+		x, y = ofs.InnerSeries.GetValue(ofs.InnerSeries.Len() - 1)
+	}
+	x = x + ofs.XOffset
+	return
+}
+
+// GetValueFormatters returns the value formatters of the inner series, if it provides them.
+func (ofs OffsetSeries) GetValueFormatters() (x, y ValueFormatter) {
+	if vfp, isVfp := ofs.InnerSeries.(ValueFormatterProvider); isVfp {
+		return vfp.GetValueFormatters()
+	}
+	return FloatValueFormatter, FloatValueFormatter
+}
+
+// Render renders the series.
+func (ofs OffsetSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
+	style := ofs.Style.InheritFrom(defaults)
+	Draw.LineSeries(r, canvasBox, xrange, yrange, style, ofs)
+}
+
+// Validate validates the series.
+func (ofs OffsetSeries) Validate() error {
+	if ofs.InnerSeries == nil {
+		return fmt.Errorf("offset series requires InnerSeries to be set")
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package chart
+
+import (
+	"testing"
+	"time"
+
+	"github.com/blendlabs/go-assert"
+)
+
+func TestOffsetSeriesGetValue(t *testing.T) {
+	assert := assert.New(t)
+
+	inner := ContinuousSeries{
+		XValues: []float64{1.0, 2.0, 3.0},
+		YValues: []float64{4.0, 5.0, 6.0},
+	}
+	ofs := OffsetSeries{
+		InnerSeries: inner,
+		XOffset:     10.0,
+	}
+
+	assert.Equal(3, ofs.Len())
+	x, y := ofs.GetValue(1)
+	assert.Equal(12.0, x)
+	assert.Equal(5.0, y)
+
+	x, y = ofs.GetLastValue()
+	assert.Equal(13.0, x)
+	assert.Equal(6.0, y)
+
+	// the underlying data is not modified.
+	assert.Equal(2.0, inner.XValues[1])
+}
+
+func TestOffsetSeriesTimeSeries(t *testing.T) {
+	assert := assert.New(t)
+
+	start := time.Date(2017, 01, 02, 0, 0, 0, 0, time.UTC)
+	lastWeek := TimeSeries{
+		XValues: []time.Time{start, start.AddDate(0, 0, 1)},
+		YValues: []float64{1.0, 2.0},
+	}
+	ofs := OffsetSeries{
+		InnerSeries: lastWeek,
+		XOffset:     float64(7 * 24 * time.Hour),
+	}
+
+	x, _ := ofs.GetValue(0)
+	assert.Equal(start.AddDate(0, 0, 7), Time.FromFloat64(x).UTC())
+
+	xf, _ := ofs.GetValueFormatters()
+	assert.NotNil(xf)
+	assert.Equal(TimeValueFormatter(x), xf(x))
+}
+
+func TestOffsetSeriesValidate(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.NotNil(OffsetSeries{}.Validate())
+	assert.Zero(OffsetSeries{}.Len())
+	assert.Nil(OffsetSeries{InnerSeries: ContinuousSeries{}}.Validate())
+}
@@ -0,0 +1,112 @@
+package chart
+
+import "reflect"
+
+// OverlayAxis is how the series of an overlay are mapped onto the y-axes of the chart they are applied to.
+type OverlayAxis int
+
+const (
+	// OverlayAxisKeep keeps the y-axis each series is mapped to.
+	OverlayAxisKeep OverlayAxis = 0
+	// OverlayAxisPrimary maps every series to the primary y-axis.
+	OverlayAxisPrimary OverlayAxis = 1
+	// OverlayAxisSecondary maps every series to the secondary y-axis, i.e. for a layer in different units.
+	OverlayAxisSecondary OverlayAxis = 2
+	// OverlayAxisSwap maps series on the primary y-axis to the secondary y-axis and vice versa.
+	OverlayAxisSwap OverlayAxis = 3
+)
+
+// Overlay is a reusable chart fragment, i.e. an slo band and an events layer, that is defined once
+// and applied to many base charts. The series are drawn in the coordinate system of the chart the
+// overlay is applied to.
+type Overlay struct {
+	Series   []Series
+	Elements []Renderable
+
+	// YAxis is how the overlay series are mapped onto the y-axes of the base chart. Remapping sets the
+	// `YAxis` field of each series; series without one are left on the axis they report.
+	YAxis OverlayAxis
+	// Below draws the overlay series beneath the series of the base chart, i.e. for bands.
+	Below bool
+}
+
+// OverlayOf returns an overlay of a chart's series and elements.
+func OverlayOf(layer Chart, axis OverlayAxis) Overlay {
+	return Overlay{
+		Series:   layer.Series,
+		Elements: layer.Elements,
+		YAxis:    axis,
+	}
+}
+
+// Apply returns a copy of the chart with the overlay series and elements merged in. It has the same
+// signature as a `Preset`, so overlays can be chained with presets, i.e. `Preset(slo.Apply).Then(...)`.
+func (o Overlay) Apply(c Chart) Chart {
+	series := make([]Series, 0, len(c.Series)+len(o.Series))
+	if !o.Below {
+		series = append(series, c.Series...)
+	}
+	for _, s := range o.Series {
+		series = append(series, o.remap(s))
+	}
+	if o.Below {
+		series = append(series, c.Series...)
+		c.seriesBelow += len(o.Series)
+	}
+	c.Series = series
+
+	elements := make([]Renderable, 0, len(c.Elements)+len(o.Elements))
+	c.Elements = append(append(elements, c.Elements...), o.Elements...)
+	return c
+}
+
+// remap returns a copy of the series mapped to its new y-axis.
+func (o Overlay) remap(s Series) Series {
+	if o.YAxis == OverlayAxisKeep {
+		return s
+	}
+
+	axis := YAxisPrimary
+	switch o.YAxis {
+	case OverlayAxisSecondary:
+		axis = YAxisSecondary
+	case OverlayAxisSwap:
+		if s.GetYAxis() == YAxisPrimary {
+			axis = YAxisSecondary
+		}
+	}
+	if s.GetYAxis() == axis {
+		return s
+	}
+
+	// copy the series (or the value it points to) so the overlay itself is left as is.
+	value := reflect.ValueOf(s)
+	isPtr := value.Kind() == reflect.Ptr
+	if isPtr {
+		if value.IsNil() {
+			return s
+		}
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Struct {
+		return s
+	}
+	field := value.FieldByName("YAxis")
+	if !field.IsValid() || field.Type() != reflect.TypeOf(axis) {
+		return s
+	}
+
+	copied := reflect.New(value.Type())
+	copied.Elem().Set(value)
+	copied.Elem().FieldByName("YAxis").Set(reflect.ValueOf(axis))
+	if isPtr {
+		if remapped, isSeries := copied.Interface().(Series); isSeries {
+			return remapped
+		}
+		return s
+	}
+	if remapped, isSeries := copied.Elem().Interface().(Series); isSeries {
+		return remapped
+	}
+	return s
+}
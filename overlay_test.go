@@ -0,0 +1,89 @@
+package chart
+
+import (
+	"bytes"
+	"testing"
+
+	assert "github.com/blendlabs/go-assert"
+)
+
+func TestOverlayApply(t *testing.T) {
+	assert := assert.New(t)
+
+	base := Chart{
+		Series: []Series{
+			ContinuousSeries{Name: "base", XValues: []float64{1, 2, 3}, YValues: []float64{1, 2, 3}},
+		},
+	}
+	var drawn []string
+	slo := Overlay{
+		Series: []Series{
+			ContinuousSeries{Name: "slo", XValues: []float64{1, 3}, YValues: []float64{99, 99}},
+			&MinSeries{Name: "min", InnerSeries: base.Series[0].(ContinuousSeries)},
+			AnnotationSeries{Name: "events", Annotations: []Value2{{XValue: 2, YValue: 2, Label: "deploy"}}},
+		},
+		Elements: []Renderable{
+			func(r Renderer, cb Box, defaults Style) { drawn = append(drawn, "slo") },
+		},
+		YAxis: OverlayAxisSecondary,
+	}
+
+	c := slo.Apply(base)
+	assert.Len(c.Series, 4)
+	assert.Len(base.Series, 1, "the base chart is left as is")
+	assert.Equal("base", c.Series[0].GetName())
+	assert.Equal(YAxisPrimary, c.Series[0].GetYAxis())
+	for _, s := range c.Series[1:] {
+		assert.Equal(YAxisSecondary, s.GetYAxis(), s.GetName())
+	}
+	_, isAnnotationSeries := c.Series[3].(AnnotationSeries)
+	assert.True(isAnnotationSeries, "remapped series keep their type")
+	assert.Equal(YAxisPrimary, slo.Series[1].GetYAxis(), "the overlay is left as is")
+
+	assert.Nil(c.Render(PNG, bytes.NewBuffer(nil)))
+	assert.Equal([]string{"slo"}, drawn)
+
+	slo.Below = true
+	slo.YAxis = OverlayAxisSwap
+	c = slo.Apply(c)
+	assert.Len(c.Series, 7)
+	assert.Equal("slo", c.Series[0].GetName())
+	assert.Equal(YAxisSecondary, c.Series[0].GetYAxis())
+	assert.Equal("base", c.Series[3].GetName())
+	assert.Len(c.Elements, 2)
+}
+
+func TestOverlayBelowKeepsColors(t *testing.T) {
+	assert := assert.New(t)
+
+	base := Chart{
+		Series: []Series{
+			ContinuousSeries{Name: "first", XValues: []float64{1, 2}, YValues: []float64{1, 2}},
+			ContinuousSeries{Name: "second", XValues: []float64{1, 2}, YValues: []float64{2, 1}},
+		},
+	}
+	band := Overlay{
+		Series: []Series{ContinuousSeries{Name: "band", XValues: []float64{1, 2}, YValues: []float64{0, 3}}},
+		Below:  true,
+	}
+
+	for _, cycle := range []ColorCycle{{}, {SkipUsed: true}} {
+		base.ColorCycle = cycle
+		c := band.Apply(base)
+		assert.Equal("band", c.Series[0].GetName())
+		assert.Equal(base.styleDefaultsSeries(0).StrokeColor, c.styleDefaultsSeries(1).StrokeColor)
+		assert.Equal(base.styleDefaultsSeries(1).StrokeColor, c.styleDefaultsSeries(2).StrokeColor)
+		assert.Equal(base.ColorCycle.GetColor(2), c.styleDefaultsSeries(0).StrokeColor, "the overlay takes the next color")
+	}
+}
+
+func TestOverlayOf(t *testing.T) {
+	assert := assert.New(t)
+
+	layer := Chart{
+		Series: []Series{ContinuousSeries{Name: "layer", YAxis: YAxisSecondary}},
+	}
+	c := Preset(OverlayOf(layer, OverlayAxisPrimary).Apply).Then(PresetMinimal()).Apply(Chart{})
+	assert.Len(c.Series, 1)
+	assert.Equal(YAxisPrimary, c.Series[0].GetYAxis())
+}
@@ -0,0 +1,101 @@
+package chart
+
+import (
+	"math"
+
+	"github.com/wcharczuk/go-chart/drawing"
+)
+
+var (
+	// PaletteOkabeIto is the Okabe-Ito palette, designed to be distinguishable with the common forms of color blindness.
+	// Use it as a chart's `ColorCycle.Colors`.
+	PaletteOkabeIto = []drawing.Color{
+		drawing.ColorFromHex("E69F00"),
+		drawing.ColorFromHex("56B4E9"),
+		drawing.ColorFromHex("009E73"),
+		drawing.ColorFromHex("F0E442"),
+		drawing.ColorFromHex("0072B2"),
+		drawing.ColorFromHex("D55E00"),
+		drawing.ColorFromHex("CC79A7"),
+		drawing.ColorFromHex("000000"),
+	}
+
+	// PaletteSet2 is the ColorBrewer qualitative Set2 palette; the first three colors are color-blind safe.
+	PaletteSet2 = []drawing.Color{
+		drawing.ColorFromHex("66C2A5"),
+		drawing.ColorFromHex("FC8D62"),
+		drawing.ColorFromHex("8DA0CB"),
+		drawing.ColorFromHex("E78AC3"),
+		drawing.ColorFromHex("A6D854"),
+		drawing.ColorFromHex("FFD92F"),
+		drawing.ColorFromHex("E5C494"),
+		drawing.ColorFromHex("B3B3B3"),
+	}
+
+	// PaletteDark2 is the ColorBrewer qualitative Dark2 palette; the first three colors are color-blind safe.
+	PaletteDark2 = []drawing.Color{
+		drawing.ColorFromHex("1B9E77"),
+		drawing.ColorFromHex("D95F02"),
+		drawing.ColorFromHex("7570B3"),
+		drawing.ColorFromHex("E7298A"),
+		drawing.ColorFromHex("66A61E"),
+		drawing.ColorFromHex("E6AB02"),
+		drawing.ColorFromHex("A6761D"),
+		drawing.ColorFromHex("666666"),
+	}
+
+	// PalettePaired is the ColorBrewer qualitative Paired palette, light and dark pairs of each hue;
+	// the first four colors are color-blind safe.
+	PalettePaired = []drawing.Color{
+		drawing.ColorFromHex("A6CEE3"),
+		drawing.ColorFromHex("1F78B4"),
+		drawing.ColorFromHex("B2DF8A"),
+		drawing.ColorFromHex("33A02C"),
+		drawing.ColorFromHex("FB9A99"),
+		drawing.ColorFromHex("E31A1C"),
+		drawing.ColorFromHex("FDBF6F"),
+		drawing.ColorFromHex("FF7F00"),
+		drawing.ColorFromHex("CAB2D6"),
+		drawing.ColorFromHex("6A3D9A"),
+		drawing.ColorFromHex("FFFF99"),
+		drawing.ColorFromHex("B15928"),
+	}
+)
+
+const (
+	// DefaultPaletteMinChroma is the least HCL chroma the colors generated by `GeneratePalette` have, so palettes
+	// generated from grayish brand colors are still distinguishable.
+	DefaultPaletteMinChroma = 40.0
+	// DefaultPaletteLightnessStep is how far the lightness of every other generated color is moved
+	// when a palette has more colors than can be told apart by hue alone.
+	DefaultPaletteLightnessStep = 15.0
+	// DefaultPaletteHueOnlyCount is the most colors a palette is generated with by hue alone.
+	DefaultPaletteHueOnlyCount = 6
+)
+
+// GeneratePalette returns a number of visually distinct colors that start with (and share the lightness and chroma
+// of) a base color, spacing their hues evenly around the HCL hue circle. Larger palettes also alternate lightness.
+// Use it as a chart's `ColorCycle.Colors`.
+func GeneratePalette(base drawing.Color, count int) []drawing.Color {
+	if count <= 0 {
+		return nil
+	}
+	h, c, l := base.HCL()
+	c = math.Max(c, DefaultPaletteMinChroma)
+	lightnessStep := DefaultPaletteLightnessStep
+	if l > 50 {
+		lightnessStep = -lightnessStep
+	}
+
+	colors := make([]drawing.Color, count)
+	colors[0] = base
+	for index := 1; index < count; index++ {
+		hue := math.Mod(h+float64(index)*360.0/float64(count), 360.0)
+		lightness := l
+		if count > DefaultPaletteHueOnlyCount && index%2 == 1 {
+			lightness += lightnessStep
+		}
+		colors[index] = drawing.ColorFromHCL(hue, c, lightness, base.A)
+	}
+	return colors
+}
@@ -0,0 +1,38 @@
+package chart
+
+import (
+	"testing"
+
+	assert "github.com/blendlabs/go-assert"
+	"github.com/wcharczuk/go-chart/drawing"
+)
+
+func TestGeneratePalette(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Empty(GeneratePalette(ColorBlue, 0))
+
+	colors := GeneratePalette(ColorBlue, 5)
+	assert.Len(colors, 5)
+	assert.Equal(ColorBlue, colors[0])
+
+	_, _, baseLightness := ColorBlue.HCL()
+	for index, c := range colors {
+		_, _, l := c.HCL()
+		assert.InDelta(baseLightness, l, 1.0)
+		for _, other := range colors[index+1:] {
+			assert.NotEqual(c, other)
+		}
+	}
+
+	gray := GeneratePalette(drawing.ColorFromHex("808080"), 3)
+	for _, c := range gray[1:] {
+		_, chroma, _ := c.HCL()
+		assert.True(chroma > 20, "generated colors are not gray")
+	}
+
+	large := GeneratePalette(ColorBlue, 8)
+	_, _, l0 := large[0].HCL()
+	_, _, l1 := large[1].HCL()
+	assert.True(l1-l0 > 10, "every other color of a large palette is lightened")
+}
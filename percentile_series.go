@@ -0,0 +1,264 @@
+package chart
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+const (
+	// DefaultPercentileBandAlpha is the fill alpha of the band closest to the median; bands further out are lighter.
+	DefaultPercentileBandAlpha uint8 = 80
+)
+
+// PercentileValues is a `PercentileValueProvider` with one slice of y values per percentile.
+type PercentileValues struct {
+	// Percentiles are the percentiles (0-100) in ascending order, i.e. 50, 90 and 99.
+	Percentiles []float64
+	XValues     []float64
+	// YValues has a slice of y values per percentile, each as long as the x values.
+	YValues [][]float64
+}
+
+// Len implements `PercentileValueProvider`.
+func (pv PercentileValues) Len() int {
+	return len(pv.XValues)
+}
+
+// GetPercentiles implements `PercentileValueProvider`.
+func (pv PercentileValues) GetPercentiles() []float64 {
+	return pv.Percentiles
+}
+
+// GetPercentileValues implements `PercentileValueProvider`; percentiles missing a value at the index are NaN.
+func (pv PercentileValues) GetPercentileValues(index int) (x float64, ys []float64) {
+	ys = make([]float64, len(pv.Percentiles))
+	for p := range ys {
+		ys[p] = math.NaN()
+		if p < len(pv.YValues) && index < len(pv.YValues[p]) {
+			ys[p] = pv.YValues[p][index]
+		}
+	}
+	return pv.XValues[index], ys
+}
+
+// Validate validates the values; there must be a slice of y values per percentile, each as long as the x values.
+func (pv PercentileValues) Validate() error {
+	if len(pv.YValues) != len(pv.Percentiles) {
+		return fmt.Errorf("percentile values requires a slice of y values per percentile")
+	}
+	for p, values := range pv.YValues {
+		if len(values) != len(pv.XValues) {
+			return fmt.Errorf("percentile values p%v y values must be the same length as the x values", pv.Percentiles[p])
+		}
+	}
+	return nil
+}
+
+// PercentileLine is a percentile drawn as a line; its style is inherited from the series style.
+type PercentileLine struct {
+	Percentile float64
+	Style      Style
+}
+
+// PercentileBand is the area between two percentiles drawn as a shaded band.
+// Unless its style sets a fill color, the band is filled with the series stroke color, lighter the further the band is
+// from the median.
+type PercentileBand struct {
+	Low, High float64
+	Style     Style
+}
+
+// PercentileSeries draws a line per percentile of a `PercentileValueProvider` and shades the bands between them.
+type PercentileSeries struct {
+	Name  string
+	Style Style
+	YAxis YAxisType
+
+	InnerSeries PercentileValueProvider
+	// Lines are the percentiles drawn as lines, by default every percentile of the inner series.
+	Lines []PercentileLine
+	// Bands are the shaded bands, by default one between each pair of consecutive percentiles.
+	Bands []PercentileBand
+}
+
+// GetName returns the name of the series.
+func (ps PercentileSeries) GetName() string {
+	return ps.Name
+}
+
+// GetStyle returns the series style.
+func (ps PercentileSeries) GetStyle() Style {
+	return ps.Style
+}
+
+// GetYAxis returns which YAxis the series draws on.
+func (ps PercentileSeries) GetYAxis() YAxisType {
+	return ps.YAxis
+}
+
+// Len implements `BoundedValueProvider`.
+func (ps PercentileSeries) Len() int {
+	return ps.InnerSeries.Len()
+}
+
+// GetBoundedValue implements `BoundedValueProvider` as the highest and lowest percentile at an index, so the ranges
+// include every percentile.
+func (ps PercentileSeries) GetBoundedValue(index int) (x, y1, y2 float64) {
+	x, ys := ps.InnerSeries.GetPercentileValues(index)
+	y1, y2 = math.NaN(), math.NaN()
+	for _, y := range ys {
+		if math.IsNaN(y) {
+			continue
+		}
+		if math.IsNaN(y1) || y > y1 {
+			y1 = y
+		}
+		if math.IsNaN(y2) || y < y2 {
+			y2 = y
+		}
+	}
+	return
+}
+
+// GetLines returns the percentile lines, or a line per percentile of the inner series if none are set.
+func (ps PercentileSeries) GetLines() []PercentileLine {
+	if ps.Lines != nil {
+		return ps.Lines
+	}
+	var lines []PercentileLine
+	for _, p := range ps.InnerSeries.GetPercentiles() {
+		lines = append(lines, PercentileLine{Percentile: p})
+	}
+	return lines
+}
+
+// GetBands returns the percentile bands, or a band between each pair of consecutive percentiles if none are set.
+func (ps PercentileSeries) GetBands() []PercentileBand {
+	if ps.Bands != nil {
+		return ps.Bands
+	}
+	var bands []PercentileBand
+	percentiles := ps.InnerSeries.GetPercentiles()
+	for index := 1; index < len(percentiles); index++ {
+		bands = append(bands, PercentileBand{Low: percentiles[index-1], High: percentiles[index]})
+	}
+	return bands
+}
+
+// Render implements `Series`.
+func (ps PercentileSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
+	if ps.InnerSeries.Len() == 0 {
+		return
+	}
+	style := ps.Style.InheritFrom(defaults)
+
+	bands := ps.GetBands()
+	alphas := ps.getBandAlphas(bands)
+	for index, band := range bands {
+		low, high := ps.getPercentileIndex(band.Low), ps.getPercentileIndex(band.High)
+		if low < 0 || high < 0 {
+			continue
+		}
+		bandStyle := band.Style.InheritFrom(Style{
+			StrokeColor: ColorTransparent,
+			FillColor:   style.GetStrokeColor().WithAlpha(alphas[index]),
+		})
+		Draw.BoundedSeries(r, canvasBox, xrange, yrange, bandStyle, percentileBand{inner: ps.InnerSeries, high: high, low: low})
+	}
+
+	lineStyle := style
+	lineStyle.FillColor = ColorTransparent
+	for _, line := range ps.GetLines() {
+		p := ps.getPercentileIndex(line.Percentile)
+		if p < 0 {
+			continue
+		}
+		Draw.LineSeries(r, canvasBox, xrange, yrange, line.Style.InheritFrom(lineStyle), percentileLine{inner: ps.InnerSeries, percentile: p})
+	}
+}
+
+// getPercentileIndex returns the index of a percentile in the inner series, or -1 if it does not have it.
+func (ps PercentileSeries) getPercentileIndex(percentile float64) int {
+	for index, p := range ps.InnerSeries.GetPercentiles() {
+		if p == percentile {
+			return index
+		}
+	}
+	return -1
+}
+
+// getBandAlphas returns the default fill alpha of each band, fading out from the band closest to the median.
+func (ps PercentileSeries) getBandAlphas(bands []PercentileBand) []uint8 {
+	order := make([]int, len(bands))
+	for index := range order {
+		order[index] = index
+	}
+	distance := func(b PercentileBand) float64 {
+		return math.Abs((b.Low+b.High)/2 - 50)
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return distance(bands[order[i]]) < distance(bands[order[j]])
+	})
+	alphas := make([]uint8, len(bands))
+	for rank, index := range order {
+		alphas[index] = uint8(float64(DefaultPercentileBandAlpha) * float64(len(bands)-rank) / float64(len(bands)))
+	}
+	return alphas
+}
+
+// Validate validates the series.
+func (ps PercentileSeries) Validate() error {
+	if ps.InnerSeries == nil {
+		return fmt.Errorf("percentile series requires InnerSeries to be set")
+	}
+	if len(ps.InnerSeries.GetPercentiles()) == 0 {
+		return fmt.Errorf("percentile series requires the inner series to have percentiles")
+	}
+	if pv, isPercentileValues := ps.InnerSeries.(PercentileValues); isPercentileValues {
+		if err := pv.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, line := range ps.GetLines() {
+		if ps.getPercentileIndex(line.Percentile) < 0 {
+			return fmt.Errorf("percentile series inner series does not have the p%v line", line.Percentile)
+		}
+	}
+	for _, band := range ps.GetBands() {
+		if ps.getPercentileIndex(band.Low) < 0 || ps.getPercentileIndex(band.High) < 0 {
+			return fmt.Errorf("percentile series inner series does not have the p%v-p%v band", band.Low, band.High)
+		}
+	}
+	return nil
+}
+
+// percentileLine is a single percentile of a `PercentileValueProvider` as a `ValueProvider`.
+type percentileLine struct {
+	inner      PercentileValueProvider
+	percentile int
+}
+
+func (pl percentileLine) Len() int {
+	return pl.inner.Len()
+}
+
+func (pl percentileLine) GetValue(index int) (x, y float64) {
+	x, ys := pl.inner.GetPercentileValues(index)
+	return x, ys[pl.percentile]
+}
+
+// percentileBand is two percentiles of a `PercentileValueProvider` as a `BoundedValueProvider`.
+type percentileBand struct {
+	inner     PercentileValueProvider
+	high, low int
+}
+
+func (pb percentileBand) Len() int {
+	return pb.inner.Len()
+}
+
+func (pb percentileBand) GetBoundedValue(index int) (x, y1, y2 float64) {
+	x, ys := pb.inner.GetPercentileValues(index)
+	return x, ys[pb.high], ys[pb.low]
+}
@@ -0,0 +1,90 @@
+package chart
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	assert "github.com/blendlabs/go-assert"
+)
+
+func percentileTestValues() PercentileValues {
+	return PercentileValues{
+		Percentiles: []float64{50, 90, 99},
+		XValues:     []float64{1, 2, 3},
+		YValues: [][]float64{
+			{10, 11, 12},
+			{20, 21, 22},
+			{30, 35, 40},
+		},
+	}
+}
+
+func TestPercentileSeries(t *testing.T) {
+	assert := assert.New(t)
+
+	ps := PercentileSeries{InnerSeries: percentileTestValues()}
+	assert.Nil(ps.Validate())
+	assert.Equal(3, ps.Len())
+
+	x, y1, y2 := ps.GetBoundedValue(1)
+	assert.Equal(2.0, x)
+	assert.Equal(35.0, y1)
+	assert.Equal(11.0, y2)
+
+	assert.Len(ps.GetLines(), 3)
+	bands := ps.GetBands()
+	assert.Len(bands, 2)
+	assert.Equal(PercentileBand{Low: 50, High: 90}, bands[0])
+	assert.Equal(PercentileBand{Low: 90, High: 99}, bands[1])
+
+	alphas := ps.getBandAlphas(bands)
+	assert.Equal(DefaultPercentileBandAlpha, alphas[0])
+	assert.True(alphas[1] < alphas[0])
+
+	ps.Lines = []PercentileLine{{Percentile: 75}}
+	assert.NotNil(ps.Validate())
+}
+
+func TestPercentileValuesRagged(t *testing.T) {
+	assert := assert.New(t)
+
+	pv := percentileTestValues()
+	assert.Nil(pv.Validate())
+
+	pv.YValues[2] = pv.YValues[2][:2]
+	assert.NotNil(pv.Validate())
+	assert.NotNil(PercentileSeries{InnerSeries: pv}.Validate())
+	_, ys := pv.GetPercentileValues(2)
+	assert.Equal(12.0, ys[0])
+	assert.True(math.IsNaN(ys[2]))
+
+	pv.YValues = pv.YValues[:1]
+	assert.NotNil(pv.Validate())
+	_, ys = pv.GetPercentileValues(0)
+	assert.Len(ys, 3)
+	assert.True(math.IsNaN(ys[1]))
+}
+
+func TestPercentileSeriesRender(t *testing.T) {
+	assert := assert.New(t)
+
+	c := Chart{
+		Series: []Series{PercentileSeries{InnerSeries: percentileTestValues()}},
+	}
+	buffer := &DrawTraceWriter{}
+	assert.Nil(c.Render(Recording, buffer))
+
+	var fills, strokes int
+	for _, call := range buffer.Trace().Calls {
+		if call.Op == DrawOpFillStroke && call.Style.FillColor.A > 0 && call.Style.FillColor.A < 255 {
+			fills++
+		}
+		if call.Op == DrawOpStroke {
+			strokes++
+		}
+	}
+	assert.Equal(2, fills)
+	assert.Equal(3, strokes)
+	assert.Nil(c.Render(PNG, bytes.NewBuffer([]byte{})))
+}
@@ -0,0 +1,207 @@
+package chart
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// ComparisonPeriod is the period a series is compared against itself over.
+type ComparisonPeriod int
+
+const (
+	// ComparisonPeriodDay compares against the previous day.
+	ComparisonPeriodDay ComparisonPeriod = 1
+	// ComparisonPeriodWeek compares against the previous week.
+	ComparisonPeriodWeek ComparisonPeriod = 2
+	// ComparisonPeriodMonth compares against the previous calendar month.
+	ComparisonPeriodMonth ComparisonPeriod = 3
+	// ComparisonPeriodYear compares against the previous calendar year.
+	ComparisonPeriodYear ComparisonPeriod = 4
+)
+
+var (
+	// DefaultPreviousPeriodDashArray is the stroke dash array used for previous period series.
+	DefaultPreviousPeriodDashArray = []float64{5.0, 5.0}
+	// DefaultPreviousPeriodAlpha is the alpha a previous period series' stroke color is faded to.
+	DefaultPreviousPeriodAlpha uint8 = 128
+	// DefaultSeasonalMinAlpha is the alpha the oldest trace of a seasonal overlay is faded to.
+	DefaultSeasonalMinAlpha uint8 = 40
+)
+
+// String returns the period name.
+func (cp ComparisonPeriod) String() string {
+	switch cp {
+	case ComparisonPeriodDay:
+		return "day"
+	case ComparisonPeriodWeek:
+		return "week"
+	case ComparisonPeriodMonth:
+		return "month"
+	case ComparisonPeriodYear:
+		return "year"
+	}
+	return "unknown"
+}
+
+// Next returns the time one period after a given time.
+// Months and years are added on the calendar, so they are not a fixed duration; days past the end of the next
+// month (i.e. Jan 31, or Feb 29 a year on) are clamped to its last day.
+func (cp ComparisonPeriod) Next(t time.Time) time.Time {
+	switch cp {
+	case ComparisonPeriodDay:
+		return t.AddDate(0, 0, 1)
+	case ComparisonPeriodWeek:
+		return t.AddDate(0, 0, 7)
+	case ComparisonPeriodMonth:
+		return addMonthsClamped(t, 1)
+	case ComparisonPeriodYear:
+		return addMonthsClamped(t, 12)
+	}
+	return t
+}
+
+// addMonthsClamped adds calendar months to a time, clamping the day to the last day of the resulting month.
+func addMonthsClamped(t time.Time, months int) time.Time {
+	year, month, day := t.Date()
+	hour, min, sec := t.Clock()
+	first := time.Date(year, month+time.Month(months), 1, hour, min, sec, t.Nanosecond(), t.Location())
+	lastDay := first.AddDate(0, 1, -1).Day()
+	if day > lastDay {
+		day = lastDay
+	}
+	return time.Date(first.Year(), first.Month(), day, hour, min, sec, t.Nanosecond(), t.Location())
+}
+
+// Start returns the start of the period a given time falls in, in the time's location.
+// Weeks start on Sunday.
+func (cp ComparisonPeriod) Start(t time.Time) time.Time {
+	year, month, day := t.Date()
+	switch cp {
+	case ComparisonPeriodDay:
+		return time.Date(year, month, day, 0, 0, 0, 0, t.Location())
+	case ComparisonPeriodWeek:
+		return time.Date(year, month, day-int(t.Weekday()), 0, 0, 0, 0, t.Location())
+	case ComparisonPeriodMonth:
+		return time.Date(year, month, 1, 0, 0, 0, 0, t.Location())
+	case ComparisonPeriodYear:
+		return time.Date(year, time.January, 1, 0, 0, 0, 0, t.Location())
+	}
+	return t
+}
+
+// PreviousPeriodSeries returns the "previous period" of a time series aligned for overlay; every value is shifted
+// forward by one period, and only the values that then fall within the original series' time extent are kept.
+// The series is not modified.
+// Unless a style is given, the previous period is styled as a dashed (and, if the series sets a stroke color, faded)
+// version of the series style.
+func PreviousPeriodSeries(ts TimeSeries, period ComparisonPeriod, userStyle ...Style) TimeSeries {
+	output := TimeSeries{
+		Name:  fmt.Sprintf("%s (previous %s)", ts.Name, period),
+		YAxis: ts.YAxis,
+	}
+	if len(userStyle) > 0 {
+		output.Style = userStyle[0]
+	} else {
+		output.Style = previousPeriodStyle(ts.Style)
+	}
+
+	count := Math.MinInt(len(ts.XValues), len(ts.YValues))
+	if count == 0 {
+		return output
+	}
+
+	start, end := ts.XValues[0], ts.XValues[0]
+	for index := 0; index < count; index++ {
+		if ts.XValues[index].Before(start) {
+			start = ts.XValues[index]
+		}
+		if ts.XValues[index].After(end) {
+			end = ts.XValues[index]
+		}
+	}
+
+	for index := 0; index < count; index++ {
+		shifted := period.Next(ts.XValues[index])
+		if shifted.Before(start) || shifted.After(end) {
+			continue
+		}
+		output.XValues = append(output.XValues, shifted)
+		output.YValues = append(output.YValues, ts.YValues[index])
+	}
+	return output
+}
+
+func previousPeriodStyle(seriesStyle Style) Style {
+	style := seriesStyle
+	style.Show = true
+	style.StrokeDashArray = DefaultPreviousPeriodDashArray
+	if !style.StrokeColor.IsZero() {
+		style.StrokeColor = style.StrokeColor.WithAlpha(DefaultPreviousPeriodAlpha)
+	}
+	return style
+}
+
+// SeasonalSeries splits a time series into one trace per period (i.e. one line per week) overlaid on the most recent
+// period, for inspecting seasonal patterns; each value keeps its offset from the start of its period (clamped to the
+// end of the most recent period, for days past the end of a shorter month).
+// Traces are returned oldest first and faded by age, from the style's stroke color on the most recent period down
+// to `DefaultSeasonalMinAlpha` on the oldest. Unless a style is given, the series style is used.
+func SeasonalSeries(ts TimeSeries, period ComparisonPeriod, userStyle ...Style) []TimeSeries {
+	style := ts.Style
+	if len(userStyle) > 0 {
+		style = userStyle[0]
+	}
+	style.Show = true
+	if style.StrokeColor.IsZero() {
+		style.StrokeColor = GetDefaultColor(0)
+	}
+
+	count := Math.MinInt(len(ts.XValues), len(ts.YValues))
+	// traces are keyed by instant, as equal times in other locations (or with monotonic readings) are not ==.
+	traces := map[int64]*TimeSeries{}
+	var starts []time.Time
+	for index := 0; index < count; index++ {
+		start := period.Start(ts.XValues[index])
+		trace, hasTrace := traces[start.UnixNano()]
+		if !hasTrace {
+			trace = &TimeSeries{
+				Name:  fmt.Sprintf("%s (%s of %s)", ts.Name, period, start.Format("2006-01-02")),
+				YAxis: ts.YAxis,
+			}
+			traces[start.UnixNano()] = trace
+			starts = append(starts, start)
+		}
+		trace.XValues = append(trace.XValues, ts.XValues[index])
+		trace.YValues = append(trace.YValues, ts.YValues[index])
+	}
+	if len(starts) == 0 {
+		return nil
+	}
+	sort.Slice(starts, func(i, j int) bool { return starts[i].Before(starts[j]) })
+
+	latest := starts[len(starts)-1]
+	// the last instant of the latest period; offsets past it (i.e. Jan 31 on a February) are clamped to it.
+	end := period.Next(latest).Add(-time.Nanosecond)
+	maxAlpha := style.StrokeColor.A
+	output := make([]TimeSeries, len(starts))
+	for index, start := range starts {
+		trace := *traces[start.UnixNano()]
+		for valueIndex, x := range trace.XValues {
+			if aligned := latest.Add(x.Sub(start)); aligned.After(end) {
+				trace.XValues[valueIndex] = end
+			} else {
+				trace.XValues[valueIndex] = aligned
+			}
+		}
+
+		trace.Style = style
+		if len(starts) > 1 && maxAlpha > DefaultSeasonalMinAlpha {
+			age := float64(len(starts)-1-index) / float64(len(starts)-1)
+			alpha := float64(maxAlpha) - age*float64(maxAlpha-DefaultSeasonalMinAlpha)
+			trace.Style.StrokeColor = style.StrokeColor.WithAlpha(uint8(alpha))
+		}
+		output[index] = trace
+	}
+	return output
+}
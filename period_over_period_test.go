@@ -0,0 +1,151 @@
+package chart
+
+import (
+	"testing"
+	"time"
+
+	"github.com/blendlabs/go-assert"
+	"github.com/wcharczuk/go-chart/drawing"
+)
+
+func TestComparisonPeriodNext(t *testing.T) {
+	assert := assert.New(t)
+
+	start := time.Date(2017, 01, 31, 12, 0, 0, 0, time.UTC)
+	assert.Equal(time.Date(2017, 02, 01, 12, 0, 0, 0, time.UTC), ComparisonPeriodDay.Next(start))
+	assert.Equal(time.Date(2017, 02, 07, 12, 0, 0, 0, time.UTC), ComparisonPeriodWeek.Next(start))
+	assert.Equal(time.Date(2017, 02, 28, 12, 0, 0, 0, time.UTC), ComparisonPeriodMonth.Next(start))
+	assert.Equal(time.Date(2018, 01, 31, 12, 0, 0, 0, time.UTC), ComparisonPeriodYear.Next(start))
+	assert.Equal(time.Date(2017, 03, 15, 12, 0, 0, 0, time.UTC), ComparisonPeriodMonth.Next(time.Date(2017, 02, 15, 12, 0, 0, 0, time.UTC)))
+	assert.Equal(time.Date(2018, 01, 31, 12, 0, 0, 0, time.UTC), ComparisonPeriodMonth.Next(time.Date(2017, 12, 31, 12, 0, 0, 0, time.UTC)))
+	assert.Equal(time.Date(2017, 02, 28, 12, 0, 0, 0, time.UTC), ComparisonPeriodYear.Next(time.Date(2016, 02, 29, 12, 0, 0, 0, time.UTC)))
+	assert.Equal("week", ComparisonPeriodWeek.String())
+}
+
+func TestPreviousPeriodSeries(t *testing.T) {
+	assert := assert.New(t)
+
+	start := time.Date(2017, 01, 01, 0, 0, 0, 0, time.UTC)
+	var xvalues []time.Time
+	var yvalues []float64
+	for day := 0; day < 14; day++ {
+		xvalues = append(xvalues, start.AddDate(0, 0, day))
+		yvalues = append(yvalues, float64(day))
+	}
+
+	ts := TimeSeries{
+		Name:    "Visits",
+		Style:   Style{Show: true, StrokeColor: drawing.ColorBlue},
+		XValues: xvalues,
+		YValues: yvalues,
+	}
+
+	previous := PreviousPeriodSeries(ts, ComparisonPeriodWeek)
+	assert.Equal("Visits (previous week)", previous.Name)
+	assert.Len(previous.XValues, 7)
+	assert.Len(previous.YValues, 7)
+	assert.Equal(start.AddDate(0, 0, 7), previous.XValues[0])
+	assert.Equal(0.0, previous.YValues[0])
+	assert.Equal(start.AddDate(0, 0, 13), previous.XValues[6])
+	assert.Equal(6.0, previous.YValues[6])
+
+	assert.True(previous.Style.Show)
+	assert.Equal(DefaultPreviousPeriodDashArray, previous.Style.StrokeDashArray)
+	assert.Equal(DefaultPreviousPeriodAlpha, previous.Style.StrokeColor.A)
+
+	// the original series is not modified.
+	assert.Equal(start, ts.XValues[0])
+	assert.Empty(ts.Style.StrokeDashArray)
+
+	styled := PreviousPeriodSeries(ts, ComparisonPeriodDay, Style{Show: true, StrokeColor: drawing.ColorRed})
+	assert.Len(styled.XValues, 13)
+	assert.Equal(drawing.ColorRed, styled.Style.StrokeColor)
+}
+
+func TestComparisonPeriodStart(t *testing.T) {
+	assert := assert.New(t)
+
+	// 2017-01-04 is a Wednesday.
+	ts := time.Date(2017, 01, 04, 13, 30, 0, 0, time.UTC)
+	assert.Equal(time.Date(2017, 01, 04, 0, 0, 0, 0, time.UTC), ComparisonPeriodDay.Start(ts))
+	assert.Equal(time.Date(2017, 01, 01, 0, 0, 0, 0, time.UTC), ComparisonPeriodWeek.Start(ts))
+	assert.Equal(time.Date(2017, 01, 01, 0, 0, 0, 0, time.UTC), ComparisonPeriodMonth.Start(ts))
+	assert.Equal(time.Date(2017, 01, 01, 0, 0, 0, 0, time.UTC), ComparisonPeriodYear.Start(ts.AddDate(0, 5, 0)))
+}
+
+func TestSeasonalSeries(t *testing.T) {
+	assert := assert.New(t)
+
+	// three weeks of hourly values, starting on a sunday.
+	start := time.Date(2017, 01, 01, 0, 0, 0, 0, time.UTC)
+	var xvalues []time.Time
+	var yvalues []float64
+	for hour := 0; hour < 21*24; hour++ {
+		xvalues = append(xvalues, start.Add(time.Duration(hour)*time.Hour))
+		yvalues = append(yvalues, float64(hour%24))
+	}
+	ts := TimeSeries{
+		Name:    "Load",
+		Style:   Style{Show: true, StrokeColor: drawing.ColorBlue},
+		XValues: xvalues,
+		YValues: yvalues,
+	}
+
+	traces := SeasonalSeries(ts, ComparisonPeriodWeek)
+	assert.Len(traces, 3)
+	assert.Equal("Load (week of 2017-01-01)", traces[0].Name)
+
+	latest := start.AddDate(0, 0, 14)
+	for _, trace := range traces {
+		assert.Len(trace.XValues, 7*24)
+		assert.Equal(latest, trace.XValues[0])
+		assert.Equal(latest.Add(167*time.Hour), trace.XValues[167])
+		assert.Equal(23.0, trace.YValues[23])
+	}
+
+	assert.Equal(DefaultSeasonalMinAlpha, traces[0].Style.StrokeColor.A)
+	assert.True(traces[1].Style.StrokeColor.A > traces[0].Style.StrokeColor.A)
+	assert.Equal(drawing.ColorBlue.A, traces[2].Style.StrokeColor.A)
+
+	// the original series is not modified.
+	assert.Equal(start, ts.XValues[0])
+	assert.Empty(SeasonalSeries(TimeSeries{}, ComparisonPeriodDay))
+}
+
+func TestSeasonalSeriesLocations(t *testing.T) {
+	assert := assert.New(t)
+
+	// the same instants, one read in utc and one in a fixed zone at the same offset.
+	start := time.Date(2017, 01, 01, 0, 0, 0, 0, time.UTC)
+	zone := time.FixedZone("UTC", 0)
+	ts := TimeSeries{
+		XValues: []time.Time{start, start.Add(time.Hour).In(zone), start.Add(2 * time.Hour).Round(0)},
+		YValues: []float64{1, 2, 3},
+	}
+	traces := SeasonalSeries(ts, ComparisonPeriodDay)
+	assert.Len(traces, 1)
+	assert.Len(traces[0].XValues, 3)
+}
+
+func TestSeasonalSeriesMonthClamp(t *testing.T) {
+	assert := assert.New(t)
+
+	ts := TimeSeries{
+		XValues: []time.Time{
+			time.Date(2017, 01, 15, 0, 0, 0, 0, time.UTC),
+			time.Date(2017, 01, 30, 12, 0, 0, 0, time.UTC),
+			time.Date(2017, 01, 31, 12, 0, 0, 0, time.UTC),
+			time.Date(2017, 02, 01, 0, 0, 0, 0, time.UTC),
+		},
+		YValues: []float64{1, 2, 3, 4},
+	}
+	traces := SeasonalSeries(ts, ComparisonPeriodMonth)
+	assert.Len(traces, 2)
+
+	end := time.Date(2017, 03, 01, 0, 0, 0, 0, time.UTC).Add(-time.Nanosecond)
+	assert.Equal(time.Date(2017, 02, 15, 0, 0, 0, 0, time.UTC), traces[0].XValues[0])
+	for _, x := range traces[0].XValues {
+		assert.False(x.After(end), "january days stay within february")
+	}
+	assert.Equal(end, traces[0].XValues[2])
+}
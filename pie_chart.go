@@ -13,6 +13,10 @@ type PieChart struct {
 	Title      string
 	TitleStyle Style
 
+	// Description is a caption (or alt text) for the chart; it is embedded in the output
+	// where the format supports it, i.e. as the svg `<desc>` or a png text chunk.
+	Description string
+
 	Width  int
 	Height int
 	DPI    float64
@@ -73,6 +77,9 @@ func (pc PieChart) Render(rp RendererProvider, w io.Writer) error {
 	if err != nil {
 		return err
 	}
+	if dr, isDescriptionRenderer := r.(DescriptionRenderer); isDescriptionRenderer && len(pc.Description) > 0 {
+		dr.SetDescription(pc.Description)
+	}
 
 	if pc.Font == nil {
 		defaultFont, err := GetDefaultFont()
@@ -0,0 +1,58 @@
+package chart
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+)
+
+const (
+	// pngHeaderLength is the length of the png signature and the IHDR chunk, which must come first.
+	pngHeaderLength = 8 + (4 + 4 + 13 + 4)
+)
+
+// pngWithText inserts a text chunk with a given keyword into an encoded png, after the IHDR chunk.
+// Text that can be represented in latin-1 is written as a `tEXt` chunk, otherwise as a utf-8 `iTXt` chunk.
+func pngWithText(encoded []byte, keyword, text string) ([]byte, error) {
+	if len(encoded) < pngHeaderLength || string(encoded[12:16]) != "IHDR" {
+		return nil, errors.New("invalid png; missing IHDR chunk")
+	}
+
+	chunkType := "tEXt"
+	data := bytes.NewBuffer([]byte(keyword))
+	data.WriteByte(0)
+	if latin1, isLatin1 := pngLatin1(text); isLatin1 {
+		data.Write(latin1)
+	} else {
+		chunkType = "iTXt"
+		// no compression, no language tag and no translated keyword.
+		data.Write([]byte{0, 0, 0, 0})
+		data.WriteString(text)
+	}
+
+	chunk := bytes.NewBuffer([]byte{})
+	binary.Write(chunk, binary.BigEndian, uint32(data.Len()))
+	chunk.WriteString(chunkType)
+	chunk.Write(data.Bytes())
+	crc := crc32.NewIEEE()
+	crc.Write([]byte(chunkType))
+	crc.Write(data.Bytes())
+	binary.Write(chunk, binary.BigEndian, crc.Sum32())
+
+	output := make([]byte, 0, len(encoded)+chunk.Len())
+	output = append(output, encoded[:pngHeaderLength]...)
+	output = append(output, chunk.Bytes()...)
+	return append(output, encoded[pngHeaderLength:]...), nil
+}
+
+func pngLatin1(text string) ([]byte, bool) {
+	output := make([]byte, 0, len(text))
+	for _, r := range text {
+		if r > 0xFF {
+			return nil, false
+		}
+		output = append(output, byte(r))
+	}
+	return output, true
+}
@@ -0,0 +1,71 @@
+package chart
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"image/png"
+	"testing"
+
+	"github.com/blendlabs/go-assert"
+)
+
+// readPNGChunks returns the chunk data by type of an encoded png, and if all the checksums are valid.
+func readPNGChunks(contents []byte) (chunks map[string][]byte, valid bool) {
+	chunks = map[string][]byte{}
+	valid = true
+	cursor := 8
+	for cursor < len(contents) {
+		length := int(binary.BigEndian.Uint32(contents[cursor:]))
+		chunkType := string(contents[cursor+4 : cursor+8])
+		data := contents[cursor+8 : cursor+8+length]
+		crc := binary.BigEndian.Uint32(contents[cursor+8+length:])
+		valid = valid && crc32.ChecksumIEEE(contents[cursor+4:cursor+8+length]) == crc
+		chunks[chunkType] = data
+		cursor += 12 + length
+	}
+	return
+}
+
+func TestChartDescriptionPNG(t *testing.T) {
+	assert := assert.New(t)
+
+	c := Chart{
+		Description: "Visits per day, January 2017",
+		Series: []Series{
+			ContinuousSeries{
+				XValues: []float64{1.0, 2.0, 3.0},
+				YValues: []float64{1.0, 2.0, 3.0},
+			},
+		},
+	}
+
+	buffer := bytes.NewBuffer([]byte{})
+	assert.Nil(c.Render(PNG, buffer))
+	contents := buffer.Bytes()
+
+	chunks, valid := readPNGChunks(contents)
+	assert.True(valid)
+	assert.Equal("Description\x00Visits per day, January 2017", string(chunks["tEXt"]))
+
+	_, err := png.Decode(bytes.NewReader(contents))
+	assert.Nil(err)
+}
+
+func TestPNGWithTextUTF8(t *testing.T) {
+	assert := assert.New(t)
+
+	r, err := PNG(10, 10)
+	assert.Nil(err)
+	buffer := bytes.NewBuffer([]byte{})
+	assert.Nil(r.Save(buffer))
+
+	contents, err := pngWithText(buffer.Bytes(), "Description", "ביקורים")
+	assert.Nil(err)
+	chunks, valid := readPNGChunks(contents)
+	assert.True(valid)
+	assert.Equal("Description\x00\x00\x00\x00\x00ביקורים", string(chunks["iTXt"]))
+
+	_, err = pngWithText([]byte("not a png"), "Description", "test")
+	assert.NotNil(err)
+}
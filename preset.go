@@ -0,0 +1,90 @@
+package chart
+
+import "github.com/wcharczuk/go-chart/drawing"
+
+// Preset configures a chart for a common archetype in one call, i.e.
+//
+//	c = chart.PresetMonitoring().Apply(c)
+//
+// Presets only fill in settings the chart leaves unset, so explicit styles on the chart win; a style the chart sets
+// keeps its own `Show`, so i.e. `Style{Show: false, StrokeWidth: 1}` hides an axis a preset would show.
+// Presets that add a legend set the chart's `Legend`, which is drawn for the chart as it is rendered.
+type Preset func(c Chart) Chart
+
+// Apply applies the preset to a chart.
+func (p Preset) Apply(c Chart) Chart {
+	return p(c)
+}
+
+// Then returns a preset that applies this preset and then another.
+func (p Preset) Then(next Preset) Preset {
+	return func(c Chart) Chart {
+		return next(p(c))
+	}
+}
+
+// PresetMonitoring is for operational dashboards: compact margins, faint grid lines on both axes,
+// axes on and a thin legend along the top.
+func PresetMonitoring() Preset {
+	grid := Style{Show: true, StrokeColor: drawing.ColorFromHex("e5e5e5"), StrokeWidth: 1.0}
+	axis := Style{Show: true, FontSize: 8.0, FontColor: drawing.ColorFromHex("666666")}
+	return func(c Chart) Chart {
+		c.Background = presetStyle(c.Background, Style{Padding: Box{Top: 30, Left: 10, Right: 10, Bottom: 10}})
+		c.TitleStyle = presetStyle(c.TitleStyle, Style{FontSize: 10.0})
+		c.XAxis.Style = presetStyle(c.XAxis.Style, axis)
+		c.XAxis.GridMajorStyle = presetStyle(c.XAxis.GridMajorStyle, grid)
+		c.YAxis.Style = presetStyle(c.YAxis.Style, axis)
+		c.YAxis.GridMajorStyle = presetStyle(c.YAxis.GridMajorStyle, grid)
+		if c.Legend == nil {
+			c.Legend = LegendThin
+		}
+		return c
+	}
+}
+
+// PresetPublication is for print and papers: generous margins, larger black text, no grid lines
+// and a boxed legend.
+func PresetPublication() Preset {
+	axis := Style{Show: true, FontSize: 11.0, FontColor: ColorBlack, StrokeColor: ColorBlack, StrokeWidth: 1.0}
+	return func(c Chart) Chart {
+		c.Background = presetStyle(c.Background, Style{Padding: Box{Top: 30, Left: 30, Right: 30, Bottom: 20}})
+		c.TitleStyle = presetStyle(c.TitleStyle, Style{Show: true, FontSize: 16.0, FontColor: ColorBlack})
+		c.XAxis.Style = presetStyle(c.XAxis.Style, axis)
+		c.XAxis.NameStyle = presetStyle(c.XAxis.NameStyle, Style{Show: true, FontSize: 12.0})
+		c.YAxis.Style = presetStyle(c.YAxis.Style, axis)
+		c.YAxis.NameStyle = presetStyle(c.YAxis.NameStyle, Style{Show: true, FontSize: 12.0})
+		if c.Legend == nil {
+			c.Legend = Legend
+		}
+		return c
+	}
+}
+
+// PresetMinimal is for sparklines and embedded charts: a small margin, hidden axes and grid lines, and no legend.
+// Axes the chart shows are drawn small and light; the preset clears the chart's legend.
+func PresetMinimal() Preset {
+	grid := Style{Show: false, StrokeColor: drawing.ColorFromHex("eeeeee"), StrokeWidth: 1.0}
+	axis := Style{Show: false, FontSize: 7.0, FontColor: drawing.ColorFromHex("999999"), StrokeColor: drawing.ColorFromHex("cccccc"), StrokeWidth: 1.0}
+	return func(c Chart) Chart {
+		c.Background = presetStyle(c.Background, Style{Padding: Box{Top: 5, Left: 5, Right: 5, Bottom: 5}})
+		c.XAxis.Style = presetStyle(c.XAxis.Style, axis)
+		c.XAxis.GridMajorStyle = presetStyle(c.XAxis.GridMajorStyle, grid)
+		c.XAxis.GridMinorStyle = presetStyle(c.XAxis.GridMinorStyle, grid)
+		c.YAxis.Style = presetStyle(c.YAxis.Style, axis)
+		c.YAxis.GridMajorStyle = presetStyle(c.YAxis.GridMajorStyle, grid)
+		c.YAxis.GridMinorStyle = presetStyle(c.YAxis.GridMinorStyle, grid)
+		c.Legend = nil
+		return c
+	}
+}
+
+// presetStyle fills in the unset values of a style from a preset; an unset style takes the preset's `Show`.
+func presetStyle(s, preset Style) Style {
+	final := s.InheritFrom(preset)
+	if s.IsZero() {
+		final.Show = s.Show || preset.Show
+	} else {
+		final.Show = s.Show
+	}
+	return final
+}
@@ -0,0 +1,110 @@
+package chart
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/blendlabs/go-assert"
+	"github.com/wcharczuk/go-chart/drawing"
+)
+
+func TestPresetMonitoring(t *testing.T) {
+	assert := assert.New(t)
+
+	c := PresetMonitoring().Apply(Chart{
+		YAxis: YAxis{Style: Style{FontSize: 12.0}},
+		Series: []Series{
+			ContinuousSeries{Name: "requests", XValues: []float64{1, 2, 3}, YValues: []float64{1, 2, 3}},
+		},
+	})
+	assert.True(c.XAxis.Style.Show)
+	assert.True(c.YAxis.GridMajorStyle.Show)
+	assert.Equal(12.0, c.YAxis.Style.FontSize, "explicit styles should win")
+	assert.Equal(8.0, c.XAxis.Style.FontSize)
+	assert.NotNil(c.Legend)
+	assert.Empty(c.Elements)
+
+	buffer := bytes.NewBuffer([]byte{})
+	assert.Nil(c.Render(PNG, buffer))
+	assert.NotZero(buffer.Len())
+}
+
+func TestPresetStyleShow(t *testing.T) {
+	assert := assert.New(t)
+
+	c := PresetPublication().Apply(Chart{
+		XAxis: XAxis{Style: Style{Show: false, StrokeWidth: 1}},
+	})
+	assert.False(c.XAxis.Style.Show, "a style the chart sets keeps its own show")
+	assert.True(c.YAxis.Style.Show)
+}
+
+func TestPresetLegend(t *testing.T) {
+	assert := assert.New(t)
+
+	// the legend is resolved as the chart renders, so it sees series set after the preset, and chained presets
+	// draw one legend.
+	c := PresetMonitoring().Then(PresetPublication()).Apply(Chart{})
+	c.Series = []Series{
+		ContinuousSeries{Name: "late series", XValues: []float64{1, 2, 3}, YValues: []float64{1, 2, 3}},
+	}
+
+	buffer := bytes.NewBuffer([]byte{})
+	assert.Nil(c.Render(SVG, buffer))
+	assert.Equal(1, strings.Count(buffer.String(), ">late series<"))
+}
+
+func TestPresetThen(t *testing.T) {
+	assert := assert.New(t)
+
+	red := drawing.ColorRed
+	c := PresetMinimal().Then(func(c Chart) Chart {
+		c.Canvas.FillColor = red
+		return c
+	}).Apply(Chart{})
+	assert.False(c.XAxis.Style.Show)
+	assert.Empty(c.Elements)
+	assert.Equal(5, c.Background.Padding.Top)
+	assert.Equal(red, c.Canvas.FillColor)
+}
+
+func TestPresetMinimal(t *testing.T) {
+	assert := assert.New(t)
+
+	c := PresetMonitoring().Then(PresetMinimal()).Apply(Chart{})
+	assert.Nil(c.Legend, "the minimal preset has no legend")
+
+	c = PresetMinimal().Apply(Chart{
+		YAxis: YAxis{Style: Style{Show: true}},
+		Series: []Series{
+			ContinuousSeries{Name: "a", XValues: []float64{1, 2, 3}, YValues: []float64{1, 2, 3}},
+		},
+	})
+	assert.False(c.XAxis.Style.Show)
+	assert.False(c.XAxis.GridMajorStyle.Show)
+	assert.False(c.YAxis.GridMajorStyle.Show)
+	assert.True(c.YAxis.Style.Show, "a style the chart sets keeps its own show")
+	assert.Equal(drawing.ColorFromHex("999999"), c.YAxis.Style.FontColor, "shown axes are drawn light")
+
+	buffer := bytes.NewBuffer([]byte{})
+	assert.Nil(c.Render(SVG, buffer))
+	assert.NotZero(buffer.Len())
+}
+
+func TestPresetPublication(t *testing.T) {
+	assert := assert.New(t)
+
+	c := PresetPublication().Apply(Chart{
+		Title: "Publication",
+		Series: []Series{
+			ContinuousSeries{Name: "a", XValues: []float64{1, 2, 3}, YValues: []float64{1, 2, 3}},
+		},
+	})
+	assert.True(c.TitleStyle.Show)
+	assert.False(c.XAxis.GridMajorStyle.Show)
+
+	buffer := bytes.NewBuffer([]byte{})
+	assert.Nil(c.Render(SVG, buffer))
+	assert.NotZero(buffer.Len())
+}
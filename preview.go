@@ -0,0 +1,137 @@
+package chart
+
+import (
+	"io"
+	"math"
+	"time"
+)
+
+const (
+	// DefaultPreviewWidth is the default width of a chart preview.
+	DefaultPreviewWidth = 160
+	// DefaultPreviewHeight is the default height of a chart preview.
+	DefaultPreviewHeight = 60
+	// DefaultPreviewPadding is the padding around a preview's canvas.
+	DefaultPreviewPadding = 2
+)
+
+// Preview returns a thumbnail version of the chart, styled like a sparkline: no title, axes, grid lines,
+// annotations or elements, and series with more points than the preview's canvas is wide are downsampled.
+// A width or height of zero uses the preview defaults.
+func (c Chart) Preview(width, height int) Chart {
+	if width <= 0 {
+		width = DefaultPreviewWidth
+	}
+	if height <= 0 {
+		height = DefaultPreviewHeight
+	}
+
+	preview := Chart{
+		Width:      width,
+		Height:     height,
+		DPI:        c.DPI,
+		Font:       c.Font,
+		Background: Style{Padding: Box{Top: DefaultPreviewPadding, Left: DefaultPreviewPadding, Right: DefaultPreviewPadding, Bottom: DefaultPreviewPadding}}.InheritFrom(c.Background),
+		Canvas:     c.Canvas,
+		XAxis:      XAxis{Range: c.XAxis.Range, Ticks: c.XAxis.Ticks},
+		YAxis:      YAxis{Range: c.YAxis.Range, Ticks: c.YAxis.Ticks, Ascending: c.YAxis.Ascending},
+		YAxisSecondary: YAxis{
+			Range:     c.YAxisSecondary.Range,
+			Ticks:     c.YAxisSecondary.Ticks,
+			Ascending: c.YAxisSecondary.Ascending,
+		},
+		ColorCycle:  c.ColorCycle,
+		IsRTL:       c.IsRTL,
+		Description: c.Description,
+		Logger:      c.Logger,
+		Ranges:      c.Ranges,
+	}
+	// one bucket per pixel of the canvas, i.e. inside the padding.
+	buckets := preview.Box().Width()
+	for _, s := range c.Series {
+		if _, isAnnotationSeries := s.(AnnotationSeries); isAnnotationSeries {
+			// keep the series index so the default colors match the full chart.
+			s = AnnotationSeries{Style: Style{Show: false, StrokeWidth: 1}}
+		}
+		downsampled := downsampleSeries(s, buckets)
+		if vp, isValueProvider := s.(ValueProvider); isValueProvider {
+			if dvp, isDownsampled := downsampled.(ValueProvider); isDownsampled && dvp.Len() != vp.Len() {
+				preview.log("chart preview downsampled series", "series", s.GetName(), "from", vp.Len(), "to", dvp.Len())
+			}
+		}
+		preview.Series = append(preview.Series, downsampled)
+	}
+	return preview
+}
+
+// RenderWithPreview renders the chart to `w` and a preview of it to `preview`, computing the ranges once so
+// the preview matches the full size chart. A preview width or height of zero uses the preview defaults.
+func (c Chart) RenderWithPreview(rp RendererProvider, w io.Writer, previewWidth, previewHeight int, preview io.Writer) error {
+	if c.Ranges == nil {
+		c.Ranges = &ChartRanges{}
+	}
+	if err := c.Render(rp, w); err != nil {
+		return err
+	}
+	return c.Preview(previewWidth, previewHeight).Render(rp, preview)
+}
+
+// downsampleSeries reduces continuous and time series with more than two points per bucket to the minimum and
+// maximum of each bucket, which keeps spikes visible. Other series are returned as is.
+func downsampleSeries(s Series, buckets int) Series {
+	switch typed := s.(type) {
+	case ContinuousSeries:
+		if indexes := downsampleIndexes(typed.YValues, buckets); indexes != nil {
+			xvalues, yvalues := make([]float64, len(indexes)), make([]float64, len(indexes))
+			for index, source := range indexes {
+				xvalues[index], yvalues[index] = typed.XValues[source], typed.YValues[source]
+			}
+			typed.XValues, typed.YValues = xvalues, yvalues
+		}
+		return typed
+	case TimeSeries:
+		if indexes := downsampleIndexes(typed.YValues, buckets); indexes != nil {
+			xvalues, yvalues := make([]time.Time, len(indexes)), make([]float64, len(indexes))
+			for index, source := range indexes {
+				xvalues[index], yvalues[index] = typed.XValues[source], typed.YValues[source]
+			}
+			typed.XValues, typed.YValues = xvalues, yvalues
+		}
+		return typed
+	}
+	return s
+}
+
+// downsampleIndexes returns the indexes of the minimum and maximum of each bucket, in order, or nil if the
+// values don't need downsampling. NaN values are skipped; a bucket of only NaN values is left out.
+func downsampleIndexes(values []float64, buckets int) []int {
+	if buckets <= 0 || len(values) <= buckets<<1 {
+		return nil
+	}
+	indexes := make([]int, 0, buckets<<1)
+	for bucket := 0; bucket < buckets; bucket++ {
+		start, end := bucket*len(values)/buckets, (bucket+1)*len(values)/buckets
+		minIndex, maxIndex := -1, -1
+		for index := start; index < end; index++ {
+			if math.IsNaN(values[index]) {
+				continue
+			}
+			if minIndex < 0 || values[index] < values[minIndex] {
+				minIndex = index
+			}
+			if maxIndex < 0 || values[index] > values[maxIndex] {
+				maxIndex = index
+			}
+		}
+		if minIndex < 0 {
+			continue
+		} else if minIndex == maxIndex {
+			indexes = append(indexes, minIndex)
+		} else if minIndex < maxIndex {
+			indexes = append(indexes, minIndex, maxIndex)
+		} else {
+			indexes = append(indexes, maxIndex, minIndex)
+		}
+	}
+	return indexes
+}
@@ -0,0 +1,93 @@
+package chart
+
+import (
+	"bytes"
+	"image/png"
+	"math"
+	"testing"
+
+	"github.com/blendlabs/go-assert"
+)
+
+func TestChartPreview(t *testing.T) {
+	assert := assert.New(t)
+
+	c := Chart{
+		Title:      "Full",
+		TitleStyle: StyleShow(),
+		XAxis:      XAxis{Style: StyleShow()},
+		YAxis:      YAxis{Style: StyleShow()},
+		Series: []Series{
+			ContinuousSeries{XValues: Sequence.Float64(0, 999), YValues: Sequence.Random(1000, 10)},
+			AnnotationSeries{Annotations: []Value2{{XValue: 1, YValue: 1, Label: "a"}}},
+			TimeSeries{XValues: Sequence.Days(9), YValues: Sequence.Random(10, 10)},
+		},
+	}
+	c.Elements = []Renderable{Legend(&c)}
+
+	preview := c.Preview(0, 0)
+	assert.Equal(DefaultPreviewWidth, preview.Width)
+	assert.Equal(DefaultPreviewHeight, preview.Height)
+	assert.False(preview.TitleStyle.Show)
+	assert.False(preview.XAxis.Style.Show)
+	assert.Empty(preview.Elements)
+	assert.Len(preview.Series, 3)
+	assert.False(preview.hasAnnotationSeries())
+
+	downsampled := preview.Series[0].(ContinuousSeries)
+	assert.True(len(downsampled.XValues) <= 2*(DefaultPreviewWidth-2*DefaultPreviewPadding))
+	assert.Len(preview.Series[2].(TimeSeries).XValues, 10)
+}
+
+func TestChartRenderWithPreview(t *testing.T) {
+	assert := assert.New(t)
+
+	c := Chart{
+		Width:  400,
+		Height: 200,
+		YAxis:  YAxis{Style: StyleShow()},
+		Series: []Series{
+			ContinuousSeries{XValues: []float64{1, 2, 3, 4}, YValues: []float64{1, 4, 2, 3}},
+		},
+	}
+
+	full, small := bytes.NewBuffer([]byte{}), bytes.NewBuffer([]byte{})
+	assert.Nil(c.RenderWithPreview(PNG, full, 100, 40, small))
+
+	fullImage, err := png.Decode(full)
+	assert.Nil(err)
+	assert.Equal(400, fullImage.Bounds().Dx())
+	smallImage, err := png.Decode(small)
+	assert.Nil(err)
+	assert.Equal(100, smallImage.Bounds().Dx())
+	assert.Equal(40, smallImage.Bounds().Dy())
+
+}
+
+func TestDownsampleIndexes(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Nil(downsampleIndexes([]float64{1, 2, 3}, 2))
+
+	values := []float64{0, 5, 1, 1, -3, 1, 2, 2}
+	indexes := downsampleIndexes(values, 2)
+	assert.Equal([]int{0, 1, 4, 6}, indexes)
+
+	ts := TimeSeries{XValues: Sequence.Days(7), YValues: values}
+	downsampled := downsampleSeries(ts, 2).(TimeSeries)
+	assert.Len(downsampled.XValues, 4)
+	assert.Equal(-3.0, downsampled.YValues[2])
+	assert.True(downsampled.XValues[2].Before(downsampled.XValues[3]))
+}
+
+func TestDownsampleIndexesNaN(t *testing.T) {
+	assert := assert.New(t)
+
+	nan := math.NaN()
+	values := []float64{nan, 2, 5, nan, nan, nan, nan, nan, 1, nan, 3, 2}
+	indexes := downsampleIndexes(values, 3)
+	assert.Equal([]int{1, 2, 8, 10}, indexes)
+	for _, index := range indexes {
+		assert.False(math.IsNaN(values[index]))
+	}
+}
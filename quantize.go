@@ -0,0 +1,158 @@
+package chart
+
+import (
+	"image"
+	"image/color"
+	imagedraw "image/draw"
+	"sort"
+)
+
+// quantize reduces an image to a paletted image of at most the given number of colors.
+// Images that already have few enough colors keep their exact colors, otherwise the palette
+// is chosen by median cut.
+func quantize(src *image.RGBA, maxColors int, dither bool) *image.Paletted {
+	palette := quantizePalette(src, maxColors)
+	dst := image.NewPaletted(src.Bounds(), palette)
+	if dither {
+		imagedraw.FloydSteinberg.Draw(dst, dst.Bounds(), src, src.Bounds().Min)
+	} else {
+		imagedraw.Draw(dst, dst.Bounds(), src, src.Bounds().Min, imagedraw.Src)
+	}
+	return dst
+}
+
+// quantizeBucket is a distinct color and how many pixels use it.
+type quantizeBucket struct {
+	c     color.RGBA
+	count int
+}
+
+func quantizePalette(src *image.RGBA, maxColors int) color.Palette {
+	counts := map[color.RGBA]int{}
+	bounds := src.Bounds()
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			counts[src.RGBAAt(x, y)]++
+		}
+	}
+
+	buckets := make([]quantizeBucket, 0, len(counts))
+	for c, count := range counts {
+		buckets = append(buckets, quantizeBucket{c: c, count: count})
+	}
+	// sort so the palette doesn't depend on map iteration order.
+	sort.Slice(buckets, func(i, j int) bool {
+		return quantizeKey(buckets[i].c) < quantizeKey(buckets[j].c)
+	})
+
+	if len(buckets) <= maxColors {
+		palette := make(color.Palette, len(buckets))
+		for index, b := range buckets {
+			palette[index] = b.c
+		}
+		return palette
+	}
+
+	boxes := [][]quantizeBucket{buckets}
+	for len(boxes) < maxColors {
+		// split the box with the widest channel range.
+		widest, channel, width := -1, 0, 0
+		for index, box := range boxes {
+			if len(box) < 2 {
+				continue
+			}
+			if c, w := quantizeWidestChannel(box); w > width {
+				widest, channel, width = index, c, w
+			}
+		}
+		if widest < 0 {
+			break
+		}
+
+		box := boxes[widest]
+		sort.SliceStable(box, func(i, j int) bool {
+			return quantizeChannel(box[i].c, channel) < quantizeChannel(box[j].c, channel)
+		})
+		split := quantizeMedian(box)
+		boxes[widest] = box[:split]
+		boxes = append(boxes, box[split:])
+	}
+
+	palette := make(color.Palette, len(boxes))
+	for index, box := range boxes {
+		palette[index] = quantizeAverage(box)
+	}
+	return palette
+}
+
+// quantizeMedian returns the index that splits a sorted box into two halves by pixel count.
+func quantizeMedian(box []quantizeBucket) int {
+	var total int
+	for _, b := range box {
+		total += b.count
+	}
+	var running int
+	for index, b := range box {
+		running += b.count
+		if running*2 >= total {
+			// both halves must have at least one color.
+			if index+1 >= len(box) {
+				return len(box) - 1
+			}
+			return index + 1
+		}
+	}
+	return len(box) >> 1
+}
+
+func quantizeWidestChannel(box []quantizeBucket) (channel, width int) {
+	for c := 0; c < 4; c++ {
+		min, max := 255, 0
+		for _, b := range box {
+			v := int(quantizeChannel(b.c, c))
+			if v < min {
+				min = v
+			}
+			if v > max {
+				max = v
+			}
+		}
+		if max-min > width {
+			channel, width = c, max-min
+		}
+	}
+	return
+}
+
+func quantizeAverage(box []quantizeBucket) color.RGBA {
+	var r, g, b, a, total int
+	for _, bucket := range box {
+		r += int(bucket.c.R) * bucket.count
+		g += int(bucket.c.G) * bucket.count
+		b += int(bucket.c.B) * bucket.count
+		a += int(bucket.c.A) * bucket.count
+		total += bucket.count
+	}
+	return color.RGBA{
+		R: uint8(r / total),
+		G: uint8(g / total),
+		B: uint8(b / total),
+		A: uint8(a / total),
+	}
+}
+
+func quantizeChannel(c color.RGBA, channel int) uint8 {
+	switch channel {
+	case 0:
+		return c.R
+	case 1:
+		return c.G
+	case 2:
+		return c.B
+	}
+	return c.A
+}
+
+func quantizeKey(c color.RGBA) uint32 {
+	return uint32(c.R)<<24 | uint32(c.G)<<16 | uint32(c.B)<<8 | uint32(c.A)
+}
@@ -0,0 +1,78 @@
+package chart
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"testing"
+
+	"github.com/blendlabs/go-assert"
+)
+
+func TestQuantizeExactColors(t *testing.T) {
+	assert := assert.New(t)
+
+	src := image.NewRGBA(image.Rect(0, 0, 4, 4))
+	red := color.RGBA{R: 255, A: 255}
+	white := color.RGBA{R: 255, G: 255, B: 255, A: 255}
+	for y := 0; y < 4; y++ {
+		for x := 0; x < 4; x++ {
+			if x < 2 {
+				src.SetRGBA(x, y, red)
+			} else {
+				src.SetRGBA(x, y, white)
+			}
+		}
+	}
+
+	q := quantize(src, 16, false)
+	assert.Len(q.Palette, 2)
+	assert.Equal(red, q.At(0, 0))
+	assert.Equal(white, q.At(3, 3))
+}
+
+func TestQuantizeMaxColors(t *testing.T) {
+	assert := assert.New(t)
+
+	src := image.NewRGBA(image.Rect(0, 0, 64, 4))
+	for y := 0; y < 4; y++ {
+		for x := 0; x < 64; x++ {
+			src.SetRGBA(x, y, color.RGBA{R: uint8(x * 4), G: uint8(y * 64), A: 255})
+		}
+	}
+
+	q := quantize(src, 8, false)
+	assert.Len(q.Palette, 8)
+
+	dithered := quantize(src, 8, true)
+	assert.Len(dithered.Palette, 8)
+}
+
+func TestPalettedPNG(t *testing.T) {
+	assert := assert.New(t)
+
+	c := Chart{
+		Width:  256,
+		Height: 128,
+		Series: []Series{
+			ContinuousSeries{
+				XValues: []float64{1, 2, 3, 4},
+				YValues: []float64{1, 3, 2, 4},
+			},
+		},
+	}
+
+	full := bytes.NewBuffer([]byte{})
+	assert.Nil(c.Render(PNG, full))
+
+	paletted := bytes.NewBuffer([]byte{})
+	assert.Nil(c.Render(PalettedPNG(16, true), paletted))
+	assert.True(paletted.Len() < full.Len())
+
+	decoded, err := png.Decode(paletted)
+	assert.Nil(err)
+	typed, isTyped := decoded.(*image.Paletted)
+	assert.True(isTyped)
+	assert.True(len(typed.Palette) <= 16)
+}
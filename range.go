@@ -1,5 +1,10 @@
 package chart
 
+import (
+	"math"
+	"sync"
+)
+
 // NameProvider is a type that returns a name.
 type NameProvider interface {
 	GetName() string
@@ -41,3 +46,80 @@ type Range interface {
 	// Translate the range to the domain.
 	Translate(value float64) int
 }
+
+// RangeFunc picks the range of an axis from the min and max of the data mapped to it.
+type RangeFunc func(dataMin, dataMax float64) (min, max float64)
+
+// RangeAtLeast returns a range func that extends the data range to include the given range, i.e. at least 0-100.
+func RangeAtLeast(min, max float64) RangeFunc {
+	return func(dataMin, dataMax float64) (float64, float64) {
+		return math.Min(min, dataMin), math.Max(max, dataMax)
+	}
+}
+
+// RangePadded returns a range func that pads the max of the data range by a share of its delta, i.e. 0.1 for 10%.
+func RangePadded(share float64) RangeFunc {
+	return func(dataMin, dataMax float64) (float64, float64) {
+		return dataMin, dataMax + (dataMax-dataMin)*share
+	}
+}
+
+// RangePowerOfTwo is a range func that rounds the max of the data range up to the next power of 2.
+func RangePowerOfTwo(dataMin, dataMax float64) (float64, float64) {
+	if dataMax <= 0 {
+		return dataMin, dataMax
+	}
+	return dataMin, math.Pow(2, math.Ceil(math.Log2(dataMax)))
+}
+
+// ChartRanges are the resolved ranges of a chart.
+// They can be captured from one render and passed to subsequent renders of the same data
+// (i.e. at a different size or with a different renderer) to skip scanning the series.
+type ChartRanges struct {
+	X          Range
+	Y          Range
+	YSecondary Range
+}
+
+// chartRangesLock guards resolving shared `ChartRanges`, so a chart can be rendered concurrently.
+var chartRangesLock sync.Mutex
+
+// IsZero returns if the ranges have been resolved or not.
+func (cr *ChartRanges) IsZero() bool {
+	if cr == nil {
+		return true
+	}
+	chartRangesLock.Lock()
+	defer chartRangesLock.Unlock()
+	return cr.isZero()
+}
+
+func (cr *ChartRanges) isZero() bool {
+	return cr.X == nil || cr.Y == nil || cr.YSecondary == nil
+}
+
+// get returns copies of the ranges if they have been resolved, so a render can set their domains without
+// changing the ranges of other renders.
+func (cr *ChartRanges) get() (x, y, ySecondary Range, ok bool) {
+	if cr == nil {
+		return
+	}
+	chartRangesLock.Lock()
+	defer chartRangesLock.Unlock()
+	if cr.isZero() {
+		return
+	}
+	return cloneRange(cr.X), cloneRange(cr.Y), cloneRange(cr.YSecondary), true
+}
+
+// publish sets copies of a render's resolved ranges, unless another render resolved them first.
+func (cr *ChartRanges) publish(x, y, ySecondary Range) {
+	if cr == nil {
+		return
+	}
+	chartRangesLock.Lock()
+	defer chartRangesLock.Unlock()
+	if cr.isZero() {
+		cr.X, cr.Y, cr.YSecondary = cloneRange(x), cloneRange(y), cloneRange(ySecondary)
+	}
+}
@@ -1,6 +1,7 @@
 package chart
 
 import (
+	"bytes"
 	"image"
 	"image/png"
 	"io"
@@ -23,12 +24,38 @@ func PNG(width, height int) (Renderer, error) {
 	return nil, err
 }
 
+// PalettedPNG returns a png/raster renderer provider that quantizes the output to an indexed color png
+// of at most the given number of colors, optionally with Floyd-Steinberg dithering.
+// Charts with few colors compress to a fraction of the size of a full color png.
+func PalettedPNG(colors int, dither bool) RendererProvider {
+	return func(width, height int) (Renderer, error) {
+		r, err := PNG(width, height)
+		if err != nil {
+			return nil, err
+		}
+		rr := r.(*rasterRenderer)
+		rr.paletteSize = colors
+		if rr.paletteSize <= 0 || rr.paletteSize > DefaultPaletteSize {
+			rr.paletteSize = DefaultPaletteSize
+		}
+		rr.dither = dither
+		return rr, nil
+	}
+}
+
 // rasterRenderer renders chart commands to a bitmap.
 type rasterRenderer struct {
 	i  *image.RGBA
 	gc *drawing.RasterGraphicContext
 
 	rotateRadians *float64
+	description   string
+
+	paletteSize int
+	dither      bool
+
+	// areas are the image map areas of the legend entries and bars drawn.
+	areas []ImageMapArea
 
 	s Style
 }
@@ -129,6 +156,29 @@ func (rr *rasterRenderer) Circle(radius float64, x, y int) {
 	rr.gc.FillStroke()
 }
 
+// Clip implements `ClipRenderer`.
+func (rr *rasterRenderer) Clip() {
+	rr.gc.Clip()
+}
+
+// ResetClip implements `ClipRenderer`.
+func (rr *rasterRenderer) ResetClip() {
+	rr.gc.ResetClip()
+}
+
+func (rr *rasterRenderer) addArea(area ImageMapArea) {
+	rr.areas = append(rr.areas, area)
+}
+
+func (rr *rasterRenderer) getAreas() []ImageMapArea {
+	return rr.areas
+}
+
+// SetAntiAlias implements `AntiAliasRenderer`.
+func (rr *rasterRenderer) SetAntiAlias(antiAlias bool) {
+	rr.gc.SetAntiAlias(antiAlias)
+}
+
 // SetFont implements the interface method.
 func (rr *rasterRenderer) SetFont(f *truetype.Font) {
 	rr.s.Font = f
@@ -150,7 +200,7 @@ func (rr *rasterRenderer) Text(body string, x, y int) {
 	rr.gc.SetFont(rr.s.Font)
 	rr.gc.SetFontSize(rr.s.FontSize)
 	rr.gc.SetFillColor(rr.s.FontColor)
-	rr.gc.CreateStringPath(body, float64(xf), float64(yf))
+	rr.gc.CreateStringPath(Text.Visual(body), float64(xf), float64(yf))
 	rr.gc.Fill()
 }
 
@@ -159,7 +209,7 @@ func (rr *rasterRenderer) MeasureText(body string) Box {
 	rr.gc.SetFont(rr.s.Font)
 	rr.gc.SetFontSize(rr.s.FontSize)
 	rr.gc.SetFillColor(rr.s.FontColor)
-	l, t, r, b, err := rr.gc.GetStringBounds(body)
+	l, t, r, b, err := rr.gc.GetStringBounds(Text.Visual(body))
 	if err != nil {
 		return Box{}
 	}
@@ -218,11 +268,43 @@ func (rr *rasterRenderer) ClearTextRotation() {
 	rr.rotateRadians = nil
 }
 
+// SetDescription sets the description written to the png as a `Description` text chunk.
+func (rr *rasterRenderer) SetDescription(description string) {
+	rr.description = description
+}
+
+// getSize implements `sizedRenderer`.
+func (rr *rasterRenderer) getSize() (width, height int) {
+	return rr.i.Bounds().Dx(), rr.i.Bounds().Dy()
+}
+
+// GetContentType implements `ContentTypeRenderer`.
+func (rr *rasterRenderer) GetContentType() string {
+	return ContentTypePNG
+}
+
 // Save implements the interface method.
 func (rr *rasterRenderer) Save(w io.Writer) error {
 	if typed, isTyped := w.(RGBACollector); isTyped {
 		typed.SetRGBA(rr.i)
 		return nil
 	}
-	return png.Encode(w, rr.i)
+	var output image.Image = rr.i
+	if rr.paletteSize > 0 {
+		output = quantize(rr.i, rr.paletteSize, rr.dither)
+	}
+	if len(rr.description) == 0 {
+		return png.Encode(w, output)
+	}
+
+	buffer := bytes.NewBuffer([]byte{})
+	if err := png.Encode(buffer, output); err != nil {
+		return err
+	}
+	contents, err := pngWithText(buffer.Bytes(), "Description", rr.description)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(contents)
+	return err
 }
@@ -0,0 +1,290 @@
+package chart
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/golang/freetype/truetype"
+	"github.com/wcharczuk/go-chart/drawing"
+)
+
+// DrawOp is the kind of a recorded draw call.
+type DrawOp string
+
+const (
+	// DrawOpStroke is a stroked path.
+	DrawOpStroke DrawOp = "stroke"
+	// DrawOpFill is a filled path.
+	DrawOpFill DrawOp = "fill"
+	// DrawOpFillStroke is a filled and stroked path.
+	DrawOpFillStroke DrawOp = "fillStroke"
+	// DrawOpCircle is a circle.
+	DrawOpCircle DrawOp = "circle"
+	// DrawOpText is a text blob.
+	DrawOpText DrawOp = "text"
+)
+
+// PathCommand is a single step of a recorded path, using the svg path command letters:
+// `M` (move to), `L` (line to), `Q` (quad curve to, with a control point), `A` (arc) and `Z` (close).
+type PathCommand struct {
+	Command string `json:"cmd"`
+	X       int    `json:"x"`
+	Y       int    `json:"y"`
+	// CX and CY are the control point of a quad curve or the center of an arc.
+	CX         int     `json:"cx,omitempty"`
+	CY         int     `json:"cy,omitempty"`
+	RX         float64 `json:"rx,omitempty"`
+	RY         float64 `json:"ry,omitempty"`
+	StartAngle float64 `json:"startAngle,omitempty"`
+	Delta      float64 `json:"delta,omitempty"`
+}
+
+// DrawCallStyle is the style a draw call was made with; fonts are not recorded, only their size and color.
+type DrawCallStyle struct {
+	StrokeColor     drawing.Color `json:"strokeColor"`
+	StrokeWidth     float64       `json:"strokeWidth"`
+	StrokeDashArray []float64     `json:"strokeDashArray,omitempty"`
+	FillColor       drawing.Color `json:"fillColor"`
+	FontSize        float64       `json:"fontSize,omitempty"`
+	FontColor       drawing.Color `json:"fontColor"`
+	TextRotation    float64       `json:"textRotation,omitempty"`
+}
+
+// DrawCall is a recorded draw call.
+type DrawCall struct {
+	Op    DrawOp        `json:"op"`
+	Path  []PathCommand `json:"path,omitempty"`
+	Text  string        `json:"text,omitempty"`
+	X     int           `json:"x,omitempty"`
+	Y     int           `json:"y,omitempty"`
+	R     float64       `json:"r,omitempty"`
+	Style DrawCallStyle `json:"style"`
+}
+
+// DrawTrace is the draw calls recorded for a chart.
+type DrawTrace struct {
+	Width  int        `json:"width"`
+	Height int        `json:"height"`
+	DPI    float64    `json:"dpi"`
+	Calls  []DrawCall `json:"calls"`
+}
+
+// Texts returns the text of each recorded text call, in draw order.
+func (dt DrawTrace) Texts() []string {
+	var texts []string
+	for _, call := range dt.Calls {
+		if call.Op == DrawOpText {
+			texts = append(texts, call.Text)
+		}
+	}
+	return texts
+}
+
+// DrawTraceCollector is a render target for a recording renderer.
+type DrawTraceCollector interface {
+	SetDrawTrace(trace DrawTrace)
+}
+
+// DrawTraceWriter is a special type of io.Writer that collects the draw trace of a recording renderer.
+type DrawTraceWriter struct {
+	trace DrawTrace
+}
+
+// Write implements io.Writer; recording renderers set the trace directly so writes are discarded.
+func (dtw *DrawTraceWriter) Write(buffer []byte) (int, error) {
+	return len(buffer), nil
+}
+
+// SetDrawTrace implements `DrawTraceCollector`.
+func (dtw *DrawTraceWriter) SetDrawTrace(trace DrawTrace) {
+	dtw.trace = trace
+}
+
+// Trace returns the collected draw trace.
+func (dtw *DrawTraceWriter) Trace() DrawTrace {
+	return dtw.trace
+}
+
+// Recording returns a new renderer that records draw calls instead of drawing them.
+// Text is measured like the png renderer so layouts match png output.
+// Saving writes the trace as json, or hands it to a `DrawTraceCollector` such as `DrawTraceWriter`.
+func Recording(width, height int) (Renderer, error) {
+	measure, err := PNG(width, height)
+	if err != nil {
+		return nil, err
+	}
+	return &recordingRenderer{
+		measure: measure,
+		trace:   DrawTrace{Width: width, Height: height},
+	}, nil
+}
+
+// recordingRenderer records chart commands.
+type recordingRenderer struct {
+	measure Renderer
+	trace   DrawTrace
+	path    []PathCommand
+
+	s             Style
+	rotateRadians *float64
+}
+
+func (rr *recordingRenderer) ResetStyle() {
+	rr.s = Style{Font: rr.s.Font}
+	rr.measure.ResetStyle()
+	rr.ClearTextRotation()
+}
+
+// GetDPI implements the interface method.
+func (rr *recordingRenderer) GetDPI() float64 {
+	return rr.trace.DPI
+}
+
+// SetDPI implements the interface method.
+func (rr *recordingRenderer) SetDPI(dpi float64) {
+	rr.trace.DPI = dpi
+	rr.measure.SetDPI(dpi)
+}
+
+// SetStrokeColor implements the interface method.
+func (rr *recordingRenderer) SetStrokeColor(c drawing.Color) {
+	rr.s.StrokeColor = c
+}
+
+// SetFillColor implements the interface method.
+func (rr *recordingRenderer) SetFillColor(c drawing.Color) {
+	rr.s.FillColor = c
+}
+
+// SetStrokeWidth implements the interface method.
+func (rr *recordingRenderer) SetStrokeWidth(width float64) {
+	rr.s.StrokeWidth = width
+}
+
+// SetStrokeDashArray implements the interface method.
+func (rr *recordingRenderer) SetStrokeDashArray(dashArray []float64) {
+	rr.s.StrokeDashArray = dashArray
+}
+
+// MoveTo implements the interface method.
+func (rr *recordingRenderer) MoveTo(x, y int) {
+	rr.path = append(rr.path, PathCommand{Command: "M", X: x, Y: y})
+}
+
+// LineTo implements the interface method.
+func (rr *recordingRenderer) LineTo(x, y int) {
+	rr.path = append(rr.path, PathCommand{Command: "L", X: x, Y: y})
+}
+
+// QuadCurveTo implements the interface method.
+func (rr *recordingRenderer) QuadCurveTo(cx, cy, x, y int) {
+	rr.path = append(rr.path, PathCommand{Command: "Q", X: x, Y: y, CX: cx, CY: cy})
+}
+
+// ArcTo implements the interface method.
+func (rr *recordingRenderer) ArcTo(cx, cy int, rx, ry, startAngle, delta float64) {
+	rr.path = append(rr.path, PathCommand{Command: "A", CX: cx, CY: cy, RX: rx, RY: ry, StartAngle: startAngle, Delta: delta})
+}
+
+// Close implements the interface method.
+func (rr *recordingRenderer) Close() {
+	rr.path = append(rr.path, PathCommand{Command: "Z"})
+}
+
+// Stroke implements the interface method.
+func (rr *recordingRenderer) Stroke() {
+	rr.recordPath(DrawOpStroke)
+}
+
+// Fill implements the interface method.
+func (rr *recordingRenderer) Fill() {
+	rr.recordPath(DrawOpFill)
+}
+
+// FillStroke implements the interface method.
+func (rr *recordingRenderer) FillStroke() {
+	rr.recordPath(DrawOpFillStroke)
+}
+
+// Circle implements the interface method.
+func (rr *recordingRenderer) Circle(radius float64, x, y int) {
+	rr.record(DrawCall{Op: DrawOpCircle, X: x, Y: y, R: radius})
+}
+
+// SetFont implements the interface method.
+func (rr *recordingRenderer) SetFont(f *truetype.Font) {
+	rr.s.Font = f
+	rr.measure.SetFont(f)
+}
+
+// SetFontColor implements the interface method.
+func (rr *recordingRenderer) SetFontColor(c drawing.Color) {
+	rr.s.FontColor = c
+}
+
+// SetFontSize implements the interface method.
+func (rr *recordingRenderer) SetFontSize(size float64) {
+	rr.s.FontSize = size
+	rr.measure.SetFontSize(size)
+}
+
+// Text implements the interface method.
+func (rr *recordingRenderer) Text(body string, x, y int) {
+	rr.record(DrawCall{Op: DrawOpText, Text: body, X: x, Y: y})
+}
+
+// MeasureText implements the interface method.
+func (rr *recordingRenderer) MeasureText(body string) Box {
+	return rr.measure.MeasureText(body)
+}
+
+// SetTextRotation implements the interface method.
+func (rr *recordingRenderer) SetTextRotation(radians float64) {
+	rr.rotateRadians = &radians
+	rr.measure.SetTextRotation(radians)
+}
+
+// ClearTextRotation implements the interface method.
+func (rr *recordingRenderer) ClearTextRotation() {
+	rr.rotateRadians = nil
+	rr.measure.ClearTextRotation()
+}
+
+// getSize implements `sizedRenderer`.
+func (rr *recordingRenderer) getSize() (width, height int) {
+	return rr.trace.Width, rr.trace.Height
+}
+
+// GetContentType implements `ContentTypeRenderer`.
+func (rr *recordingRenderer) GetContentType() string {
+	return ContentTypeJSON
+}
+
+// Save implements the interface method.
+func (rr *recordingRenderer) Save(w io.Writer) error {
+	if typed, isTyped := w.(DrawTraceCollector); isTyped {
+		typed.SetDrawTrace(rr.trace)
+		return nil
+	}
+	return json.NewEncoder(w).Encode(rr.trace)
+}
+
+func (rr *recordingRenderer) recordPath(op DrawOp) {
+	rr.record(DrawCall{Op: op, Path: rr.path})
+	rr.path = nil
+}
+
+func (rr *recordingRenderer) record(call DrawCall) {
+	call.Style = DrawCallStyle{
+		StrokeColor:     rr.s.StrokeColor,
+		StrokeWidth:     rr.s.StrokeWidth,
+		StrokeDashArray: rr.s.StrokeDashArray,
+		FillColor:       rr.s.FillColor,
+		FontSize:        rr.s.FontSize,
+		FontColor:       rr.s.FontColor,
+	}
+	if rr.rotateRadians != nil {
+		call.Style.TextRotation = *rr.rotateRadians
+	}
+	rr.trace.Calls = append(rr.trace.Calls, call)
+}
@@ -0,0 +1,92 @@
+package chart
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/blendlabs/go-assert"
+	"github.com/wcharczuk/go-chart/drawing"
+)
+
+func TestRecordingRenderer(t *testing.T) {
+	assert := assert.New(t)
+
+	r, err := Recording(100, 100)
+	assert.Nil(err)
+
+	r.SetStrokeColor(drawing.ColorRed)
+	r.SetStrokeWidth(2.0)
+	r.MoveTo(0, 0)
+	r.LineTo(10, 10)
+	r.QuadCurveTo(15, 5, 20, 10)
+	r.Close()
+	r.Stroke()
+	r.ResetStyle()
+	r.SetFillColor(drawing.ColorBlue)
+	r.Circle(5, 50, 50)
+
+	collector := &DrawTraceWriter{}
+	assert.Nil(r.Save(collector))
+	trace := collector.Trace()
+	assert.Equal(100, trace.Width)
+	assert.Len(trace.Calls, 2)
+
+	stroke := trace.Calls[0]
+	assert.Equal(DrawOpStroke, stroke.Op)
+	assert.Len(stroke.Path, 4)
+	assert.Equal("Q", stroke.Path[2].Command)
+	assert.Equal(15, stroke.Path[2].CX)
+	assert.Equal(drawing.ColorRed, stroke.Style.StrokeColor)
+	assert.Equal(2.0, stroke.Style.StrokeWidth)
+
+	circle := trace.Calls[1]
+	assert.Equal(DrawOpCircle, circle.Op)
+	assert.Equal(50, circle.X)
+	assert.Equal(5.0, circle.R)
+	assert.True(circle.Style.StrokeColor.IsZero(), "the style should have been reset")
+	assert.Equal(drawing.ColorBlue, circle.Style.FillColor)
+}
+
+func TestRecordingRendererChart(t *testing.T) {
+	assert := assert.New(t)
+
+	c := Chart{
+		Title:      "Recorded",
+		TitleStyle: StyleShow(),
+		Width:      300,
+		Height:     200,
+		XAxis:      XAxis{Style: StyleShow()},
+		Series: []Series{
+			ContinuousSeries{XValues: []float64{1, 2, 3}, YValues: []float64{1, 3, 2}},
+		},
+	}
+
+	collector := &DrawTraceWriter{}
+	assert.Nil(c.Render(Recording, collector))
+	trace := collector.Trace()
+	assert.Equal(DefaultDPI, trace.DPI)
+
+	texts := trace.Texts()
+	assert.NotEmpty(texts)
+	assert.Equal("Recorded", texts[len(texts)-1])
+
+	// the title is centered the same way the png renderer centers it.
+	png, err := PNG(300, 200)
+	assert.Nil(err)
+	png.SetDPI(DefaultDPI)
+	f, err := GetDefaultFont()
+	assert.Nil(err)
+	png.SetFont(f)
+	png.SetFontSize(DefaultTitleFontSize)
+	titleWidth := png.MeasureText("Recorded").Width()
+	title := trace.Calls[len(trace.Calls)-1]
+	assert.Equal((300>>1)-(titleWidth>>1), title.X)
+
+	buffer := bytes.NewBuffer([]byte{})
+	assert.Nil(c.Render(Recording, buffer))
+	var decoded DrawTrace
+	assert.Nil(json.Unmarshal(buffer.Bytes(), &decoded))
+	assert.Len(decoded.Calls, len(trace.Calls))
+	assert.Equal(trace.Calls[0].Style.FillColor, decoded.Calls[0].Style.FillColor)
+}
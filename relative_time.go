@@ -0,0 +1,102 @@
+package chart
+
+import (
+	"math"
+	"strconv"
+	"time"
+)
+
+// relativeTimeSteps are the tick steps of a relative time axis, smallest first.
+var relativeTimeSteps = []time.Duration{
+	time.Second, 5 * time.Second, 15 * time.Second, 30 * time.Second,
+	time.Minute, 5 * time.Minute, 15 * time.Minute, 30 * time.Minute,
+	time.Hour, 3 * time.Hour, 6 * time.Hour, 12 * time.Hour,
+	24 * time.Hour, 7 * 24 * time.Hour,
+}
+
+// RelativeTimeValueFormatter returns a value formatter that labels timestamps relative to a reference instant,
+// i.e. "-6h", "-1d", "+30m" or "now".
+func RelativeTimeValueFormatter(now time.Time) ValueFormatter {
+	return func(v interface{}) string {
+		var t time.Time
+		switch typed := v.(type) {
+		case time.Time:
+			t = typed
+		case int64:
+			t = time.Unix(0, typed)
+		case float64:
+			t = Time.FromFloat64(typed)
+		default:
+			return ""
+		}
+		return FormatRelativeDuration(t.Sub(now))
+	}
+}
+
+// FormatRelativeDuration formats a duration with a sign in the largest unit (days, hours, minutes or seconds) that
+// divides it evenly, i.e. "-6h", "-1d" or "+36h", once rounded to the second; durations under a second are "now".
+func FormatRelativeDuration(d time.Duration) string {
+	if d > -time.Second && d < time.Second {
+		return "now"
+	}
+	sign := "+"
+	if d < 0 {
+		sign = "-"
+		d = -d
+	}
+	whole := (d + time.Second/2) / time.Second * time.Second
+	units := []time.Duration{24 * time.Hour, time.Hour, time.Minute, time.Second}
+	suffixes := []string{"d", "h", "m", "s"}
+	unit, suffix := time.Second, "s"
+	for index := len(units) - 1; index >= 0; index-- {
+		if whole >= units[index] && whole%units[index] == 0 {
+			unit, suffix = units[index], suffixes[index]
+		}
+	}
+	if unit > time.Second {
+		d = whole
+	}
+	value := math.Floor(float64(d)/float64(unit)*10+0.5) / 10
+	return sign + strconv.FormatFloat(value, 'f', -1, 64) + suffix
+}
+
+// GenerateRelativeTimeTicks generates ticks for a time range anchored at a reference instant, such that the
+// instant (if within the range) is labeled "now" and the other ticks step away from it in round durations.
+func GenerateRelativeTimeTicks(r Renderer, ra Range, style Style, now time.Time) []Tick {
+	min, max := ra.GetMin(), ra.GetMax()
+	if max <= min || ra.GetDomain() <= 0 {
+		return nil
+	}
+
+	style.GetTextOptions().WriteToRenderer(r)
+	labelBox := r.MeasureText(FormatRelativeDuration(-time.Duration(max - min)))
+	tickSize := float64(labelBox.Width() + DefaultMinimumTickHorizontalSpacing)
+	maxTicks := math.Max(1, math.Floor(float64(ra.GetDomain())/tickSize))
+
+	span := float64(max - min)
+	step := relativeTimeSteps[len(relativeTimeSteps)-1]
+	for _, candidate := range relativeTimeSteps {
+		if span/float64(candidate) <= maxTicks {
+			step = candidate
+			break
+		}
+	}
+	if span/float64(step) > maxTicks {
+		days := math.Ceil(span / maxTicks / float64(24*time.Hour))
+		step = time.Duration(days) * 24 * time.Hour
+	}
+
+	// the ticks are whole steps away from the reference instant; labeling them from the step (rather than from
+	// their float value, which is only precise to a few hundred nanoseconds) keeps the labels round.
+	anchor := Time.ToFloat64(now)
+	var ticks []Tick
+	for index := int64(math.Ceil((min - anchor) / float64(step))); ; index++ {
+		offset := time.Duration(index) * step
+		value := Time.ToFloat64(now.Add(offset))
+		if value > max {
+			break
+		}
+		ticks = append(ticks, Tick{Value: value, Label: FormatRelativeDuration(offset)})
+	}
+	return ticks
+}
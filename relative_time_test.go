@@ -0,0 +1,96 @@
+package chart
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	assert "github.com/blendlabs/go-assert"
+)
+
+func TestFormatRelativeDuration(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal("now", FormatRelativeDuration(0))
+	assert.Equal("now", FormatRelativeDuration(500*time.Millisecond))
+	assert.Equal("-6h", FormatRelativeDuration(-6*time.Hour))
+	assert.Equal("-1d", FormatRelativeDuration(-24*time.Hour))
+	assert.Equal("+36h", FormatRelativeDuration(36*time.Hour))
+	assert.Equal("-1.5s", FormatRelativeDuration(-1500*time.Millisecond))
+	assert.Equal("-30m", FormatRelativeDuration(-30*time.Minute))
+	assert.Equal("-45s", FormatRelativeDuration(-45*time.Second))
+	assert.Equal("-6h", FormatRelativeDuration(-6*time.Hour+300*time.Nanosecond))
+
+	now := time.Date(2017, 01, 01, 12, 0, 0, 0, time.UTC)
+	vf := RelativeTimeValueFormatter(now)
+	assert.Equal("-2h", vf(now.Add(-2*time.Hour)))
+	assert.Equal("now", vf(Time.ToFloat64(now)))
+	assert.Equal("", vf("nope"))
+}
+
+func TestGenerateRelativeTimeTicks(t *testing.T) {
+	assert := assert.New(t)
+
+	r, err := PNG(1024, 1024)
+	assert.Nil(err)
+	f, err := GetDefaultFont()
+	assert.Nil(err)
+
+	now := time.Date(2017, 01, 01, 12, 0, 0, 0, time.UTC)
+	ra := &ContinuousRange{
+		Min:    Time.ToFloat64(now.Add(-24*time.Hour - 20*time.Minute)),
+		Max:    Time.ToFloat64(now),
+		Domain: 1000,
+	}
+	ticks := GenerateRelativeTimeTicks(r, ra, Style{Font: f, FontSize: DefaultFontSize}, now)
+	assert.NotEmpty(ticks)
+	assert.Equal("now", ticks[len(ticks)-1].Label)
+	assert.Equal(Time.ToFloat64(now), ticks[len(ticks)-1].Value)
+	for index, tick := range ticks {
+		assert.True(tick.Value >= ra.Min)
+		if index > 0 {
+			assert.True(tick.Value > ticks[index-1].Value)
+		}
+	}
+	assert.Equal("-1d", ticks[0].Label)
+
+	// float timestamps lose the nanoseconds of a real reference instant, which must not leak into the labels.
+	now = time.Date(2017, 01, 01, 12, 0, 0, 123456789, time.UTC)
+	ra = &ContinuousRange{
+		Min:    Time.ToFloat64(now.Add(-24*time.Hour - 20*time.Minute)),
+		Max:    Time.ToFloat64(now),
+		Domain: 1000,
+	}
+	ticks = GenerateRelativeTimeTicks(r, ra, Style{Font: f, FontSize: DefaultFontSize}, now)
+	assert.Equal("-1d", ticks[0].Label)
+	assert.Equal("now", ticks[len(ticks)-1].Label)
+	for _, tick := range ticks {
+		assert.False(strings.HasSuffix(tick.Label, "s"), tick.Label)
+	}
+}
+
+func TestXAxisNow(t *testing.T) {
+	assert := assert.New(t)
+
+	now := time.Date(2017, 01, 01, 12, 0, 0, 0, time.UTC)
+	c := Chart{
+		XAxis: XAxis{Style: StyleShow(), Now: now},
+		Series: []Series{
+			TimeSeries{
+				XValues: []time.Time{now.Add(-6 * time.Hour), now.Add(-3 * time.Hour), now},
+				YValues: []float64{1, 2, 3},
+			},
+		},
+	}
+	assert.Nil(c.Render(PNG, bytes.NewBuffer(nil)))
+
+	r, err := PNG(c.GetWidth(), c.GetHeight())
+	assert.Nil(err)
+	xr, _, _ := c.getRanges()
+	xr.SetDomain(c.getDefaultCanvasBox().Width())
+	ticks := c.XAxis.GetTicks(r, xr, c.styleDefaultsAxes(), TimeValueFormatter)
+	assert.NotEmpty(ticks)
+	assert.Equal("-6h", ticks[0].Label)
+	assert.Equal("now", ticks[len(ticks)-1].Label)
+}
@@ -0,0 +1,113 @@
+package chart
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"runtime"
+	"sync"
+)
+
+// RenderableChart is a chart that can be rendered, i.e. `Chart`, `BarChart`, `StackedBarChart` or `PieChart`.
+type RenderableChart interface {
+	Render(rp RendererProvider, w io.Writer) error
+}
+
+// RenderJob is a chart to render as part of a batch.
+type RenderJob struct {
+	// Name identifies the job in its result.
+	Name  string
+	Chart RenderableChart
+	// Renderer is the renderer provider to use; it defaults to `PNG`.
+	Renderer RendererProvider
+	// Writer, if set, receives the rendered chart. Otherwise the result holds the rendered bytes.
+	Writer io.Writer
+}
+
+// RenderResult is the outcome of a render job.
+type RenderResult struct {
+	Name     string
+	Contents []byte
+	Err      error
+}
+
+var _renderBufferPool = sync.Pool{
+	New: func() interface{} {
+		return bytes.NewBuffer(make([]byte, 0, 1<<16))
+	},
+}
+
+// RenderAll renders a batch of charts with at most `concurrency` charts rendering at a time, and returns
+// a result for each job in the same order as the jobs. A concurrency of zero or less uses one worker per cpu.
+// Jobs must not share mutable series (e.g. `*EMASeries`), but fonts and text measurements are shared safely.
+func RenderAll(jobs []RenderJob, concurrency int) []RenderResult {
+	if concurrency <= 0 {
+		concurrency = runtime.NumCPU()
+	}
+	if concurrency > len(jobs) {
+		concurrency = len(jobs)
+	}
+
+	results := make([]RenderResult, len(jobs))
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	wg.Add(concurrency)
+	for x := 0; x < concurrency; x++ {
+		go func() {
+			defer wg.Done()
+			for index := range indexes {
+				results[index] = renderJob(jobs[index])
+			}
+		}()
+	}
+	for index := range jobs {
+		indexes <- index
+	}
+	close(indexes)
+	wg.Wait()
+	return results
+}
+
+func renderJob(job RenderJob) (result RenderResult) {
+	result.Name = job.Name
+	defer func() {
+		if r := recover(); r != nil {
+			result.Contents = nil
+			result.Err = renderJobPanic(r)
+		}
+	}()
+
+	if job.Chart == nil {
+		result.Err = errors.New("render job has no chart")
+		return
+	}
+	rp := job.Renderer
+	if rp == nil {
+		rp = PNG
+	}
+
+	buffer := _renderBufferPool.Get().(*bytes.Buffer)
+	buffer.Reset()
+	defer _renderBufferPool.Put(buffer)
+
+	if result.Err = job.Chart.Render(rp, buffer); result.Err != nil {
+		return
+	}
+	if job.Writer != nil {
+		_, result.Err = job.Writer.Write(buffer.Bytes())
+		return
+	}
+	result.Contents = make([]byte, buffer.Len())
+	copy(result.Contents, buffer.Bytes())
+	return
+}
+
+func renderJobPanic(r interface{}) error {
+	if err, isError := r.(error); isError {
+		return err
+	}
+	if message, isString := r.(string); isString {
+		return errors.New(message)
+	}
+	return errors.New("render job panicked")
+}
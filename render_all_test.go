@@ -0,0 +1,65 @@
+package chart
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/blendlabs/go-assert"
+)
+
+func TestRenderAll(t *testing.T) {
+	assert := assert.New(t)
+
+	var jobs []RenderJob
+	for x := 0; x < 16; x++ {
+		jobs = append(jobs, RenderJob{
+			Name: fmt.Sprintf("chart-%d", x),
+			Chart: Chart{
+				Title:      fmt.Sprintf("Chart %d", x),
+				TitleStyle: Style{Show: true},
+				XAxis:      XAxis{Style: Style{Show: true}},
+				YAxis:      YAxis{Style: Style{Show: true}},
+				Series: []Series{
+					ContinuousSeries{
+						XValues: []float64{1, 2, 3, 4},
+						YValues: []float64{1, 3, 2, float64(x)},
+					},
+				},
+			},
+		})
+	}
+	jobs[3].Renderer = SVG
+	writer := bytes.NewBuffer([]byte{})
+	jobs[5].Writer = writer
+	jobs[7].Chart = nil
+
+	results := RenderAll(jobs, 4)
+	assert.Len(results, len(jobs))
+	for index, result := range results {
+		assert.Equal(jobs[index].Name, result.Name)
+		switch index {
+		case 5:
+			assert.Nil(result.Err)
+			assert.Empty(result.Contents)
+			assert.NotZero(writer.Len())
+		case 7:
+			assert.NotNil(result.Err)
+		default:
+			assert.Nil(result.Err)
+			assert.NotEmpty(result.Contents)
+		}
+	}
+	assert.Equal("<svg", string(results[3].Contents[:4]))
+	assert.Equal("\x89PNG", string(results[0].Contents[:4]))
+}
+
+func TestRenderAllError(t *testing.T) {
+	assert := assert.New(t)
+
+	results := RenderAll([]RenderJob{
+		{Name: "empty", Chart: Chart{}},
+	}, 0)
+	assert.Len(results, 1)
+	assert.NotNil(results[0].Err)
+}
@@ -0,0 +1,200 @@
+package chart
+
+import (
+	"encoding/json"
+	"io"
+	"math"
+)
+
+// RenderInfo describes a rendered chart, so interactive overlays (i.e. hover rulers or click to zoom) can be built on
+// top of the image; positions are in pixels from the top left of the image.
+type RenderInfo struct {
+	Width  int     `json:"width"`
+	Height int     `json:"height"`
+	Canvas BoxInfo `json:"canvas"`
+
+	XAxis          AxisInfo `json:"xAxis"`
+	YAxis          AxisInfo `json:"yAxis"`
+	YAxisSecondary AxisInfo `json:"yAxisSecondary"`
+
+	Series []SeriesInfo `json:"series"`
+	Legend []LegendInfo `json:"legend"`
+
+	// IncludePoints, if set before rendering, also describes the position of every value of each series.
+	IncludePoints bool `json:"-"`
+}
+
+// BoxInfo is a box in a render info.
+type BoxInfo struct {
+	Top    int `json:"top"`
+	Left   int `json:"left"`
+	Right  int `json:"right"`
+	Bottom int `json:"bottom"`
+}
+
+// AxisInfo describes the range and ticks of a rendered axis.
+type AxisInfo struct {
+	Min        float64    `json:"min"`
+	Max        float64    `json:"max"`
+	Descending bool       `json:"descending"`
+	Ticks      []TickInfo `json:"ticks"`
+}
+
+// TickInfo is a rendered tick and its position along its axis.
+type TickInfo struct {
+	Value    float64 `json:"value"`
+	Label    string  `json:"label"`
+	Position int     `json:"position"`
+}
+
+// SeriesInfo describes the extent of a rendered series; series without values have no extent.
+type SeriesInfo struct {
+	Key   string  `json:"key"`
+	Name  string  `json:"name"`
+	YAxis string  `json:"yAxis"`
+	Color string  `json:"color"`
+	Count int     `json:"count"`
+	MinX  float64 `json:"minX"`
+	MaxX  float64 `json:"maxX"`
+	MinY  float64 `json:"minY"`
+	MaxY  float64 `json:"maxY"`
+
+	Points []PointInfo `json:"points,omitempty"`
+}
+
+// PointInfo is a rendered value of a series, with its labels formatted like the axes.
+type PointInfo struct {
+	X      float64 `json:"x"`
+	Y      float64 `json:"y"`
+	XLabel string  `json:"xLabel"`
+	YLabel string  `json:"yLabel"`
+	Left   int     `json:"left"`
+	Top    int     `json:"top"`
+}
+
+// LegendInfo is an entry of the chart's legend.
+type LegendInfo struct {
+	Label string `json:"label"`
+	Color string `json:"color"`
+}
+
+// RenderWithInfo renders the chart to `w` and writes its render info as json to `info`.
+func (c Chart) RenderWithInfo(rp RendererProvider, w io.Writer, info io.Writer) error {
+	c.Info = &RenderInfo{IncludePoints: c.Info != nil && c.Info.IncludePoints}
+	if err := c.Render(rp, w); err != nil {
+		return err
+	}
+	return json.NewEncoder(info).Encode(c.Info)
+}
+
+// getRenderInfo describes the chart once it is laid out.
+func (c Chart) getRenderInfo(canvasBox Box, xr, yr, yra Range, xt, yt, yta []Tick, xf, yf, yfa ValueFormatter, includePoints bool) RenderInfo {
+	info := RenderInfo{
+		IncludePoints: includePoints,
+		Width:         c.GetWidth(),
+		Height:        c.GetHeight(),
+		Canvas:        boxInfo(canvasBox),
+		XAxis: getAxisInfo(xr, xt, func(v float64) int {
+			return canvasBox.Left + xr.Translate(v)
+		}),
+		YAxis: getAxisInfo(yr, yt, func(v float64) int {
+			return canvasBox.Bottom - yr.Translate(v)
+		}),
+		YAxisSecondary: getAxisInfo(yra, yta, func(v float64) int {
+			return canvasBox.Bottom - yra.Translate(v)
+		}),
+	}
+
+	keys := c.SeriesKeys()
+	for index, s := range c.Series {
+		style := s.GetStyle()
+		if !style.IsZero() && !style.Show {
+			continue
+		}
+		color := style.InheritFrom(c.styleDefaultsSeries(index)).GetStrokeColor().String()
+		si := SeriesInfo{Key: keys[index], Name: s.GetName(), YAxis: "primary", Color: color}
+		if s.GetYAxis() == YAxisSecondary {
+			si.YAxis = "secondary"
+		}
+		getSeriesExtent(s, &si)
+		if vp, isValueProvider := s.(ValueProvider); isValueProvider && includePoints {
+			if si.YAxis == "secondary" {
+				si.Points = getPointInfos(vp, canvasBox, xr, yra, xf, yfa)
+			} else {
+				si.Points = getPointInfos(vp, canvasBox, xr, yr, xf, yf)
+			}
+		}
+		info.Series = append(info.Series, si)
+	}
+
+	labels, lines := c.getLegendEntries(c.Series)
+	for index, label := range labels {
+		info.Legend = append(info.Legend, LegendInfo{Label: label, Color: lines[index].GetStrokeColor().String()})
+	}
+	return info
+}
+
+func getAxisInfo(ra Range, ticks []Tick, position func(float64) int) AxisInfo {
+	ai := AxisInfo{Min: ra.GetMin(), Max: ra.GetMax(), Descending: ra.IsDescending()}
+	for _, t := range ticks {
+		ai.Ticks = append(ai.Ticks, TickInfo{Value: t.Value, Label: t.Label, Position: position(t.Value)})
+	}
+	return ai
+}
+
+// getPointInfos describes the (non NaN) values of a series.
+func getPointInfos(vp ValueProvider, canvasBox Box, xr, yr Range, xf, yf ValueFormatter) []PointInfo {
+	if xf == nil {
+		xf = FloatValueFormatter
+	}
+	if yf == nil {
+		yf = FloatValueFormatter
+	}
+	var points []PointInfo
+	for index := 0; index < vp.Len(); index++ {
+		x, y := vp.GetValue(index)
+		if math.IsNaN(x) || math.IsNaN(y) {
+			continue
+		}
+		points = append(points, PointInfo{
+			X:      x,
+			Y:      y,
+			XLabel: xf(x),
+			YLabel: yf(y),
+			Left:   canvasBox.Left + xr.Translate(x),
+			Top:    canvasBox.Bottom - yr.Translate(y),
+		})
+	}
+	return points
+}
+
+// getSeriesExtent sets the count and extent of the (non NaN) values of a series.
+func getSeriesExtent(s Series, si *SeriesInfo) {
+	si.MinX, si.MinY = math.MaxFloat64, math.MaxFloat64
+	si.MaxX, si.MaxY = -math.MaxFloat64, -math.MaxFloat64
+	add := func(x float64, ys ...float64) {
+		for _, y := range ys {
+			if math.IsNaN(x) || math.IsNaN(y) {
+				continue
+			}
+			si.MinX, si.MaxX = math.Min(si.MinX, x), math.Max(si.MaxX, x)
+			si.MinY, si.MaxY = math.Min(si.MinY, y), math.Max(si.MaxY, y)
+		}
+	}
+	if bvp, isBoundedValueProvider := s.(BoundedValueProvider); isBoundedValueProvider {
+		si.Count = bvp.Len()
+		for index := 0; index < bvp.Len(); index++ {
+			x, y1, y2 := bvp.GetBoundedValue(index)
+			add(x, y1, y2)
+		}
+	} else if vp, isValueProvider := s.(ValueProvider); isValueProvider {
+		si.Count = vp.Len()
+		for index := 0; index < vp.Len(); index++ {
+			x, y := vp.GetValue(index)
+			add(x, y)
+		}
+	}
+	if si.MinX > si.MaxX {
+		si.MinX, si.MaxX, si.MinY, si.MaxY = 0, 0, 0, 0
+	}
+}
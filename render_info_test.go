@@ -0,0 +1,67 @@
+package chart
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	assert "github.com/blendlabs/go-assert"
+)
+
+func TestChartRenderInfo(t *testing.T) {
+	assert := assert.New(t)
+
+	info := RenderInfo{}
+	c := Chart{
+		Info:  &info,
+		XAxis: XAxis{Style: StyleShow()},
+		YAxis: YAxis{Style: StyleShow()},
+		Series: []Series{
+			ContinuousSeries{Name: "foo", XValues: []float64{1, 2, 3}, YValues: []float64{4, 5, 6}},
+			ContinuousSeries{Name: "bar", YAxis: YAxisSecondary, XValues: []float64{1, 2, 3}, YValues: []float64{-1, 7, 2}},
+		},
+	}
+	buffer := bytes.NewBuffer([]byte{})
+	assert.Nil(c.Render(PNG, buffer))
+
+	assert.Equal(DefaultChartWidth, info.Width)
+	assert.Equal(DefaultChartHeight, info.Height)
+	assert.NotEmpty(info.XAxis.Ticks)
+	assert.NotEmpty(info.YAxis.Ticks)
+
+	first := info.XAxis.Ticks[0]
+	assert.Equal(info.Canvas.Left+int(float64(info.Canvas.Right-info.Canvas.Left)*(first.Value-info.XAxis.Min)/(info.XAxis.Max-info.XAxis.Min)), first.Position)
+
+	assert.Len(info.Series, 2)
+	assert.Equal("foo", info.Series[0].Name)
+	assert.Equal("primary", info.Series[0].YAxis)
+	assert.Equal(3, info.Series[0].Count)
+	assert.Equal(4.0, info.Series[0].MinY)
+	assert.Equal(6.0, info.Series[0].MaxY)
+	assert.Equal("secondary", info.Series[1].YAxis)
+	assert.Equal(-1.0, info.Series[1].MinY)
+	assert.Equal(7.0, info.Series[1].MaxY)
+
+	assert.Len(info.Legend, 2)
+	assert.Equal("bar", info.Legend[1].Label)
+	assert.Equal(info.Series[1].Color, info.Legend[1].Color)
+}
+
+func TestChartRenderWithInfo(t *testing.T) {
+	assert := assert.New(t)
+
+	c := Chart{
+		Series: []Series{
+			ContinuousSeries{Name: "foo", XValues: []float64{1, 2, 3}, YValues: []float64{4, 5, 6}},
+		},
+	}
+	image, sidecar := bytes.NewBuffer([]byte{}), bytes.NewBuffer([]byte{})
+	assert.Nil(c.RenderWithInfo(PNG, image, sidecar))
+	assert.NotZero(image.Len())
+
+	var info RenderInfo
+	assert.Nil(json.Unmarshal(sidecar.Bytes(), &info))
+	assert.Len(info.Series, 1)
+	assert.Equal("foo", info.Series[0].Name)
+	assert.Nil(c.Info)
+}
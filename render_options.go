@@ -0,0 +1,69 @@
+package chart
+
+import (
+	"io"
+	"reflect"
+)
+
+// RenderOptions are per-render overrides of a chart's settings; unset fields leave the chart's settings as is.
+type RenderOptions struct {
+	Width  int
+	Height int
+	DPI    float64
+	// Theme is applied to the copy of the chart that is rendered, i.e. `PresetPublication()`.
+	Theme Preset
+}
+
+// RenderWith renders the chart with per-render overrides, without changing the chart, so one chart
+// definition can be rendered at several sizes (concurrently, too). The axis ranges the chart points to are copied
+// before they're fit to the series, as are populated `Ranges`; unpopulated `Ranges` still receive (copies of) the
+// ranges resolved by the first render to finish resolving them.
+// Elements that hold a pointer to the chart (i.e. legends) see the chart's own settings, not the overrides.
+func (c Chart) RenderWith(rp RendererProvider, w io.Writer, opts RenderOptions) error {
+	return c.WithOptions(opts).Render(rp, w)
+}
+
+// WithOptions returns a copy of the chart with the overrides applied, that doesn't share any ranges with the chart.
+func (c Chart) WithOptions(opts RenderOptions) Chart {
+	if opts.Width > 0 {
+		c.Width = opts.Width
+	}
+	if opts.Height > 0 {
+		c.Height = opts.Height
+	}
+	if opts.DPI > 0 {
+		c.DPI = opts.DPI
+	}
+
+	c.XAxis.Range = cloneRange(c.XAxis.Range)
+	c.YAxis.Range = cloneRange(c.YAxis.Range)
+	c.YAxisSecondary.Range = cloneRange(c.YAxisSecondary.Range)
+	if x, y, ySecondary, ok := c.Ranges.get(); ok {
+		c.Ranges = &ChartRanges{X: x, Y: y, YSecondary: ySecondary}
+	}
+
+	// copy the series and elements so a theme appending to them doesn't write into the chart's backing arrays.
+	c.Series = append([]Series(nil), c.Series...)
+	c.Elements = append([]Renderable(nil), c.Elements...)
+	if opts.Theme != nil {
+		c = opts.Theme.Apply(c)
+	}
+	return c
+}
+
+// cloneRange returns a copy of a range; ranges are mutated as a chart is laid out (i.e. by `SetDomain`).
+func cloneRange(ra Range) Range {
+	if ra == nil {
+		return nil
+	}
+	value := reflect.ValueOf(ra)
+	if value.Kind() != reflect.Ptr || value.IsNil() || value.Elem().Kind() != reflect.Struct {
+		return ra
+	}
+	copied := reflect.New(value.Elem().Type())
+	copied.Elem().Set(value.Elem())
+	if cloned, isRange := copied.Interface().(Range); isRange {
+		return cloned
+	}
+	return ra
+}
@@ -0,0 +1,99 @@
+package chart
+
+import (
+	"bytes"
+	"image/png"
+	"sync"
+	"testing"
+
+	assert "github.com/blendlabs/go-assert"
+)
+
+func TestChartRenderWith(t *testing.T) {
+	assert := assert.New(t)
+
+	yrange := &ContinuousRange{Min: 0, Max: 10}
+	c := Chart{
+		Width:  300,
+		Height: 200,
+		YAxis:  YAxis{Style: StyleShow(), Range: yrange},
+		Series: []Series{
+			ContinuousSeries{XValues: Sequence.Float64(1.0, 10.0), YValues: Sequence.Float64(1.0, 10.0)},
+		},
+	}
+
+	sizes := []RenderOptions{
+		{Width: 640, Height: 480},
+		{Width: 160, Height: 90, DPI: 72},
+		{Theme: PresetMinimal()},
+	}
+	outputs := make([]*bytes.Buffer, len(sizes))
+	errs := make([]error, len(sizes))
+	wg := sync.WaitGroup{}
+	for index, opts := range sizes {
+		wg.Add(1)
+		go func(index int, opts RenderOptions) {
+			defer wg.Done()
+			outputs[index] = bytes.NewBuffer(nil)
+			errs[index] = c.RenderWith(PNG, outputs[index], opts)
+		}(index, opts)
+	}
+	wg.Wait()
+
+	expected := [][2]int{{640, 480}, {160, 90}, {300, 200}}
+	for index := range sizes {
+		assert.Nil(errs[index])
+		image, err := png.Decode(outputs[index])
+		assert.Nil(err)
+		assert.Equal(expected[index][0], image.Bounds().Dx())
+		assert.Equal(expected[index][1], image.Bounds().Dy())
+	}
+
+	assert.Equal(300, c.Width)
+	assert.Zero(yrange.Domain, "the chart's range is copied before it is laid out")
+	assert.Len(c.Elements, 0)
+}
+
+func TestChartWithOptionsRanges(t *testing.T) {
+	assert := assert.New(t)
+
+	ranges := &ChartRanges{}
+	c := Chart{
+		Ranges: ranges,
+		Series: []Series{
+			ContinuousSeries{XValues: Sequence.Float64(1.0, 10.0), YValues: Sequence.Float64(1.0, 10.0)},
+		},
+	}
+	assert.Nil(c.RenderWith(PNG, bytes.NewBuffer(nil), RenderOptions{Width: 200, Height: 100}))
+	assert.False(ranges.IsZero(), "unpopulated ranges receive the resolved ranges")
+	assert.Equal(1.0, ranges.X.GetMin())
+	assert.Equal(10.0, ranges.X.GetMax())
+
+	copied := c.WithOptions(RenderOptions{Height: 300})
+	assert.True(copied.Ranges != ranges)
+	assert.True(copied.Ranges.Y != ranges.Y)
+	assert.Equal(ranges.Y.GetMax(), copied.Ranges.Y.GetMax())
+}
+
+func TestChartRenderWithConcurrentRanges(t *testing.T) {
+	assert := assert.New(t)
+
+	ranges := &ChartRanges{}
+	c := Chart{
+		Ranges: ranges,
+		Series: []Series{
+			ContinuousSeries{XValues: Sequence.Float64(1.0, 10.0), YValues: Sequence.Float64(1.0, 10.0)},
+		},
+	}
+
+	errs := make(chan error, 4)
+	for x := 0; x < 4; x++ {
+		go func(width int) {
+			errs <- c.RenderWith(PNG, bytes.NewBuffer(nil), RenderOptions{Width: width, Height: 100})
+		}(200 + 100*x)
+	}
+	for x := 0; x < 4; x++ {
+		assert.Nil(<-errs)
+	}
+	assert.False(ranges.IsZero())
+}
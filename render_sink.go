@@ -0,0 +1,103 @@
+package chart
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+)
+
+// ContentTypeRenderer is implemented by renderers that report the content type of their output.
+type ContentTypeRenderer interface {
+	GetContentType() string
+}
+
+// RenderSink is a destination for rendered charts, i.e. a file, a buffer or an object store.
+type RenderSink interface {
+	Store(ctx context.Context, contents []byte, contentType string) error
+}
+
+// RenderSinkFunc is a function that implements `RenderSink`.
+type RenderSinkFunc func(ctx context.Context, contents []byte, contentType string) error
+
+// Store implements `RenderSink`.
+func (rsf RenderSinkFunc) Store(ctx context.Context, contents []byte, contentType string) error {
+	return rsf(ctx, contents, contentType)
+}
+
+// ObjectStore is the subset of an object storage client (i.e. s3 or gcs) a chart sink needs.
+type ObjectStore interface {
+	Put(ctx context.Context, key, contentType string, body io.Reader) error
+}
+
+// RenderTo renders a chart and stores it in a sink, along with the content type reported by the renderer.
+// The renderer provider defaults to `PNG`.
+func RenderTo(ctx context.Context, c RenderableChart, rp RendererProvider, sink RenderSink) error {
+	if sink == nil {
+		return errors.New("render sink is nil")
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	rp, contentType := withContentType(rp)
+	results := RenderAll([]RenderJob{{Chart: c, Renderer: rp}}, 1)
+	if results[0].Err != nil {
+		return results[0].Err
+	}
+	return sink.Store(ctx, results[0].Contents, *contentType)
+}
+
+// FileSink returns a sink that replaces a file with each rendered chart, see `FileTarget`.
+func FileSink(path string) RenderSink {
+	target := FileTarget(path)
+	return RenderSinkFunc(func(ctx context.Context, contents []byte, _ string) error {
+		return target.Publish(ctx, contents)
+	})
+}
+
+// WriterSink returns a sink that writes each rendered chart to a new writer opened for its content type.
+// The writer is closed after the chart is written.
+func WriterSink(open func(ctx context.Context, contentType string) (io.WriteCloser, error)) RenderSink {
+	return RenderSinkFunc(func(ctx context.Context, contents []byte, contentType string) error {
+		target := WriterTarget(func(ctx context.Context) (io.WriteCloser, error) {
+			return open(ctx, contentType)
+		})
+		return target.Publish(ctx, contents)
+	})
+}
+
+// ObjectSink returns a sink that puts each rendered chart into an object store under a key.
+func ObjectSink(store ObjectStore, key string) RenderSink {
+	return RenderSinkFunc(func(ctx context.Context, contents []byte, contentType string) error {
+		return store.Put(ctx, key, contentType, bytes.NewReader(contents))
+	})
+}
+
+// BufferSink is a sink that keeps the latest rendered chart and its content type in memory.
+type BufferSink struct {
+	Buffer      bytes.Buffer
+	ContentType string
+}
+
+// Store implements `RenderSink`.
+func (bs *BufferSink) Store(_ context.Context, contents []byte, contentType string) error {
+	bs.Buffer.Reset()
+	bs.ContentType = contentType
+	_, err := bs.Buffer.Write(contents)
+	return err
+}
+
+// withContentType wraps a renderer provider to capture the content type of the renderer it provides.
+func withContentType(rp RendererProvider) (RendererProvider, *string) {
+	if rp == nil {
+		rp = PNG
+	}
+	contentType := ContentTypeUnknown
+	return func(width, height int) (Renderer, error) {
+		r, err := rp(width, height)
+		if typed, isTyped := r.(ContentTypeRenderer); isTyped {
+			contentType = typed.GetContentType()
+		}
+		return r, err
+	}, &contentType
+}
@@ -0,0 +1,58 @@
+package chart
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/blendlabs/go-assert"
+)
+
+type testObjectStore map[string]string
+
+func (tos testObjectStore) Put(_ context.Context, key, contentType string, body io.Reader) error {
+	contents, err := ioutil.ReadAll(body)
+	tos[key] = contentType + ":" + string(contents[:4])
+	return err
+}
+
+func TestRenderTo(t *testing.T) {
+	assert := assert.New(t)
+
+	c := Chart{
+		Series: []Series{
+			ContinuousSeries{XValues: []float64{1, 2, 3}, YValues: []float64{1, 2, 3}},
+		},
+	}
+
+	buffer := &BufferSink{}
+	assert.Nil(RenderTo(context.Background(), c, nil, buffer))
+	assert.Equal(ContentTypePNG, buffer.ContentType)
+	assert.NotZero(buffer.Buffer.Len())
+
+	assert.Nil(RenderTo(context.Background(), c, SVG, buffer))
+	assert.Equal(ContentTypeSVG, buffer.ContentType)
+	assert.Equal("<svg", buffer.Buffer.String()[:4])
+
+	store := testObjectStore{}
+	assert.Nil(RenderTo(context.Background(), c, SVG, ObjectSink(store, "charts/cpu.svg")))
+	assert.Equal(ContentTypeSVG+":<svg", store["charts/cpu.svg"])
+
+	dir, err := ioutil.TempDir("", "go-chart")
+	assert.Nil(err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "chart.svg")
+	assert.Nil(RenderTo(context.Background(), c, SVG, FileSink(path)))
+	contents, err := ioutil.ReadFile(path)
+	assert.Nil(err)
+	assert.Equal("<svg", string(contents[:4]))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.NotNil(RenderTo(ctx, c, SVG, buffer))
+	assert.NotNil(RenderTo(context.Background(), c, SVG, nil))
+	assert.NotNil(RenderTo(context.Background(), Chart{}, SVG, buffer))
+}
@@ -84,3 +84,32 @@ type Renderer interface {
 	// Save writes the image to the given writer.
 	Save(w io.Writer) error
 }
+
+// DescriptionRenderer is a renderer that can embed a text description of the chart in its output.
+type DescriptionRenderer interface {
+	// SetDescription sets the description (caption or alt text) of the output.
+	SetDescription(description string)
+}
+
+// GroupRenderer is a renderer that can group draw calls under an id, i.e. as svg `<g>` elements.
+type GroupRenderer interface {
+	// StartGroup starts a group; groups cannot be nested.
+	StartGroup(id string)
+	// EndGroup ends the current group.
+	EndGroup()
+}
+
+// ClipRenderer is a renderer that can clip draw calls to a path.
+type ClipRenderer interface {
+	// Clip clips subsequent draw calls to the current path (as drawn with `MoveTo`, `LineTo` etc.) and clears it;
+	// a clip replaces any previous clip.
+	Clip()
+	// ResetClip removes the clip.
+	ResetClip()
+}
+
+// AntiAliasRenderer is a renderer that can turn off anti-aliasing, i.e. to draw background series faster.
+type AntiAliasRenderer interface {
+	// SetAntiAlias turns anti-aliasing of subsequent draw calls on or off; it is on by default.
+	SetAntiAlias(antiAlias bool)
+}
@@ -0,0 +1,102 @@
+package chart
+
+import (
+	"fmt"
+	"math"
+)
+
+const (
+	// DefaultRugLength is the default length of the rug marks.
+	DefaultRugLength = 8
+	// DefaultRugAlpha is the default alpha of the rug marks, so overlapping marks read as darker.
+	DefaultRugAlpha = 128
+)
+
+// RugAxis is which axis a rug series draws its marks along.
+type RugAxis int
+
+const (
+	// RugAxisX draws the marks along the bottom of the canvas at the x value of each observation.
+	RugAxisX RugAxis = 0
+	// RugAxisY draws the marks along the left of the canvas at the y value of each observation.
+	RugAxisY RugAxis = 1
+)
+
+// RugSeries draws a small tick mark along the edge of the canvas for each observation of the inner series,
+// i.e. to show the raw samples beneath a density series.
+// A rug series is drawn within the ranges of the other series; its own values don't change the ranges.
+type RugSeries struct {
+	Name  string
+	Style Style
+	YAxis YAxisType
+
+	InnerSeries ValueProvider
+	Axis        RugAxis
+	// Length is the length of each mark in pixels.
+	Length int
+}
+
+// GetName returns the name of the series.
+func (rs RugSeries) GetName() string {
+	return rs.Name
+}
+
+// GetStyle returns the series style.
+func (rs RugSeries) GetStyle() Style {
+	return rs.Style
+}
+
+// GetYAxis returns which YAxis the series draws on.
+func (rs RugSeries) GetYAxis() YAxisType {
+	return rs.YAxis
+}
+
+// GetLength returns the mark length or a default.
+func (rs RugSeries) GetLength(defaults ...int) int {
+	if rs.Length == 0 {
+		if len(defaults) > 0 {
+			return defaults[0]
+		}
+		return DefaultRugLength
+	}
+	return rs.Length
+}
+
+// Render renders the series.
+func (rs RugSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
+	style := rs.Style.InheritFrom(Style{
+		StrokeColor: defaults.GetStrokeColor().WithAlpha(DefaultRugAlpha),
+		StrokeWidth: defaults.GetStrokeWidth(DefaultSeriesLineWidth),
+	})
+	style.GetStrokeOptions().WriteToRenderer(r)
+	defer r.ResetStyle()
+
+	length := rs.GetLength()
+	for index := 0; index < rs.InnerSeries.Len(); index++ {
+		vx, vy := rs.InnerSeries.GetValue(index)
+		if rs.Axis == RugAxisY {
+			if math.IsNaN(vy) || vy < yrange.GetMin() || vy > yrange.GetMax() {
+				continue
+			}
+			y := canvasBox.Bottom - yrange.Translate(vy)
+			r.MoveTo(canvasBox.Left, y)
+			r.LineTo(canvasBox.Left+length, y)
+		} else {
+			if math.IsNaN(vx) || vx < xrange.GetMin() || vx > xrange.GetMax() {
+				continue
+			}
+			x := canvasBox.Left + xrange.Translate(vx)
+			r.MoveTo(x, canvasBox.Bottom)
+			r.LineTo(x, canvasBox.Bottom-length)
+		}
+	}
+	r.Stroke()
+}
+
+// Validate validates the series.
+func (rs RugSeries) Validate() error {
+	if rs.InnerSeries == nil {
+		return fmt.Errorf("rug series requires InnerSeries to be set")
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+package chart
+
+import (
+	"testing"
+
+	assert "github.com/blendlabs/go-assert"
+)
+
+func TestRugSeriesRender(t *testing.T) {
+	assert := assert.New(t)
+
+	r, err := Recording(100, 100)
+	assert.Nil(err)
+
+	rs := RugSeries{
+		InnerSeries: ContinuousSeries{XValues: []float64{0, 5, 10, 20}, YValues: []float64{1, 2, 3, 4}},
+		Length:      5,
+	}
+	assert.Nil(rs.Validate())
+
+	canvasBox := Box{Top: 0, Left: 10, Right: 110, Bottom: 100}
+	xrange := &ContinuousRange{Min: 0, Max: 10, Domain: 100}
+	yrange := &ContinuousRange{Min: 0, Max: 10, Domain: 100}
+	rs.Render(r, canvasBox, xrange, yrange, Style{StrokeColor: ColorBlue})
+
+	collector := &DrawTraceWriter{}
+	assert.Nil(r.Save(collector))
+	calls := collector.Trace().Calls
+	assert.Len(calls, 1)
+	path := calls[0].Path
+	assert.Len(path, 6, "the out of range value is skipped")
+	assert.Equal(60, path[2].X)
+	assert.Equal(100, path[2].Y)
+	assert.Equal(95, path[3].Y)
+
+	r, err = Recording(100, 100)
+	assert.Nil(err)
+	rs.Axis = RugAxisY
+	rs.Render(r, canvasBox, xrange, yrange, Style{StrokeColor: ColorBlue})
+	assert.Nil(r.Save(collector))
+	path = collector.Trace().Calls[0].Path
+	assert.Len(path, 8)
+	assert.Equal(10, path[0].X)
+	assert.Equal(90, path[0].Y)
+	assert.Equal(15, path[1].X)
+}
@@ -0,0 +1,103 @@
+package chart
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+)
+
+// ScheduleStep is a target value that takes effect at a given time and holds until the next step.
+type ScheduleStep struct {
+	Start time.Time
+	Value float64
+}
+
+// ScheduleSeries draws stepwise targets by time window across the chart's x range.
+// Each step holds its value from its start until the next step starts, or to the end of the x range.
+// The x range is injected at render time.
+type ScheduleSeries struct {
+	Name  string
+	Style Style
+	YAxis YAxisType
+
+	Steps []ScheduleStep
+
+	xvalues []float64
+	yvalues []float64
+}
+
+// GetName returns the name of the series.
+func (ss ScheduleSeries) GetName() string {
+	return ss.Name
+}
+
+// GetStyle returns the line style.
+func (ss ScheduleSeries) GetStyle() Style {
+	return ss.Style
+}
+
+// GetYAxis returns which YAxis the series draws on.
+func (ss ScheduleSeries) GetYAxis() YAxisType {
+	return ss.YAxis
+}
+
+// GetValueFormatters returns value formatter defaults for the series.
+func (ss ScheduleSeries) GetValueFormatters() (x, y ValueFormatter) {
+	x = TimeValueFormatter
+	y = FloatValueFormatter
+	return
+}
+
+// WithXRange returns a copy of the series with the steps resolved to points within a given x range.
+func (ss ScheduleSeries) WithXRange(xrange Range) ValueProvider {
+	ss.xvalues, ss.yvalues = nil, nil
+
+	steps := make([]ScheduleStep, len(ss.Steps))
+	copy(steps, ss.Steps)
+	sort.SliceStable(steps, func(i, j int) bool {
+		return steps[i].Start.Before(steps[j].Start)
+	})
+
+	min, max := xrange.GetMin(), xrange.GetMax()
+	for index, step := range steps {
+		start := Time.ToFloat64(step.Start)
+		end := max
+		if index < len(steps)-1 {
+			end = Time.ToFloat64(steps[index+1].Start)
+		}
+		start, end = math.Max(start, min), math.Min(end, max)
+		if start >= end {
+			continue
+		}
+		ss.xvalues = append(ss.xvalues, start, end)
+		ss.yvalues = append(ss.yvalues, step.Value, step.Value)
+	}
+	return ss
+}
+
+// Len returns the number of elements in the series; it is zero until the x range is set.
+func (ss ScheduleSeries) Len() int {
+	return len(ss.xvalues)
+}
+
+// GetValue gets a value at a given index.
+func (ss ScheduleSeries) GetValue(index int) (x, y float64) {
+	x = ss.xvalues[index]
+	y = ss.yvalues[index]
+	return
+}
+
+// Render renders the series.
+func (ss ScheduleSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
+	style := ss.Style.InheritFrom(defaults)
+	Draw.LineSeries(r, canvasBox, xrange, yrange, style, ss.WithXRange(xrange))
+}
+
+// Validate validates the series.
+func (ss ScheduleSeries) Validate() error {
+	if len(ss.Steps) == 0 {
+		return fmt.Errorf("schedule series requires steps to be set and not empty")
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package chart
+
+import (
+	"testing"
+	"time"
+
+	"github.com/blendlabs/go-assert"
+)
+
+func TestScheduleSeriesWithXRange(t *testing.T) {
+	assert := assert.New(t)
+
+	start := time.Date(2017, 01, 01, 0, 0, 0, 0, time.UTC)
+	ss := ScheduleSeries{
+		Steps: []ScheduleStep{
+			{Start: start.AddDate(0, 0, 10), Value: 20.0},
+			{Start: start, Value: 10.0},
+			{Start: start.AddDate(0, 0, 40), Value: 30.0},
+		},
+	}
+	assert.Zero(ss.Len())
+
+	xrange := &ContinuousRange{
+		Min: Time.ToFloat64(start.AddDate(0, 0, 5)),
+		Max: Time.ToFloat64(start.AddDate(0, 0, 20)),
+	}
+	vp := ss.WithXRange(xrange)
+
+	// the first step is clipped to the start of the range, the second holds to the end of the range,
+	// and the last step is outside of the range.
+	assert.Equal(4, vp.Len())
+	x, y := vp.GetValue(0)
+	assert.Equal(xrange.Min, x)
+	assert.Equal(10.0, y)
+	x, y = vp.GetValue(1)
+	assert.Equal(Time.ToFloat64(start.AddDate(0, 0, 10)), x)
+	assert.Equal(10.0, y)
+	x, y = vp.GetValue(2)
+	assert.Equal(Time.ToFloat64(start.AddDate(0, 0, 10)), x)
+	assert.Equal(20.0, y)
+	x, y = vp.GetValue(3)
+	assert.Equal(xrange.Max, x)
+	assert.Equal(20.0, y)
+}
+
+func TestScheduleSeriesValidate(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.NotNil(ScheduleSeries{}.Validate())
+	assert.Nil(ScheduleSeries{Steps: []ScheduleStep{{Start: time.Now(), Value: 1.0}}}.Validate())
+}
@@ -8,3 +8,23 @@ type Series interface {
 	Validate() error
 	Render(r Renderer, canvasBox Box, xrange, yrange Range, s Style)
 }
+
+// DerivedSeries is a series computed from an inner series, i.e. a moving average.
+// Unless it has a name of its own, the legend names it after its derivation and its inner series, i.e. "SMA(20) of CPU".
+type DerivedSeries interface {
+	GetInnerSeries() ValueProvider
+	// GetDerivation describes how the series is derived, i.e. "SMA(20)".
+	GetDerivation() string
+}
+
+// SeededSeries is a series with randomized rendering (i.e. jitter or label tie-breaking) that takes the chart's seed.
+type SeededSeries interface {
+	// WithSeed returns a copy of the series using the seed, unless the series sets its own.
+	WithSeed(seed int64) Series
+}
+
+// cachedSeries is a series with derived values that are costly to compute on each access (i.e. histogram
+// normalizations), that computes them once per render.
+type cachedSeries interface {
+	withCachedValues() Series
+}
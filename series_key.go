@@ -0,0 +1,63 @@
+package chart
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// SeriesKeyProvider is a series that provides its own stable key.
+type SeriesKeyProvider interface {
+	GetKey() string
+}
+
+// SeriesKeys returns a stable, unique key for each series, used as the group id of the series in svg output.
+// Keys come from `SeriesKeyProvider` if implemented, otherwise from the series name (i.e. `series-p99-latency`),
+// and otherwise from the series index. Duplicate keys are suffixed with a counter, skipping
+// any suffixed key that is already taken.
+func (c Chart) SeriesKeys() []string {
+	keys := make([]string, len(c.Series))
+	seen := map[string]bool{}
+	counts := map[string]int{}
+	for index, s := range c.Series {
+		base := seriesKey(s, index)
+		key := base
+		for seen[key] {
+			counts[base]++
+			key = fmt.Sprintf("%s-%d", base, counts[base]+1)
+		}
+		seen[key] = true
+		keys[index] = key
+	}
+	return keys
+}
+
+func seriesKey(s Series, index int) string {
+	if kp, isKeyProvider := s.(SeriesKeyProvider); isKeyProvider {
+		if key := kp.GetKey(); len(key) > 0 {
+			return key
+		}
+	}
+	if slug := seriesKeySlug(s.GetName()); len(slug) > 0 {
+		return "series-" + slug
+	}
+	return fmt.Sprintf("series-%d", index)
+}
+
+// seriesKeySlug lowercases a name and replaces runs of anything but letters and digits with dashes.
+func seriesKeySlug(name string) string {
+	var slug []rune
+	dash := false
+	for _, r := range strings.ToLower(name) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if dash && len(slug) > 0 {
+				slug = append(slug, '-')
+			}
+			slug = append(slug, r)
+			dash = false
+		} else {
+			dash = true
+		}
+	}
+	return string(slug)
+}
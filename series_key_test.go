@@ -0,0 +1,70 @@
+package chart
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	assert "github.com/blendlabs/go-assert"
+)
+
+type keyedSeries struct {
+	ContinuousSeries
+	Key string
+}
+
+func (ks keyedSeries) GetKey() string {
+	return ks.Key
+}
+
+func TestChartSeriesKeys(t *testing.T) {
+	assert := assert.New(t)
+
+	c := Chart{
+		Series: []Series{
+			ContinuousSeries{Name: "P99 Latency (ms)"},
+			ContinuousSeries{Name: "p99 latency ms"},
+			ContinuousSeries{},
+			keyedSeries{Key: "custom"},
+			ContinuousSeries{Name: "--"},
+		},
+	}
+	assert.Equal([]string{"series-p99-latency-ms", "series-p99-latency-ms-2", "series-2", "custom", "series-4"}, c.SeriesKeys())
+
+	c = Chart{
+		Series: []Series{
+			ContinuousSeries{Name: "x"},
+			ContinuousSeries{Name: "x"},
+			ContinuousSeries{Name: "x 2"},
+			ContinuousSeries{Name: "x"},
+		},
+	}
+	assert.Equal([]string{"series-x", "series-x-2", "series-x-2-2", "series-x-3"}, c.SeriesKeys())
+}
+
+func TestChartRenderSeriesGroups(t *testing.T) {
+	assert := assert.New(t)
+
+	c := Chart{
+		Series: []Series{
+			ContinuousSeries{Name: "a & b", XValues: Sequence.Float64(1.0, 4.0), YValues: Sequence.Float64(1.0, 4.0)},
+			ContinuousSeries{XValues: Sequence.Float64(1.0, 4.0), YValues: Sequence.Float64(4.0, 1.0)},
+		},
+	}
+
+	render := func() string {
+		buffer := bytes.NewBuffer(nil)
+		assert.Nil(c.Render(SVG, buffer))
+		return buffer.String()
+	}
+
+	first := render()
+	assert.True(strings.Contains(first, `<g id="series-a-b">`))
+	assert.True(strings.Contains(first, `<g id="series-1">`))
+	assert.Equal(strings.Count(first, "<g "), strings.Count(first, "</g>"))
+	assert.Equal(first, render())
+
+	buffer := bytes.NewBuffer(nil)
+	assert.Nil(c.Render(PNG, buffer))
+	assert.NotZero(buffer.Len())
+}
@@ -0,0 +1,249 @@
+package chart
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// SeriesTag is the struct tag read by `SeriesFromStructs`.
+	SeriesTag = "chart"
+)
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// seriesField is a tagged struct field.
+type seriesField struct {
+	index int
+	name  string
+	yaxis YAxisType
+}
+
+// SeriesFromStructs converts a slice of structs (or struct pointers) into series, one per field tagged `chart:"y"`.
+// The x values come from the field tagged `chart:"x"`; if it is a `time.Time` the series are `TimeSeries`,
+// otherwise they are `ContinuousSeries`. Without an x field the row index is used.
+// Y fields take options after a comma, i.e. `chart:"y,name=Latency,axis=secondary"`; the name defaults to the field name.
+// Numeric fields of any int, uint or float kind are supported.
+func SeriesFromStructs(rows interface{}) ([]Series, error) {
+	rv := reflect.ValueOf(rows)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return nil, errors.New("rows must be a slice of structs")
+	}
+	rowType := rv.Type().Elem()
+	isPointer := rowType.Kind() == reflect.Ptr
+	if isPointer {
+		rowType = rowType.Elem()
+	}
+	if rowType.Kind() != reflect.Struct {
+		return nil, errors.New("rows must be a slice of structs")
+	}
+
+	xfield := -1
+	var yfields []seriesField
+	for index := 0; index < rowType.NumField(); index++ {
+		field := rowType.Field(index)
+		tag := field.Tag.Get(SeriesTag)
+		if len(tag) == 0 || tag == "-" {
+			continue
+		}
+		parts := strings.Split(tag, ",")
+		switch strings.TrimSpace(parts[0]) {
+		case "x":
+			if field.Type != timeType && !isNumericKind(field.Type.Kind()) {
+				return nil, fmt.Errorf("x field %s must be numeric or a time.Time", field.Name)
+			}
+			if field.Type == timeType && len(field.PkgPath) > 0 {
+				return nil, fmt.Errorf("x field %s is a time.Time and must be exported", field.Name)
+			}
+			xfield = index
+		case "y":
+			if !isNumericKind(field.Type.Kind()) {
+				return nil, fmt.Errorf("y field %s must be numeric", field.Name)
+			}
+			yf := seriesField{index: index, name: field.Name}
+			for _, option := range parts[1:] {
+				key, value := seriesTagOption(option)
+				switch key {
+				case "name":
+					yf.name = value
+				case "axis":
+					if value == "secondary" {
+						yf.yaxis = YAxisSecondary
+					} else if value != "primary" {
+						return nil, fmt.Errorf("y field %s has an invalid axis: %s", field.Name, value)
+					}
+				default:
+					return nil, fmt.Errorf("y field %s has an unknown tag option: %s", field.Name, key)
+				}
+			}
+			yfields = append(yfields, yf)
+		default:
+			return nil, fmt.Errorf("field %s has an invalid chart tag: %s", field.Name, tag)
+		}
+	}
+	if len(yfields) == 0 {
+		return nil, errors.New("rows have no fields tagged `chart:\"y\"`")
+	}
+
+	rowCount := rv.Len()
+	isTime := xfield >= 0 && rowType.Field(xfield).Type == timeType
+	xvalues := make([]float64, rowCount)
+	var xtimes []time.Time
+	if isTime {
+		xtimes = make([]time.Time, rowCount)
+	}
+	yvalues := make([][]float64, len(yfields))
+	for index := range yvalues {
+		yvalues[index] = make([]float64, rowCount)
+	}
+
+	for row := 0; row < rowCount; row++ {
+		rowValue := rv.Index(row)
+		if isPointer {
+			if rowValue.IsNil() {
+				return nil, fmt.Errorf("row %d is nil", row)
+			}
+			rowValue = rowValue.Elem()
+		}
+		switch {
+		case isTime:
+			xtimes[row] = rowValue.Field(xfield).Interface().(time.Time)
+		case xfield >= 0:
+			xvalues[row] = numericValue(rowValue.Field(xfield))
+		default:
+			xvalues[row] = float64(row)
+		}
+		for index, yf := range yfields {
+			yvalues[index][row] = numericValue(rowValue.Field(yf.index))
+		}
+	}
+
+	series := make([]Series, len(yfields))
+	for index, yf := range yfields {
+		if isTime {
+			series[index] = TimeSeries{Name: yf.name, YAxis: yf.yaxis, XValues: xtimes, YValues: yvalues[index]}
+		} else {
+			series[index] = ContinuousSeries{Name: yf.name, YAxis: yf.yaxis, XValues: xvalues, YValues: yvalues[index]}
+		}
+	}
+	return series, nil
+}
+
+// SeriesFromMap converts named y values into continuous series, sorted by name, that share the x values.
+// If the x values are empty the value index is used.
+func SeriesFromMap(xvalues []float64, values map[string][]float64) ([]Series, error) {
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	series := make([]Series, 0, len(names))
+	for _, name := range names {
+		yvalues := values[name]
+		xs := xvalues
+		if len(xs) == 0 {
+			xs = make([]float64, len(yvalues))
+			for index := range xs {
+				xs[index] = float64(index)
+			}
+		} else if len(xs) != len(yvalues) {
+			return nil, fmt.Errorf("series %s has %d values for %d x values", name, len(yvalues), len(xs))
+		}
+		series = append(series, ContinuousSeries{Name: name, XValues: xs, YValues: yvalues})
+	}
+	return series, nil
+}
+
+// SeriesFromCSV reads series from csv with a header row; the first column is the x values and each other column
+// is a series named by its header. If every x value parses as a time (RFC3339 or `DefaultDateFormat`) the series
+// are `TimeSeries`, otherwise the x values must be numeric and the series are `ContinuousSeries`.
+func SeriesFromCSV(r io.Reader) ([]Series, error) {
+	records, err := csv.NewReader(r).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) < 2 || len(records[0]) < 2 {
+		return nil, errors.New("csv must have a header row, at least one data row and at least two columns")
+	}
+	header, rows := records[0], records[1:]
+
+	xtimes, isTime := seriesCSVTimes(rows)
+	var xvalues []float64
+	if !isTime {
+		xvalues = make([]float64, len(rows))
+		for index, row := range rows {
+			if xvalues[index], err = strconv.ParseFloat(strings.TrimSpace(row[0]), 64); err != nil {
+				return nil, fmt.Errorf("row %d: invalid x value: %s", index+2, row[0])
+			}
+		}
+	}
+
+	series := make([]Series, 0, len(header)-1)
+	for column := 1; column < len(header); column++ {
+		yvalues := make([]float64, len(rows))
+		for index, row := range rows {
+			if yvalues[index], err = strconv.ParseFloat(strings.TrimSpace(row[column]), 64); err != nil {
+				return nil, fmt.Errorf("row %d: invalid %s value: %s", index+2, header[column], row[column])
+			}
+		}
+		name := strings.TrimSpace(header[column])
+		if isTime {
+			series = append(series, TimeSeries{Name: name, XValues: xtimes, YValues: yvalues})
+		} else {
+			series = append(series, ContinuousSeries{Name: name, XValues: xvalues, YValues: yvalues})
+		}
+	}
+	return series, nil
+}
+
+func seriesCSVTimes(rows [][]string) ([]time.Time, bool) {
+	times := make([]time.Time, len(rows))
+	for index, row := range rows {
+		value := strings.TrimSpace(row[0])
+		t, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			if t, err = time.Parse(DefaultDateFormat, value); err != nil {
+				return nil, false
+			}
+		}
+		times[index] = t
+	}
+	return times, true
+}
+
+func seriesTagOption(option string) (key, value string) {
+	parts := strings.SplitN(strings.TrimSpace(option), "=", 2)
+	key = parts[0]
+	if len(parts) > 1 {
+		value = strings.TrimSpace(parts[1])
+	}
+	return
+}
+
+func isNumericKind(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+func numericValue(v reflect.Value) float64 {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(v.Uint())
+	}
+	return v.Float()
+}
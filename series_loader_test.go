@@ -0,0 +1,136 @@
+package chart
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/blendlabs/go-assert"
+)
+
+type testLoaderRow struct {
+	Timestamp time.Time `chart:"x"`
+	Latency   float64   `chart:"y,name=Latency"`
+	Requests  int       `chart:"y,axis=secondary"`
+	Host      string
+}
+
+func TestSeriesFromStructs(t *testing.T) {
+	assert := assert.New(t)
+
+	start := time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC)
+	rows := []testLoaderRow{
+		{Timestamp: start, Latency: 10.5, Requests: 100, Host: "a"},
+		{Timestamp: start.Add(time.Minute), Latency: 12.0, Requests: 120, Host: "a"},
+	}
+
+	series, err := SeriesFromStructs(rows)
+	assert.Nil(err)
+	assert.Len(series, 2)
+
+	latency, isTimeSeries := series[0].(TimeSeries)
+	assert.True(isTimeSeries)
+	assert.Equal("Latency", latency.Name)
+	assert.Equal(YAxisPrimary, latency.YAxis)
+	assert.Equal(start, latency.XValues[0])
+	assert.Equal([]float64{10.5, 12.0}, latency.YValues)
+
+	requests := series[1].(TimeSeries)
+	assert.Equal("Requests", requests.Name)
+	assert.Equal(YAxisSecondary, requests.YAxis)
+	assert.Equal([]float64{100, 120}, requests.YValues)
+
+	pointers, err := SeriesFromStructs([]*testLoaderRow{&rows[0], &rows[1]})
+	assert.Nil(err)
+	assert.Len(pointers, 2)
+}
+
+func TestSeriesFromStructsContinuous(t *testing.T) {
+	assert := assert.New(t)
+
+	type row struct {
+		Value uint8 `chart:"y"`
+	}
+	series, err := SeriesFromStructs([]row{{Value: 3}, {Value: 5}})
+	assert.Nil(err)
+	assert.Len(series, 1)
+	cs := series[0].(ContinuousSeries)
+	assert.Equal([]float64{0, 1}, cs.XValues)
+	assert.Equal([]float64{3, 5}, cs.YValues)
+}
+
+func TestSeriesFromStructsErrors(t *testing.T) {
+	assert := assert.New(t)
+
+	_, err := SeriesFromStructs(testLoaderRow{})
+	assert.NotNil(err)
+
+	_, err = SeriesFromStructs([]int{1, 2})
+	assert.NotNil(err)
+
+	type noY struct {
+		X float64 `chart:"x"`
+	}
+	_, err = SeriesFromStructs([]noY{{}})
+	assert.NotNil(err)
+
+	type badY struct {
+		Y string `chart:"y"`
+	}
+	_, err = SeriesFromStructs([]badY{{}})
+	assert.NotNil(err)
+
+	type badOption struct {
+		Y float64 `chart:"y,color=red"`
+	}
+	_, err = SeriesFromStructs([]badOption{{}})
+	assert.NotNil(err)
+
+	type unexportedTime struct {
+		at time.Time `chart:"x"`
+		Y  float64   `chart:"y"`
+	}
+	_, err = SeriesFromStructs([]unexportedTime{{}})
+	assert.NotNil(err)
+}
+
+func TestSeriesFromMap(t *testing.T) {
+	assert := assert.New(t)
+
+	series, err := SeriesFromMap(nil, map[string][]float64{
+		"b": {1, 2, 3},
+		"a": {3, 2, 1},
+	})
+	assert.Nil(err)
+	assert.Len(series, 2)
+	assert.Equal("a", series[0].GetName())
+	assert.Equal([]float64{0, 1, 2}, series[0].(ContinuousSeries).XValues)
+
+	_, err = SeriesFromMap([]float64{1, 2}, map[string][]float64{"a": {1, 2, 3}})
+	assert.NotNil(err)
+
+	series, err = SeriesFromMap(nil, map[string][]float64{"a": {}})
+	assert.Nil(err)
+	assert.Empty(series[0].(ContinuousSeries).XValues)
+}
+
+func TestSeriesFromCSV(t *testing.T) {
+	assert := assert.New(t)
+
+	series, err := SeriesFromCSV(strings.NewReader("day,p50,p99\n2017-01-01,1.5,3\n2017-01-02,2,4.5\n"))
+	assert.Nil(err)
+	assert.Len(series, 2)
+	p99 := series[1].(TimeSeries)
+	assert.Equal("p99", p99.Name)
+	assert.Equal(time.Date(2017, 1, 2, 0, 0, 0, 0, time.UTC), p99.XValues[1])
+	assert.Equal([]float64{3, 4.5}, p99.YValues)
+
+	series, err = SeriesFromCSV(strings.NewReader("x,y\n1,2\n2,4\n"))
+	assert.Nil(err)
+	assert.Equal([]float64{1, 2}, series[0].(ContinuousSeries).XValues)
+
+	_, err = SeriesFromCSV(strings.NewReader("x,y\n1,abc\n"))
+	assert.NotNil(err)
+	_, err = SeriesFromCSV(strings.NewReader("x,y\n"))
+	assert.NotNil(err)
+}
@@ -0,0 +1,168 @@
+// Package seriesutil computes summary statistics over the values of chart series,
+// i.e. for titles or annotations like "p99 = 42ms".
+package seriesutil
+
+import (
+	"math"
+	"sort"
+)
+
+// ValueProvider is a type that produces values; every `chart.ValueProvider` is one.
+type ValueProvider interface {
+	Len() int
+	GetValue(index int) (float64, float64)
+}
+
+// NaNPolicy is how NaN (i.e. missing) values are treated.
+type NaNPolicy int
+
+const (
+	// NaNSkip leaves NaN values out of the statistics.
+	NaNSkip NaNPolicy = 0
+	// NaNPropagate makes every statistic NaN if any value is NaN.
+	NaNPropagate NaNPolicy = 1
+	// NaNZero treats NaN values as zero.
+	NaNZero NaNPolicy = 2
+)
+
+// Values returns the y values of a value provider with the NaN policy applied.
+// If the policy is `NaNPropagate` and there is a NaN value, `ok` is false.
+func Values(vp ValueProvider, policy NaNPolicy) (values []float64, ok bool) {
+	values = make([]float64, 0, vp.Len())
+	for index := 0; index < vp.Len(); index++ {
+		_, y := vp.GetValue(index)
+		if math.IsNaN(y) {
+			switch policy {
+			case NaNPropagate:
+				return nil, false
+			case NaNZero:
+				y = 0
+			default:
+				continue
+			}
+		}
+		values = append(values, y)
+	}
+	return values, true
+}
+
+// Stats are the summary statistics of a set of values.
+// Every statistic is NaN if there are no values (or a NaN value under `NaNPropagate`).
+type Stats struct {
+	Count  int
+	Sum    float64
+	Min    float64
+	Max    float64
+	Mean   float64
+	StdDev float64
+
+	vp     ValueProvider
+	policy NaNPolicy
+	sorted *[]float64
+}
+
+// Summarize returns the summary statistics of the y values of a value provider, in a single pass over the values.
+// The values are only collected and sorted once a percentile (or the median) is asked for.
+func Summarize(vp ValueProvider, policy NaNPolicy) Stats {
+	stats := summarize(vp, policy)
+	if stats.Count > 0 {
+		stats.vp, stats.policy, stats.sorted = vp, policy, new([]float64)
+	}
+	return stats
+}
+
+// summarize computes the running statistics of the values, with Welford's method for the deviation.
+func summarize(vp ValueProvider, policy NaNPolicy) Stats {
+	nan := math.NaN()
+	stats := Stats{Min: math.MaxFloat64, Max: -math.MaxFloat64}
+	var m2 float64
+	for index := 0; index < vp.Len(); index++ {
+		_, y := vp.GetValue(index)
+		if math.IsNaN(y) {
+			switch policy {
+			case NaNPropagate:
+				return Stats{Sum: nan, Min: nan, Max: nan, Mean: nan, StdDev: nan}
+			case NaNZero:
+				y = 0
+			default:
+				continue
+			}
+		}
+		stats.Count++
+		stats.Sum += y
+		stats.Min = math.Min(stats.Min, y)
+		stats.Max = math.Max(stats.Max, y)
+		delta := y - stats.Mean
+		stats.Mean += delta / float64(stats.Count)
+		m2 += delta * (y - stats.Mean)
+	}
+	if stats.Count == 0 {
+		return Stats{Sum: nan, Min: nan, Max: nan, Mean: nan, StdDev: nan}
+	}
+	stats.Mean = stats.Sum / float64(stats.Count)
+	stats.StdDev = math.Sqrt(m2 / float64(stats.Count))
+	return stats
+}
+
+// getSorted returns the sorted values, collecting them on first use.
+func (s Stats) getSorted() []float64 {
+	if s.sorted == nil {
+		return nil
+	}
+	if *s.sorted == nil {
+		values, _ := Values(s.vp, s.policy)
+		sort.Float64s(values)
+		*s.sorted = values
+	}
+	return *s.sorted
+}
+
+// Percentile returns the p-th percentile (on the interval [0,100]) of the values,
+// interpolating linearly between the closest ranks.
+func (s Stats) Percentile(p float64) float64 {
+	sorted := s.getSorted()
+	if len(sorted) == 0 || math.IsNaN(p) {
+		return math.NaN()
+	}
+	if p <= 0 {
+		return sorted[0]
+	}
+	if p >= 100 {
+		return sorted[len(sorted)-1]
+	}
+
+	rank := (p / 100) * float64(len(sorted)-1)
+	lower := int(math.Floor(rank))
+	upper := int(math.Ceil(rank))
+	return sorted[lower] + (sorted[upper]-sorted[lower])*(rank-float64(lower))
+}
+
+// Median returns the 50th percentile of the values.
+func (s Stats) Median() float64 {
+	return s.Percentile(50)
+}
+
+// Min returns the minimum y value of a value provider.
+func Min(vp ValueProvider, policy NaNPolicy) float64 {
+	return summarize(vp, policy).Min
+}
+
+// Max returns the maximum y value of a value provider.
+func Max(vp ValueProvider, policy NaNPolicy) float64 {
+	return summarize(vp, policy).Max
+}
+
+// Mean returns the mean of the y values of a value provider.
+func Mean(vp ValueProvider, policy NaNPolicy) float64 {
+	return summarize(vp, policy).Mean
+}
+
+// StdDev returns the (population) standard deviation of the y values of a value provider.
+func StdDev(vp ValueProvider, policy NaNPolicy) float64 {
+	return summarize(vp, policy).StdDev
+}
+
+// Percentile returns the p-th percentile (on the interval [0,100]) of the y values of a value provider.
+func Percentile(vp ValueProvider, p float64, policy NaNPolicy) float64 {
+	return Summarize(vp, policy).Percentile(p)
+}
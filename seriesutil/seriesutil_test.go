@@ -0,0 +1,80 @@
+package seriesutil
+
+import (
+	"math"
+	"testing"
+
+	assert "github.com/blendlabs/go-assert"
+)
+
+type values []float64
+
+func (v values) Len() int {
+	return len(v)
+}
+
+func (v values) GetValue(index int) (float64, float64) {
+	return float64(index), v[index]
+}
+
+func TestSummarize(t *testing.T) {
+	assert := assert.New(t)
+
+	stats := Summarize(values{4, 1, 3, 2, 5}, NaNSkip)
+	assert.Equal(5, stats.Count)
+	assert.Equal(15.0, stats.Sum)
+	assert.Equal(1.0, stats.Min)
+	assert.Equal(5.0, stats.Max)
+	assert.Equal(3.0, stats.Mean)
+	assert.InDelta(math.Sqrt(2), stats.StdDev, 0.0000001)
+	assert.Equal(3.0, stats.Median())
+	assert.Equal(1.0, stats.Percentile(0))
+	assert.Equal(5.0, stats.Percentile(100))
+	assert.InDelta(4.96, stats.Percentile(99), 0.0000001)
+	assert.InDelta(1.4, stats.Percentile(10), 0.0000001)
+}
+
+func TestSummarizeNaNPolicy(t *testing.T) {
+	assert := assert.New(t)
+
+	vs := values{1, math.NaN(), 3}
+	assert.Equal(2.0, Mean(vs, NaNSkip))
+	assert.InDelta(4.0/3.0, Mean(vs, NaNZero), 0.0000001)
+	assert.Equal(0.0, Min(vs, NaNZero))
+	assert.True(math.IsNaN(Mean(vs, NaNPropagate)))
+	assert.True(math.IsNaN(Percentile(vs, 50, NaNPropagate)))
+	assert.Equal(3.0, Max(vs, NaNSkip))
+	assert.Equal(1.0, StdDev(vs, NaNSkip))
+
+	empty := Summarize(values{}, NaNSkip)
+	assert.Zero(empty.Count)
+	assert.True(math.IsNaN(empty.Min))
+	assert.True(math.IsNaN(empty.Percentile(50)))
+}
+
+type countingValues struct {
+	values
+	calls *int
+}
+
+func (cv countingValues) GetValue(index int) (float64, float64) {
+	*cv.calls++
+	return cv.values.GetValue(index)
+}
+
+func TestSummarizeSortsLazily(t *testing.T) {
+	assert := assert.New(t)
+
+	var calls int
+	vs := countingValues{values: values{4, 1, 3, 2, 5}, calls: &calls}
+	assert.Equal(1.0, Min(vs, NaNSkip))
+	assert.Equal(5, calls, "min is a single pass")
+
+	calls = 0
+	stats := Summarize(vs, NaNSkip)
+	assert.Equal(5, calls)
+	assert.Equal(3.0, stats.Median())
+	assert.Equal(10, calls, "the values are collected for the first percentile")
+	assert.Equal(5.0, stats.Percentile(100))
+	assert.Equal(10, calls, "and sorted only once")
+}
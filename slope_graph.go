@@ -0,0 +1,395 @@
+package chart
+
+import (
+	"errors"
+	"io"
+	"sort"
+
+	"github.com/golang/freetype/truetype"
+)
+
+const (
+	// DefaultSlopeGraphLabelMargin is the default distance between the lines and the labels of a slope graph.
+	DefaultSlopeGraphLabelMargin = 8
+	// DefaultSlopeGraphLabelSpacing is the default minimum vertical space between the labels on the same side.
+	DefaultSlopeGraphLabelSpacing = 2
+	// DefaultSlopeGraphDotRadius is the default radius of the dots at the ends of each slope.
+	DefaultSlopeGraphDotRadius = 3.0
+	// DefaultSlopeGraphLineWidth is the default width of the slopes.
+	DefaultSlopeGraphLineWidth = 2.0
+)
+
+// Slope is a single category of a slope graph, with its value at the left and right time points.
+type Slope struct {
+	Label string
+	Style Style
+	Left  float64
+	Right float64
+}
+
+// SlopeGraph is a chart that compares the values of categories at (exactly) two time points,
+// connecting the values of each category with a line labeled on both ends.
+type SlopeGraph struct {
+	Title      string
+	TitleStyle Style
+
+	// Description is a caption (or alt text) for the chart; it is embedded in the output
+	// where the format supports it, i.e. as the svg `<desc>` or a png text chunk.
+	Description string
+
+	Width  int
+	Height int
+	DPI    float64
+
+	Background Style
+	Canvas     Style
+
+	// LeftHeading and RightHeading name the time points, i.e. `2015` and `2016`.
+	LeftHeading  string
+	RightHeading string
+	HeadingStyle Style
+
+	// SlopeStyle is the style applied to every slope, under the style of the slope itself.
+	SlopeStyle     Style
+	ValueFormatter ValueFormatter
+
+	Font        *truetype.Font
+	defaultFont *truetype.Font
+
+	Slopes   []Slope
+	Elements []Renderable
+}
+
+// GetDPI returns the dpi for the chart.
+func (sg SlopeGraph) GetDPI(defaults ...float64) float64 {
+	if sg.DPI == 0 {
+		if len(defaults) > 0 {
+			return defaults[0]
+		}
+		return DefaultDPI
+	}
+	return sg.DPI
+}
+
+// GetFont returns the text font.
+func (sg SlopeGraph) GetFont() *truetype.Font {
+	if sg.Font == nil {
+		return sg.defaultFont
+	}
+	return sg.Font
+}
+
+// GetWidth returns the chart width or the default value.
+func (sg SlopeGraph) GetWidth() int {
+	if sg.Width == 0 {
+		return DefaultChartWidth
+	}
+	return sg.Width
+}
+
+// GetHeight returns the chart height or the default value.
+func (sg SlopeGraph) GetHeight() int {
+	if sg.Height == 0 {
+		return DefaultChartHeight
+	}
+	return sg.Height
+}
+
+// GetValueFormatter returns the value formatter or a default.
+func (sg SlopeGraph) GetValueFormatter() ValueFormatter {
+	if sg.ValueFormatter == nil {
+		return FloatValueFormatter
+	}
+	return sg.ValueFormatter
+}
+
+// Render renders the chart with the given renderer to the given io.Writer.
+func (sg SlopeGraph) Render(rp RendererProvider, w io.Writer) error {
+	if len(sg.Slopes) == 0 {
+		return errors.New("please provide at least one slope")
+	}
+
+	r, err := rp(sg.GetWidth(), sg.GetHeight())
+	if err != nil {
+		return err
+	}
+	if dr, isDescriptionRenderer := r.(DescriptionRenderer); isDescriptionRenderer && len(sg.Description) > 0 {
+		dr.SetDescription(sg.Description)
+	}
+
+	if sg.Font == nil {
+		defaultFont, err := GetDefaultFont()
+		if err != nil {
+			return err
+		}
+		sg.defaultFont = defaultFont
+	}
+	r.SetDPI(sg.GetDPI(DefaultDPI))
+
+	canvasBox := sg.getTitleAdjustedCanvasBox(r, sg.Box())
+	if canvasBox.Bottom-canvasBox.Top <= 0 {
+		return errors.New("slope graph is too small for its title")
+	}
+
+	sg.drawBackground(r)
+	sg.drawCanvas(r, canvasBox)
+	canvasBox = sg.drawHeadings(r, canvasBox)
+	sg.drawSlopes(r, canvasBox)
+	sg.drawTitle(r)
+	for _, a := range sg.Elements {
+		a(r, canvasBox, sg.styleDefaultsElements())
+	}
+
+	return r.Save(w)
+}
+
+func (sg SlopeGraph) drawBackground(r Renderer) {
+	Draw.Box(r, Box{
+		Right:  sg.GetWidth(),
+		Bottom: sg.GetHeight(),
+	}, sg.getBackgroundStyle())
+}
+
+func (sg SlopeGraph) drawCanvas(r Renderer, canvasBox Box) {
+	Draw.Box(r, canvasBox, sg.getCanvasStyle())
+}
+
+func (sg SlopeGraph) drawTitle(r Renderer) {
+	if len(sg.Title) > 0 && sg.TitleStyle.Show {
+		Draw.TextWithin(r, sg.Title, sg.Box(), sg.styleDefaultsTitle())
+	}
+}
+
+// getTitleAdjustedCanvasBox pushes the top of the canvas below the title, if shown.
+func (sg SlopeGraph) getTitleAdjustedCanvasBox(r Renderer, canvasBox Box) Box {
+	if len(sg.Title) == 0 || !sg.TitleStyle.Show {
+		return canvasBox
+	}
+	style := sg.styleDefaultsTitle()
+	style.GetTextOptions().WriteToRenderer(r)
+	defer r.ResetStyle()
+
+	lines := Text.WrapFit(r, sg.Title, canvasBox.Width(), style)
+	canvasBox.Top += Text.MeasureLines(r, lines, style).Height() + DefaultTitleBottom
+	return canvasBox
+}
+
+// getLayout returns the x coordinates of the ends of the slopes and the widest label on either side.
+func (sg SlopeGraph) getLayout(r Renderer, canvasBox Box, leftLabels, rightLabels []string) (left, right int) {
+	var leftWidth, rightWidth int
+	for index := range sg.Slopes {
+		style := sg.getSlopeStyle(index)
+		leftWidth = Math.MaxInt(leftWidth, Draw.MeasureText(r, leftLabels[index], style).Width())
+		rightWidth = Math.MaxInt(rightWidth, Draw.MeasureText(r, rightLabels[index], style).Width())
+	}
+	left = canvasBox.Left + leftWidth + DefaultSlopeGraphLabelMargin
+	right = canvasBox.Right - rightWidth - DefaultSlopeGraphLabelMargin
+	return
+}
+
+// drawHeadings draws the headings above either end of the slopes and returns the canvas below them.
+func (sg SlopeGraph) drawHeadings(r Renderer, canvasBox Box) Box {
+	if len(sg.LeftHeading) == 0 && len(sg.RightHeading) == 0 {
+		return canvasBox
+	}
+	leftLabels, rightLabels := sg.getLabels()
+	left, right := sg.getLayout(r, canvasBox, leftLabels, rightLabels)
+
+	style := sg.styleDefaultsHeading()
+	var height int
+	if len(sg.LeftHeading) > 0 {
+		tb := Draw.MeasureText(r, sg.LeftHeading, style)
+		Draw.Text(r, sg.LeftHeading, left-(tb.Width()>>1), canvasBox.Top+tb.Height(), style)
+		height = Math.MaxInt(height, tb.Height())
+	}
+	if len(sg.RightHeading) > 0 {
+		tb := Draw.MeasureText(r, sg.RightHeading, style)
+		Draw.Text(r, sg.RightHeading, right-(tb.Width()>>1), canvasBox.Top+tb.Height(), style)
+		height = Math.MaxInt(height, tb.Height())
+	}
+	canvasBox.Top += height + DefaultSlopeGraphLabelMargin
+	return canvasBox
+}
+
+func (sg SlopeGraph) drawSlopes(r Renderer, canvasBox Box) {
+	leftLabels, rightLabels := sg.getLabels()
+	left, right := sg.getLayout(r, canvasBox, leftLabels, rightLabels)
+
+	var textHeight int
+	for index := range sg.Slopes {
+		textHeight = Math.MaxInt(textHeight, Draw.MeasureText(r, leftLabels[index], sg.getSlopeStyle(index)).Height())
+	}
+
+	// keep room for half a label above the highest value and below the lowest value.
+	top := canvasBox.Top + (textHeight >> 1)
+	bottom := canvasBox.Bottom - (textHeight >> 1)
+	yrange := sg.getRange(bottom - top)
+
+	leftYs := make([]int, len(sg.Slopes))
+	rightYs := make([]int, len(sg.Slopes))
+	for index, s := range sg.Slopes {
+		leftYs[index] = bottom - yrange.Translate(s.Left)
+		rightYs[index] = bottom - yrange.Translate(s.Right)
+	}
+	leftLabelYs := slopeGraphSpreadLabels(leftYs, textHeight+DefaultSlopeGraphLabelSpacing, top, bottom)
+	rightLabelYs := slopeGraphSpreadLabels(rightYs, textHeight+DefaultSlopeGraphLabelSpacing, top, bottom)
+
+	for index := range sg.Slopes {
+		style := sg.getSlopeStyle(index)
+
+		style.GetStrokeOptions().WriteToRenderer(r)
+		r.MoveTo(left, leftYs[index])
+		r.LineTo(right, rightYs[index])
+		r.Stroke()
+
+		style.GetFillAndStrokeOptions().WriteToRenderer(r)
+		r.Circle(DefaultSlopeGraphDotRadius, left, leftYs[index])
+		r.Circle(DefaultSlopeGraphDotRadius, right, rightYs[index])
+
+		tb := Draw.MeasureText(r, leftLabels[index], style)
+		Draw.Text(r, leftLabels[index], left-DefaultSlopeGraphLabelMargin-tb.Width(), leftLabelYs[index]+(tb.Height()>>1), style)
+		tb = Draw.MeasureText(r, rightLabels[index], style)
+		Draw.Text(r, rightLabels[index], right+DefaultSlopeGraphLabelMargin, rightLabelYs[index]+(tb.Height()>>1), style)
+	}
+}
+
+// getLabels returns the labels drawn to the left and to the right of each slope.
+func (sg SlopeGraph) getLabels() (left, right []string) {
+	vf := sg.GetValueFormatter()
+	left = make([]string, len(sg.Slopes))
+	right = make([]string, len(sg.Slopes))
+	for index, s := range sg.Slopes {
+		left[index] = vf(s.Left)
+		right[index] = vf(s.Right)
+		if len(s.Label) > 0 {
+			left[index] = s.Label + " " + left[index]
+			right[index] = right[index] + " " + s.Label
+		}
+	}
+	return
+}
+
+// getRange returns the range shared by both ends of the slopes.
+func (sg SlopeGraph) getRange(domain int) *ContinuousRange {
+	yrange := &ContinuousRange{Min: sg.Slopes[0].Left, Max: sg.Slopes[0].Left, Domain: domain}
+	for _, s := range sg.Slopes {
+		yrange.Min, _ = Math.MinAndMax(yrange.Min, s.Left, s.Right)
+		_, yrange.Max = Math.MinAndMax(yrange.Max, s.Left, s.Right)
+	}
+	if yrange.Min == yrange.Max {
+		yrange.Min = yrange.Min - 1
+		yrange.Max = yrange.Max + 1
+	}
+	return yrange
+}
+
+// slopeGraphSpreadLabels returns the label positions for the given (center) positions
+// such that labels are at least spacing apart, while staying within the top and bottom bounds if possible.
+func slopeGraphSpreadLabels(ys []int, spacing, top, bottom int) []int {
+	order := make([]int, len(ys))
+	for index := range order {
+		order[index] = index
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return ys[order[i]] < ys[order[j]]
+	})
+
+	output := make([]int, len(ys))
+	for position, index := range order {
+		output[index] = ys[index]
+		if position > 0 {
+			output[index] = Math.MaxInt(output[index], output[order[position-1]]+spacing)
+		}
+	}
+
+	// push everything back up if the labels ran past the bottom.
+	for position := len(order) - 1; position >= 0; position-- {
+		index := order[position]
+		limit := bottom
+		if position < len(order)-1 {
+			limit = output[order[position+1]] - spacing
+		}
+		if output[index] > limit {
+			output[index] = Math.MaxInt(limit, top)
+		}
+	}
+	return output
+}
+
+func (sg SlopeGraph) getBackgroundStyle() Style {
+	return sg.Background.InheritFrom(sg.styleDefaultsBackground())
+}
+
+func (sg SlopeGraph) getCanvasStyle() Style {
+	return sg.Canvas.InheritFrom(sg.styleDefaultsCanvas())
+}
+
+func (sg SlopeGraph) getSlopeStyle(index int) Style {
+	return sg.Slopes[index].Style.InheritFrom(sg.SlopeStyle.InheritFrom(sg.styleDefaultsSlope(index)))
+}
+
+func (sg SlopeGraph) styleDefaultsBackground() Style {
+	return Style{
+		FillColor:   DefaultBackgroundColor,
+		StrokeColor: DefaultBackgroundStrokeColor,
+		StrokeWidth: DefaultStrokeWidth,
+	}
+}
+
+func (sg SlopeGraph) styleDefaultsCanvas() Style {
+	return Style{
+		FillColor:   DefaultCanvasColor,
+		StrokeColor: DefaultCanvasStrokeColor,
+		StrokeWidth: DefaultStrokeWidth,
+	}
+}
+
+func (sg SlopeGraph) styleDefaultsSlope(index int) Style {
+	color := GetDefaultColor(index)
+	return Style{
+		StrokeColor: color,
+		StrokeWidth: DefaultSlopeGraphLineWidth,
+		FillColor:   color,
+		FontColor:   DefaultTextColor,
+		FontSize:    DefaultFontSize,
+		Font:        sg.GetFont(),
+	}
+}
+
+func (sg SlopeGraph) styleDefaultsHeading() Style {
+	return sg.HeadingStyle.InheritFrom(Style{
+		FontColor: DefaultTextColor,
+		FontSize:  DefaultAxisFontSize,
+		Font:      sg.GetFont(),
+	})
+}
+
+func (sg SlopeGraph) styleDefaultsTitle() Style {
+	return sg.TitleStyle.InheritFrom(Style{
+		FontColor:           DefaultTextColor,
+		Font:                sg.GetFont(),
+		FontSize:            DefaultTitleFontSize,
+		TextHorizontalAlign: TextHorizontalAlignCenter,
+		TextVerticalAlign:   TextVerticalAlignTop,
+		TextWrap:            TextWrapWord,
+	})
+}
+
+func (sg SlopeGraph) styleDefaultsElements() Style {
+	return Style{
+		Font: sg.GetFont(),
+	}
+}
+
+// Box returns the chart bounds as a box.
+func (sg SlopeGraph) Box() Box {
+	dpr := sg.Background.Padding.GetRight(DefaultBackgroundPadding.Right)
+	dpb := sg.Background.Padding.GetBottom(DefaultBackgroundPadding.Bottom)
+
+	return Box{
+		Top:    sg.Background.Padding.GetTop(DefaultBackgroundPadding.Top),
+		Left:   sg.Background.Padding.GetLeft(DefaultBackgroundPadding.Left),
+		Right:  sg.GetWidth() - dpr,
+		Bottom: sg.GetHeight() - dpb,
+	}
+}
@@ -0,0 +1,45 @@
+package chart
+
+import (
+	"bytes"
+	"testing"
+
+	assert "github.com/blendlabs/go-assert"
+)
+
+func TestSlopeGraphRender(t *testing.T) {
+	assert := assert.New(t)
+
+	sg := SlopeGraph{
+		Width:        400,
+		Height:       300,
+		LeftHeading:  "2015",
+		RightHeading: "2016",
+		Slopes: []Slope{
+			{Label: "North", Left: 10, Right: 14},
+			{Label: "South", Left: 10.1, Right: 8},
+		},
+	}
+
+	buffer := bytes.NewBuffer([]byte{})
+	assert.Nil(sg.Render(PNG, buffer))
+	assert.NotZero(buffer.Len())
+
+	collector := &DrawTraceWriter{}
+	assert.Nil(sg.Render(Recording, collector))
+	texts := collector.Trace().Texts()
+	assert.Equal([]string{"2015", "2016", "North 10.00", "14.00 North", "South 10.10", "8.00 South"}, texts)
+}
+
+func TestSlopeGraphRenderEmpty(t *testing.T) {
+	assert := assert.New(t)
+	assert.NotNil(SlopeGraph{}.Render(PNG, bytes.NewBuffer([]byte{})))
+}
+
+func TestSlopeGraphSpreadLabels(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal([]int{50, 10, 30}, slopeGraphSpreadLabels([]int{50, 10, 30}, 10, 0, 100))
+	assert.Equal([]int{50, 60, 70}, slopeGraphSpreadLabels([]int{50, 50, 50}, 10, 0, 100))
+	assert.Equal([]int{80, 90, 100}, slopeGraphSpreadLabels([]int{100, 100, 100}, 10, 0, 100))
+}
@@ -48,6 +48,16 @@ func (sma SMASeries) GetPeriod(defaults ...int) int {
 	return sma.Period
 }
 
+// GetInnerSeries implements `DerivedSeries`.
+func (sma SMASeries) GetInnerSeries() ValueProvider {
+	return sma.InnerSeries
+}
+
+// GetDerivation implements `DerivedSeries`.
+func (sma SMASeries) GetDerivation() string {
+	return fmt.Sprintf("SMA(%d)", sma.GetPeriod())
+}
+
 // GetValue gets a value at a given index.
 func (sma SMASeries) GetValue(index int) (x, y float64) {
 	if sma.InnerSeries == nil {
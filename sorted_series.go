@@ -0,0 +1,171 @@
+package chart
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// DuplicateX is how a sorted series merges values that share an x value.
+type DuplicateX int
+
+const (
+	// DuplicateXKeep keeps every value, in their original order among themselves.
+	DuplicateXKeep DuplicateX = 0
+	// DuplicateXLast keeps the last of the values.
+	DuplicateXLast DuplicateX = 1
+	// DuplicateXFirst keeps the first of the values.
+	DuplicateXFirst DuplicateX = 2
+	// DuplicateXMean merges the values into their mean.
+	DuplicateXMean DuplicateX = 3
+	// DuplicateXSum merges the values into their sum.
+	DuplicateXSum DuplicateX = 4
+)
+
+// SortedSeries is a series that sorts its inner series by x value (and merges duplicate x values) at render time,
+// since unsorted or duplicated data draws as zig-zags. The inner series is read once, on first use.
+type SortedSeries struct {
+	Name  string
+	Style Style
+	YAxis YAxisType
+
+	InnerSeries ValueProvider
+	Duplicates  DuplicateX
+
+	xvalues []float64
+	yvalues []float64
+}
+
+// GetName returns the name of the time series.
+func (ss SortedSeries) GetName() string {
+	return ss.Name
+}
+
+// GetStyle returns the line style.
+func (ss SortedSeries) GetStyle() Style {
+	return ss.Style
+}
+
+// GetYAxis returns which YAxis the series draws on.
+func (ss SortedSeries) GetYAxis() YAxisType {
+	return ss.YAxis
+}
+
+// Len returns the number of elements in the series.
+func (ss *SortedSeries) Len() int {
+	ss.ensureSorted()
+	return len(ss.xvalues)
+}
+
+// GetValue gets a value at a given index.
+func (ss *SortedSeries) GetValue(index int) (x, y float64) {
+	ss.ensureSorted()
+	return ss.xvalues[index], ss.yvalues[index]
+}
+
+// GetLastValue gets the value with the largest x value.
+func (ss *SortedSeries) GetLastValue() (x, y float64) {
+	ss.ensureSorted()
+	if len(ss.xvalues) == 0 {
+		return
+	}
+	return ss.xvalues[len(ss.xvalues)-1], ss.yvalues[len(ss.yvalues)-1]
+}
+
+// GetValueFormatters returns the value formatters of the inner series, if it provides them.
+func (ss *SortedSeries) GetValueFormatters() (x, y ValueFormatter) {
+	if vfp, isVfp := ss.InnerSeries.(ValueFormatterProvider); isVfp {
+		return vfp.GetValueFormatters()
+	}
+	return FloatValueFormatter, FloatValueFormatter
+}
+
+// Render renders the series.
+func (ss *SortedSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
+	style := ss.Style.InheritFrom(defaults)
+	Draw.LineSeries(r, canvasBox, xrange, yrange, style, ss)
+}
+
+// Validate validates the series.
+func (ss *SortedSeries) Validate() error {
+	if ss.InnerSeries == nil {
+		return fmt.Errorf("sorted series requires InnerSeries to be set")
+	}
+	return nil
+}
+
+func (ss *SortedSeries) ensureSorted() {
+	if ss.xvalues != nil {
+		return
+	}
+
+	length := ss.InnerSeries.Len()
+	order := make([]int, length)
+	xvalues := make([]float64, length)
+	yvalues := make([]float64, length)
+	for index := 0; index < length; index++ {
+		order[index] = index
+		xvalues[index], yvalues[index] = ss.InnerSeries.GetValue(index)
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return xvalues[order[i]] < xvalues[order[j]]
+	})
+
+	ss.xvalues = make([]float64, 0, length)
+	ss.yvalues = make([]float64, 0, length)
+	for start := 0; start < length; {
+		end := start + 1
+		if ss.Duplicates != DuplicateXKeep {
+			for end < length && xvalues[order[end]] == xvalues[order[start]] {
+				end++
+			}
+		}
+		ss.xvalues = append(ss.xvalues, xvalues[order[start]])
+		ss.yvalues = append(ss.yvalues, ss.merge(yvalues, order[start:end]))
+		start = end
+	}
+}
+
+// merge merges the y values of a run of indexes that share an x value.
+func (ss *SortedSeries) merge(yvalues []float64, indexes []int) float64 {
+	switch ss.Duplicates {
+	case DuplicateXLast:
+		return yvalues[indexes[len(indexes)-1]]
+	case DuplicateXMean, DuplicateXSum:
+		var sum float64
+		for _, index := range indexes {
+			sum += yvalues[index]
+		}
+		if ss.Duplicates == DuplicateXMean {
+			return sum / float64(len(indexes))
+		}
+		return sum
+	}
+	return yvalues[indexes[0]]
+}
+
+// CheckXOrder returns an error describing the first x value of a value provider that is out of order or
+// duplicated (or NaN), i.e. to catch data that would draw as zig-zags before it is charted.
+func CheckXOrder(vp ValueProvider) error {
+	if vp.Len() == 0 {
+		return nil
+	}
+	previous, _ := vp.GetValue(0)
+	for index := 0; index < vp.Len(); index++ {
+		x, _ := vp.GetValue(index)
+		if math.IsNaN(x) {
+			return fmt.Errorf("x value at index %d is NaN", index)
+		}
+		if index == 0 {
+			continue
+		}
+		if x < previous {
+			return fmt.Errorf("x value at index %d (%v) is less than the previous x value (%v); use a `SortedSeries`", index, x, previous)
+		}
+		if x == previous {
+			return fmt.Errorf("x value at index %d (%v) is a duplicate of the previous x value; use a `SortedSeries` to merge duplicates", index, x)
+		}
+		previous = x
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package chart
+
+import (
+	"bytes"
+	"testing"
+
+	assert "github.com/blendlabs/go-assert"
+)
+
+func TestSortedSeries(t *testing.T) {
+	assert := assert.New(t)
+
+	inner := ContinuousSeries{
+		XValues: []float64{3, 1, 2, 1, 3},
+		YValues: []float64{30, 10, 20, 11, 31},
+	}
+
+	ss := &SortedSeries{InnerSeries: inner}
+	assert.Nil(ss.Validate())
+	assert.Equal(5, ss.Len())
+	x, y := ss.GetValue(1)
+	assert.Equal(1.0, x)
+	assert.Equal(11.0, y, "duplicates keep their original order")
+	assert.NotNil(CheckXOrder(ss), "kept duplicates are reported")
+
+	expected := map[DuplicateX][]float64{
+		DuplicateXLast:  {11, 20, 31},
+		DuplicateXFirst: {10, 20, 30},
+		DuplicateXMean:  {10.5, 20, 30.5},
+		DuplicateXSum:   {21, 20, 61},
+	}
+	for duplicates, yvalues := range expected {
+		ss = &SortedSeries{InnerSeries: inner, Duplicates: duplicates}
+		assert.Equal(3, ss.Len())
+		for index, expectedY := range yvalues {
+			x, y := ss.GetValue(index)
+			assert.Equal(float64(index+1), x)
+			assert.Equal(expectedY, y)
+		}
+		assert.Nil(CheckXOrder(ss))
+	}
+
+	ss = &SortedSeries{InnerSeries: inner, Duplicates: DuplicateXSum}
+	lx, ly := ss.GetLastValue()
+	assert.Equal(3.0, lx)
+	assert.Equal(61.0, ly)
+
+	c := Chart{Series: []Series{ss}}
+	assert.Nil(c.Render(PNG, bytes.NewBuffer(nil)))
+}
+
+func TestCheckXOrder(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Nil(CheckXOrder(ContinuousSeries{}))
+	assert.Nil(CheckXOrder(ContinuousSeries{XValues: []float64{1, 2, 3}, YValues: []float64{1, 2, 3}}))
+	assert.NotNil(CheckXOrder(ContinuousSeries{XValues: []float64{1, 3, 2}, YValues: []float64{1, 2, 3}}))
+	assert.NotNil(CheckXOrder(ContinuousSeries{XValues: []float64{1, 1}, YValues: []float64{1, 2}}))
+}
@@ -24,11 +24,26 @@ func (sb StackedBar) GetWidth() int {
 	return sb.Width
 }
 
+// GetTotal returns the total of the bar's (positive) values.
+func (sb StackedBar) GetTotal() float64 {
+	var total float64
+	for _, v := range sb.Values {
+		if v.Value > 0 {
+			total += v.Value
+		}
+	}
+	return total
+}
+
 // StackedBarChart is a chart that draws sections of a bar based on percentages.
 type StackedBarChart struct {
 	Title      string
 	TitleStyle Style
 
+	// Description is a caption (or alt text) for the chart; it is embedded in the output
+	// where the format supports it, i.e. as the svg `<desc>` or a png text chunk.
+	Description string
+
 	Width  int
 	Height int
 	DPI    float64
@@ -41,6 +56,23 @@ type StackedBarChart struct {
 
 	BarSpacing int
 
+	// IsHorizontal draws the bars horizontally, i.e. with the bars on the y-axis
+	// and the stacked segments along the x-axis.
+	IsHorizontal bool
+	// IsPercent normalizes horizontal bars so that each bar spans the full canvas width.
+	// Vertical bars are always normalized.
+	IsPercent bool
+	// SegmentLabelStyle, if shown, draws a label within each (non-transparent) horizontal bar segment.
+	// The label is either the value label, or the formatted value if the label is unset.
+	SegmentLabelStyle Style
+	// TotalLabelStyle, if shown, draws the total of each bar above vertical bars or past the end of horizontal bars.
+	TotalLabelStyle Style
+	// TotalValueFormatter formats the bar totals; it defaults to `FloatValueFormatter`.
+	TotalValueFormatter ValueFormatter
+
+	// Info, if set, is filled with the layout of each stack, its total and the share of each segment once rendered.
+	Info *StackedBarInfo
+
 	Font        *truetype.Font
 	defaultFont *truetype.Font
 
@@ -101,6 +133,9 @@ func (sbc StackedBarChart) Render(rp RendererProvider, w io.Writer) error {
 	if err != nil {
 		return err
 	}
+	if dr, isDescriptionRenderer := r.(DescriptionRenderer); isDescriptionRenderer && len(sbc.Description) > 0 {
+		dr.SetDescription(sbc.Description)
+	}
 
 	if sbc.Font == nil {
 		defaultFont, err := GetDefaultFont()
@@ -111,10 +146,36 @@ func (sbc StackedBarChart) Render(rp RendererProvider, w io.Writer) error {
 	}
 	r.SetDPI(sbc.GetDPI(DefaultDPI))
 
-	canvasBox := sbc.getAdjustedCanvasBox(r, sbc.getDefaultCanvasBox())
-	sbc.drawBars(r, canvasBox)
-	sbc.drawXAxis(r, canvasBox)
-	sbc.drawYAxis(r, canvasBox)
+	var canvasBox Box
+	var stacks []StackInfo
+	if sbc.IsHorizontal {
+		canvasBox = sbc.getHorizontalAdjustedCanvasBox(r, sbc.getDefaultCanvasBox())
+		xr := sbc.getHorizontalRange(canvasBox)
+		if xr.GetDelta() == 0 {
+			return fmt.Errorf("invalid data range; cannot be zero")
+		}
+		stacks = sbc.drawHorizontalBars(r, canvasBox, xr)
+		sbc.drawHorizontalXAxis(r, canvasBox, xr)
+		sbc.drawHorizontalYAxis(r, canvasBox)
+	} else {
+		canvasBox = sbc.getAdjustedCanvasBox(r, sbc.getDefaultCanvasBox())
+		stacks = sbc.drawBars(r, canvasBox)
+		sbc.drawXAxis(r, canvasBox)
+		sbc.drawYAxis(r, canvasBox)
+	}
+	if sbc.TotalLabelStyle.Show {
+		for _, stack := range stacks {
+			sbc.drawTotalLabel(r, stack)
+		}
+	}
+	if sbc.Info != nil {
+		*sbc.Info = StackedBarInfo{
+			Width:  sbc.GetWidth(),
+			Height: sbc.GetHeight(),
+			Canvas: boxInfo(canvasBox),
+			Stacks: stacks,
+		}
+	}
 
 	sbc.drawTitle(r)
 	for _, a := range sbc.Elements {
@@ -124,19 +185,22 @@ func (sbc StackedBarChart) Render(rp RendererProvider, w io.Writer) error {
 	return r.Save(w)
 }
 
-func (sbc StackedBarChart) drawBars(r Renderer, canvasBox Box) {
+func (sbc StackedBarChart) drawBars(r Renderer, canvasBox Box) []StackInfo {
+	stacks := make([]StackInfo, len(sbc.Bars))
 	xoffset := canvasBox.Left
-	for _, bar := range sbc.Bars {
-		sbc.drawBar(r, canvasBox, xoffset, bar)
+	for index, bar := range sbc.Bars {
+		stacks[index] = sbc.drawBar(r, canvasBox, xoffset, bar)
 		xoffset += (sbc.GetBarSpacing() + bar.GetWidth())
 	}
+	return stacks
 }
 
-func (sbc StackedBarChart) drawBar(r Renderer, canvasBox Box, xoffset int, bar StackedBar) int {
+func (sbc StackedBarChart) drawBar(r Renderer, canvasBox Box, xoffset int, bar StackedBar) StackInfo {
 	barSpacing2 := sbc.GetBarSpacing() >> 1
 	bxl := xoffset + barSpacing2
 	bxr := bxl + bar.GetWidth()
 
+	stack := sbc.newStackInfo(bar)
 	normalizedBarComponents := Values(bar.Values).Normalize()
 	yoffset := canvasBox.Top
 	for index, bv := range normalizedBarComponents {
@@ -147,11 +211,215 @@ func (sbc StackedBarChart) drawBar(r Renderer, canvasBox Box, xoffset int, bar S
 			Right:  bxr,
 			Bottom: Math.MinInt(yoffset+barHeight, canvasBox.Bottom-DefaultStrokeWidth),
 		}
-		Draw.Box(r, barBox, bv.Style.InheritFrom(sbc.styleDefaultsStackedBarValue(index)))
+		barStyle := bv.Style.InheritFrom(sbc.styleDefaultsStackedBarValue(index))
+		Draw.Box(r, barBox, barStyle)
+		recordArea(r, ImageMapAreaBar, bar.Name, imageMapBarTitle(bar.Name, bv.Label), barBox)
+		stack.Segments[index].Box, stack.Segments[index].Color = boxInfo(barBox), barStyle.FillColor.String()
 		yoffset += barHeight
 	}
 
-	return bxr
+	stack.Box = boxInfo(Box{Top: canvasBox.Top, Left: bxl, Right: bxr, Bottom: Math.MinInt(yoffset, canvasBox.Bottom-DefaultStrokeWidth)})
+	return stack
+}
+
+func (sbc StackedBarChart) drawHorizontalBars(r Renderer, canvasBox Box, xr Range) []StackInfo {
+	stacks := make([]StackInfo, len(sbc.Bars))
+	yoffset := canvasBox.Top
+	for index, bar := range sbc.Bars {
+		stacks[index] = sbc.drawHorizontalBar(r, canvasBox, xr, yoffset, bar)
+		yoffset += (sbc.GetBarSpacing() + bar.GetWidth())
+	}
+	return stacks
+}
+
+func (sbc StackedBarChart) drawHorizontalBar(r Renderer, canvasBox Box, xr Range, yoffset int, bar StackedBar) StackInfo {
+	barSpacing2 := sbc.GetBarSpacing() >> 1
+	byt := yoffset + barSpacing2
+	byb := byt + bar.GetWidth()
+
+	stack := sbc.newStackInfo(bar)
+	var total float64
+	for index, bv := range sbc.getHorizontalBarValues(bar) {
+		barBox := Box{
+			Top:    byt,
+			Left:   canvasBox.Left + xr.Translate(total),
+			Right:  Math.MinInt(canvasBox.Left+xr.Translate(total+bv.Value), canvasBox.Right),
+			Bottom: byb,
+		}
+		barStyle := bv.Style.InheritFrom(sbc.styleDefaultsStackedBarValue(index))
+		Draw.Box(r, barBox, barStyle)
+		recordArea(r, ImageMapAreaBar, bar.Name, imageMapBarTitle(bar.Name, bv.Label), barBox)
+		stack.Segments[index].Box, stack.Segments[index].Color = boxInfo(barBox), barStyle.FillColor.String()
+		if sbc.SegmentLabelStyle.Show && !barBox.IsZero() && !bv.Style.FillColor.IsTransparent() {
+			sbc.drawSegmentLabel(r, barBox, bv)
+		}
+		total += bv.Value
+	}
+
+	stack.Box = boxInfo(Box{Top: byt, Left: canvasBox.Left, Right: Math.MinInt(canvasBox.Left+xr.Translate(total), canvasBox.Right), Bottom: byb})
+	return stack
+}
+
+// newStackInfo returns the info of a bar without its layout, with a segment per (positive) value.
+func (sbc StackedBarChart) newStackInfo(bar StackedBar) StackInfo {
+	stack := StackInfo{
+		Name:       bar.Name,
+		Total:      bar.GetTotal(),
+		TotalLabel: sbc.getTotalValueFormatter()(bar.GetTotal()),
+	}
+	for _, v := range bar.Values {
+		if v.Value > 0 {
+			stack.Segments = append(stack.Segments, StackSegmentInfo{Label: v.Label, Value: v.Value, Share: v.Value / stack.Total})
+		}
+	}
+	return stack
+}
+
+// drawTotalLabel draws a bar's total centered above a vertical bar, or past the end of a horizontal bar.
+func (sbc StackedBarChart) drawTotalLabel(r Renderer, stack StackInfo) {
+	labelStyle := sbc.TotalLabelStyle.InheritFrom(sbc.styleDefaultsTotalLabel())
+	tb := Draw.MeasureText(r, stack.TotalLabel, labelStyle)
+	if sbc.IsHorizontal {
+		cy := (stack.Box.Top + stack.Box.Bottom) >> 1
+		Draw.Text(r, stack.TotalLabel, stack.Box.Right+DefaultStackTotalLabelMargin, cy+(tb.Height()>>1), labelStyle)
+		return
+	}
+	cx := (stack.Box.Left + stack.Box.Right) >> 1
+	Draw.Text(r, stack.TotalLabel, cx-(tb.Width()>>1), stack.Box.Top-DefaultStackTotalLabelMargin, labelStyle)
+}
+
+// getTotalLabelsSize returns the size of the largest total label, or zero if total labels aren't shown.
+func (sbc StackedBarChart) getTotalLabelsSize(r Renderer) (width, height int) {
+	if !sbc.TotalLabelStyle.Show {
+		return
+	}
+	labelStyle := sbc.TotalLabelStyle.InheritFrom(sbc.styleDefaultsTotalLabel())
+	for _, bar := range sbc.Bars {
+		tb := Draw.MeasureText(r, sbc.getTotalValueFormatter()(bar.GetTotal()), labelStyle)
+		width, height = Math.MaxInt(width, tb.Width()), Math.MaxInt(height, tb.Height())
+	}
+	return width + DefaultStackTotalLabelMargin, height + DefaultStackTotalLabelMargin
+}
+
+func (sbc StackedBarChart) getTotalValueFormatter() ValueFormatter {
+	if sbc.TotalValueFormatter != nil {
+		return sbc.TotalValueFormatter
+	}
+	return FloatValueFormatter
+}
+
+func (sbc StackedBarChart) drawSegmentLabel(r Renderer, segmentBox Box, bv Value) {
+	label := bv.Label
+	if len(label) == 0 {
+		label = sbc.formatHorizontalValue(bv.Value)
+	}
+
+	labelStyle := sbc.SegmentLabelStyle.InheritFrom(sbc.styleDefaultsSegmentLabel())
+	tb := Draw.MeasureText(r, label, labelStyle)
+	if tb.Width() > segmentBox.Width() || tb.Height() > segmentBox.Height() {
+		return // the label doesn't fit, skip it.
+	}
+
+	cx, cy := segmentBox.Center()
+	Draw.Text(r, label, cx-(tb.Width()>>1), cy+(tb.Height()>>1), labelStyle)
+}
+
+// getHorizontalBarValues returns the (positive) values for a horizontal bar, normalized if
+// the chart is in percent mode.
+func (sbc StackedBarChart) getHorizontalBarValues(bar StackedBar) []Value {
+	var output []Value
+	for _, v := range bar.Values {
+		if v.Value > 0 {
+			output = append(output, v)
+		}
+	}
+	if sbc.IsPercent {
+		return Values(output).Normalize()
+	}
+	return output
+}
+
+func (sbc StackedBarChart) formatHorizontalValue(v float64) string {
+	if sbc.IsPercent {
+		return fmt.Sprintf("%0.0f%%", v*100)
+	}
+	return FloatValueFormatter(v)
+}
+
+func (sbc StackedBarChart) getHorizontalRange(canvasBox Box) Range {
+	if sbc.IsPercent {
+		return &ContinuousRange{Min: 0, Max: 1.0, Domain: canvasBox.Width()}
+	}
+
+	var max float64
+	for _, bar := range sbc.Bars {
+		max = Math.Max(max, Math.Sum(Values(sbc.getHorizontalBarValues(bar)).Values()...))
+	}
+	return &ContinuousRange{Min: 0, Max: max, Domain: canvasBox.Width()}
+}
+
+func (sbc StackedBarChart) getHorizontalTicks(r Renderer, xr Range) []Tick {
+	if sbc.IsPercent {
+		var ticks []Tick
+		for x := 0; x <= 5; x++ {
+			tv := float64(x) * 0.2
+			ticks = append(ticks, Tick{Value: tv, Label: sbc.formatHorizontalValue(tv)})
+		}
+		return ticks
+	}
+	return GenerateContinuousTicks(r, xr, false, sbc.XAxis.InheritFrom(sbc.styleDefaultsAxes()), FloatValueFormatter)
+}
+
+func (sbc StackedBarChart) drawHorizontalXAxis(r Renderer, canvasBox Box, xr Range) {
+	if sbc.XAxis.Show {
+		axisStyle := sbc.XAxis.InheritFrom(sbc.styleDefaultsAxes())
+		axisStyle.WriteToRenderer(r)
+
+		r.MoveTo(canvasBox.Left, canvasBox.Bottom)
+		r.LineTo(canvasBox.Right, canvasBox.Bottom)
+		r.Stroke()
+
+		for _, t := range sbc.getHorizontalTicks(r, xr) {
+			tx := canvasBox.Left + xr.Translate(t.Value)
+
+			axisStyle.GetStrokeOptions().WriteToRenderer(r)
+			r.MoveTo(tx, canvasBox.Bottom)
+			r.LineTo(tx, canvasBox.Bottom+DefaultVerticalTickHeight)
+			r.Stroke()
+
+			axisStyle.GetTextOptions().WriteToRenderer(r)
+			tb := r.MeasureText(t.Label)
+			Draw.Text(r, t.Label, tx-(tb.Width()>>1), canvasBox.Bottom+DefaultXAxisMargin+tb.Height(), axisStyle)
+		}
+	}
+}
+
+func (sbc StackedBarChart) drawHorizontalYAxis(r Renderer, canvasBox Box) {
+	if sbc.YAxis.Show {
+		axisStyle := sbc.YAxis.InheritFrom(sbc.styleDefaultsAxes())
+		axisStyle.WriteToRenderer(r)
+
+		r.MoveTo(canvasBox.Left, canvasBox.Top)
+		r.LineTo(canvasBox.Left, canvasBox.Bottom)
+		r.Stroke()
+
+		cursor := canvasBox.Top
+		for _, bar := range sbc.Bars {
+			barHeight := bar.GetWidth() + sbc.GetBarSpacing()
+			if len(bar.Name) > 0 {
+				tb := Draw.MeasureText(r, bar.Name, axisStyle)
+				tx := canvasBox.Left - (DefaultYAxisMargin + tb.Width())
+				ty := cursor + (barHeight >> 1) + (tb.Height() >> 1)
+				Draw.Text(r, bar.Name, tx, ty, axisStyle)
+			}
+
+			axisStyle.WriteToRenderer(r)
+			r.MoveTo(canvasBox.Left, cursor+barHeight)
+			r.LineTo(canvasBox.Left-DefaultHorizontalTickWidth, cursor+barHeight)
+			r.Stroke()
+			cursor += barHeight
+		}
+	}
 }
 
 func (sbc StackedBarChart) drawXAxis(r Renderer, canvasBox Box) {
@@ -233,6 +501,7 @@ func (sbc StackedBarChart) getAdjustedCanvasBox(r Renderer, canvasBox Box) Box {
 	for _, bar := range sbc.Bars {
 		totalWidth += bar.GetWidth() + sbc.GetBarSpacing()
 	}
+	_, totalLabelHeight := sbc.getTotalLabelsSize(r)
 
 	if sbc.XAxis.Show {
 		xaxisHeight := DefaultVerticalTickHeight
@@ -256,14 +525,14 @@ func (sbc StackedBarChart) getAdjustedCanvasBox(r Renderer, canvasBox Box) Box {
 			}
 		}
 		return Box{
-			Top:    canvasBox.Top,
+			Top:    canvasBox.Top + totalLabelHeight,
 			Left:   canvasBox.Left,
 			Right:  canvasBox.Left + totalWidth,
 			Bottom: sbc.GetHeight() - xaxisHeight,
 		}
 	}
 	return Box{
-		Top:    canvasBox.Top,
+		Top:    canvasBox.Top + totalLabelHeight,
 		Left:   canvasBox.Left,
 		Right:  canvasBox.Left + totalWidth,
 		Bottom: canvasBox.Bottom,
@@ -271,6 +540,44 @@ func (sbc StackedBarChart) getAdjustedCanvasBox(r Renderer, canvasBox Box) Box {
 
 }
 
+func (sbc StackedBarChart) getHorizontalAdjustedCanvasBox(r Renderer, canvasBox Box) Box {
+	var totalHeight int
+	for _, bar := range sbc.Bars {
+		totalHeight += bar.GetWidth() + sbc.GetBarSpacing()
+	}
+
+	left := canvasBox.Left
+	if sbc.YAxis.Show {
+		axisStyle := sbc.YAxis.InheritFrom(sbc.styleDefaultsAxes())
+
+		var maxLabelWidth int
+		for _, bar := range sbc.Bars {
+			if len(bar.Name) > 0 {
+				maxLabelWidth = Math.MaxInt(maxLabelWidth, Draw.MeasureText(r, bar.Name, axisStyle).Width())
+			}
+		}
+		left += maxLabelWidth + DefaultYAxisMargin
+	}
+
+	totalLabelWidth, _ := sbc.getTotalLabelsSize(r)
+	right := canvasBox.Right - totalLabelWidth
+	bottom := canvasBox.Bottom
+	if sbc.XAxis.Show {
+		// the last tick label is centered on the right edge of the canvas.
+		axisStyle := sbc.XAxis.InheritFrom(sbc.styleDefaultsAxes())
+		tb := Draw.MeasureText(r, sbc.formatHorizontalValue(sbc.getHorizontalRange(canvasBox).GetMax()), axisStyle)
+		right -= tb.Width() >> 1
+		bottom = sbc.GetHeight() - (tb.Height() + (2 * DefaultXAxisMargin))
+	}
+
+	return Box{
+		Top:    canvasBox.Top,
+		Left:   left,
+		Right:  right,
+		Bottom: Math.MinInt(canvasBox.Top+totalHeight, bottom),
+	}
+}
+
 // Box returns the chart bounds as a box.
 func (sbc StackedBarChart) Box() Box {
 	dpr := sbc.Background.Padding.GetRight(10)
@@ -292,6 +599,22 @@ func (sbc StackedBarChart) styleDefaultsStackedBarValue(index int) Style {
 	}
 }
 
+func (sbc StackedBarChart) styleDefaultsSegmentLabel() Style {
+	return Style{
+		Font:      sbc.GetFont(),
+		FontSize:  DefaultAxisFontSize,
+		FontColor: ColorWhite,
+	}
+}
+
+func (sbc StackedBarChart) styleDefaultsTotalLabel() Style {
+	return Style{
+		Font:      sbc.GetFont(),
+		FontSize:  DefaultAxisFontSize,
+		FontColor: DefaultTextColor,
+	}
+}
+
 func (sbc StackedBarChart) styleDefaultsTitle() Style {
 	return sbc.TitleStyle.InheritFrom(Style{
 		FontColor:           DefaultTextColor,
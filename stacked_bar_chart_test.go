@@ -0,0 +1,185 @@
+package chart
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	assert "github.com/blendlabs/go-assert"
+)
+
+func TestStackedBarChartRender(t *testing.T) {
+	assert := assert.New(t)
+
+	sbc := StackedBarChart{
+		Title:      "Test Title",
+		TitleStyle: StyleShow(),
+		XAxis:      StyleShow(),
+		YAxis:      StyleShow(),
+		Bars: []StackedBar{
+			{
+				Name: "One",
+				Values: []Value{
+					{Value: 5, Label: "Blue"},
+					{Value: 5, Label: "Green"},
+				},
+			},
+			{
+				Name: "Two",
+				Values: []Value{
+					{Value: 10, Label: "Blue"},
+					{Value: 5, Label: "Green"},
+				},
+			},
+		},
+	}
+
+	buf := bytes.NewBuffer([]byte{})
+	assert.Nil(sbc.Render(PNG, buf))
+	assert.NotZero(buf.Len())
+
+	sbc.IsHorizontal = true
+	sbc.SegmentLabelStyle = StyleShow()
+
+	buf = bytes.NewBuffer([]byte{})
+	assert.Nil(sbc.Render(PNG, buf))
+	assert.NotZero(buf.Len())
+
+	sbc.IsPercent = true
+	buf = bytes.NewBuffer([]byte{})
+	assert.Nil(sbc.Render(SVG, buf))
+	assert.NotZero(buf.Len())
+}
+
+func TestStackedBarChartRenderHorizontalZero(t *testing.T) {
+	assert := assert.New(t)
+
+	sbc := StackedBarChart{
+		IsHorizontal: true,
+		Bars: []StackedBar{
+			{Name: "One", Values: []Value{{Value: 0}}},
+		},
+	}
+
+	buf := bytes.NewBuffer([]byte{})
+	assert.NotNil(sbc.Render(PNG, buf))
+}
+
+func TestStackedBarChartGetHorizontalRange(t *testing.T) {
+	assert := assert.New(t)
+
+	sbc := StackedBarChart{
+		IsHorizontal: true,
+		Bars: []StackedBar{
+			{Values: []Value{{Value: 5}, {Value: 5}}},
+			{Values: []Value{{Value: 10}, {Value: 5}, {Value: -1}}},
+		},
+	}
+
+	canvasBox := Box{Left: 10, Right: 110}
+	xr := sbc.getHorizontalRange(canvasBox)
+	assert.Equal(0.0, xr.GetMin())
+	assert.Equal(15.0, xr.GetMax())
+	assert.Equal(100, xr.GetDomain())
+
+	sbc.IsPercent = true
+	xr = sbc.getHorizontalRange(canvasBox)
+	assert.Equal(1.0, xr.GetMax())
+}
+
+func TestStackedBarChartGetHorizontalTicks(t *testing.T) {
+	assert := assert.New(t)
+
+	sbc := StackedBarChart{IsHorizontal: true, IsPercent: true}
+	ticks := sbc.getHorizontalTicks(nil, &ContinuousRange{Min: 0, Max: 1, Domain: 100})
+	assert.Len(ticks, 6)
+	assert.Equal("0%", ticks[0].Label)
+	assert.Equal("100%", ticks[5].Label)
+}
+
+func TestStackedBarChartPercentNegativeValues(t *testing.T) {
+	assert := assert.New(t)
+
+	sbc := StackedBarChart{IsHorizontal: true, IsPercent: true}
+	values := sbc.getHorizontalBarValues(StackedBar{Values: []Value{{Value: 3}, {Value: -2}, {Value: 1}}})
+	assert.Len(values, 2)
+	assert.Equal(0.75, values[0].Value)
+	assert.Equal(0.25, values[1].Value)
+	assert.Equal(1.0, Math.Sum(Values(values).Values()...), "negative values are dropped, so the bar spans 100%")
+}
+
+func TestStackedBarChartInfo(t *testing.T) {
+	assert := assert.New(t)
+
+	var info StackedBarInfo
+	sbc := StackedBarChart{
+		Info:            &info,
+		TotalLabelStyle: StyleShow(),
+		Bars: []StackedBar{
+			{Name: "One", Values: []Value{{Value: 1, Label: "Blue"}, {Value: 3, Label: "Green"}}},
+			{Name: "Two", Values: []Value{{Value: 0, Label: "Blue"}, {Value: 5, Label: "Green"}}},
+		},
+	}
+	assert.Nil(sbc.Render(PNG, bytes.NewBuffer([]byte{})))
+	assert.Len(info.Stacks, 2)
+
+	one := info.Stacks[0]
+	assert.Equal("One", one.Name)
+	assert.Equal(4.0, one.Total)
+	assert.Equal("4.00", one.TotalLabel)
+	assert.Len(one.Segments, 2)
+	assert.Equal(0.25, one.Segments[0].Share)
+	assert.Equal(0.75, one.Segments[1].Share)
+	assert.Equal(one.Box.Top, one.Segments[0].Box.Top)
+	assert.Equal(one.Segments[0].Box.Bottom, one.Segments[1].Box.Top)
+	assert.NotEmpty(one.Segments[0].Color)
+
+	// the total labels are drawn above the bars.
+	assert.True(info.Canvas.Top > DefaultStackTotalLabelMargin)
+
+	two := info.Stacks[1]
+	assert.Len(two.Segments, 1)
+	assert.Equal("Green", two.Segments[0].Label)
+	assert.Equal(1.0, two.Segments[0].Share)
+}
+
+func TestStackedBarChartInfoHorizontal(t *testing.T) {
+	assert := assert.New(t)
+
+	sbc := StackedBarChart{
+		Width:           512,
+		IsHorizontal:    true,
+		TotalLabelStyle: StyleShow(),
+		Bars: []StackedBar{
+			{Name: "One", Values: []Value{{Value: 1}, {Value: 3}}},
+			{Name: "Two", Values: []Value{{Value: 2}}},
+		},
+	}
+	withoutTotals := StackedBarChart{Width: 512, IsHorizontal: true, Bars: sbc.Bars}
+
+	var info, infoWithoutTotals StackedBarInfo
+	sbc.Info, withoutTotals.Info = &info, &infoWithoutTotals
+	assert.Nil(sbc.Render(PNG, bytes.NewBuffer([]byte{})))
+	assert.Nil(withoutTotals.Render(PNG, bytes.NewBuffer([]byte{})))
+
+	// space is reserved right of the bars for the total labels.
+	assert.True(info.Canvas.Right < infoWithoutTotals.Canvas.Right)
+	assert.Equal(info.Stacks[0].Segments[1].Box.Right, info.Stacks[0].Box.Right)
+	assert.Equal(4.0, info.Stacks[0].Total)
+	assert.Equal(2.0, info.Stacks[1].Total)
+}
+
+func TestStackedBarChartRenderWithInfo(t *testing.T) {
+	assert := assert.New(t)
+
+	sbc := StackedBarChart{
+		Bars: []StackedBar{
+			{Name: "One", Values: []Value{{Value: 1}, {Value: 3}}},
+		},
+	}
+	image, info := bytes.NewBuffer([]byte{}), bytes.NewBuffer([]byte{})
+	assert.Nil(sbc.RenderWithInfo(SVG, image, info))
+	assert.NotZero(image.Len())
+	assert.True(strings.Contains(info.String(), `"totalLabel":"4.00"`))
+	assert.True(strings.Contains(info.String(), `"share":0.75`))
+}
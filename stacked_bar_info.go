@@ -0,0 +1,47 @@
+package chart
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// StackedBarInfo describes a rendered stacked bar chart, so callers can label stack totals or build hover summaries
+// on top of the image; positions are in pixels from the top left of the image.
+type StackedBarInfo struct {
+	Width  int         `json:"width"`
+	Height int         `json:"height"`
+	Canvas BoxInfo     `json:"canvas"`
+	Stacks []StackInfo `json:"stacks"`
+}
+
+// StackInfo describes a rendered stacked bar and its total.
+type StackInfo struct {
+	Name       string             `json:"name"`
+	Total      float64            `json:"total"`
+	TotalLabel string             `json:"totalLabel"`
+	Box        BoxInfo            `json:"box"`
+	Segments   []StackSegmentInfo `json:"segments"`
+}
+
+// StackSegmentInfo describes a rendered segment of a stacked bar; its share is its value over the stack total.
+// Only segments with positive values are drawn, and described.
+type StackSegmentInfo struct {
+	Label string  `json:"label"`
+	Value float64 `json:"value"`
+	Share float64 `json:"share"`
+	Color string  `json:"color"`
+	Box   BoxInfo `json:"box"`
+}
+
+// RenderWithInfo renders the stacked bar chart to `w` and writes its stacked bar info as json to `info`.
+func (sbc StackedBarChart) RenderWithInfo(rp RendererProvider, w io.Writer, info io.Writer) error {
+	sbc.Info = &StackedBarInfo{}
+	if err := sbc.Render(rp, w); err != nil {
+		return err
+	}
+	return json.NewEncoder(info).Encode(sbc.Info)
+}
+
+func boxInfo(b Box) BoxInfo {
+	return BoxInfo{Top: b.Top, Left: b.Left, Right: b.Right, Bottom: b.Bottom}
+}
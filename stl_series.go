@@ -0,0 +1,381 @@
+package chart
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+const (
+	// DefaultSTLSeasonalWindow is the default loess window (in periods) used to smooth the seasonal component.
+	DefaultSTLSeasonalWindow = 7
+	// DefaultSTLRobustIterations is the number of robustness passes when decomposing a series robustly.
+	DefaultSTLRobustIterations = 15
+)
+
+// STLComponent is a component of a seasonal-trend decomposition.
+type STLComponent int
+
+const (
+	// STLTrend is the slowly varying trend of a series.
+	STLTrend STLComponent = iota
+	// STLSeasonal is the periodic component of a series.
+	STLSeasonal
+	// STLRemainder is what remains of a series once its trend and seasonal components are removed.
+	STLRemainder
+)
+
+// String returns the name of the component.
+func (sc STLComponent) String() string {
+	switch sc {
+	case STLSeasonal:
+		return "seasonal"
+	case STLRemainder:
+		return "remainder"
+	default:
+		return "trend"
+	}
+}
+
+// STLSeries is a component of the seasonal-trend decomposition (STL, Cleveland et al. 1990) of an inner series.
+// The inner series values are assumed to be evenly spaced; the period is given in values, i.e. 24 for a daily cycle
+// of hourly values. Use `NewSTLSeries` to draw each component (commonly on charts of their own) from one
+// decomposition.
+type STLSeries struct {
+	Name  string
+	Style Style
+	YAxis YAxisType
+
+	Component STLComponent
+	// Period is the number of values in a seasonal cycle.
+	Period int
+	// SeasonalWindow is the loess window, in cycles, that smooths each value of the cycle across cycles; wider
+	// windows make for a steadier seasonal component. It defaults to `DefaultSTLSeasonalWindow`.
+	SeasonalWindow int
+	// TrendWindow is the loess window, in values, that smooths the trend. It defaults to the smallest odd window
+	// over 1.5 periods (adjusted for the seasonal window).
+	TrendWindow int
+	// Robust downweights outliers in the inner series, so they end up in the remainder.
+	Robust bool
+
+	InnerSeries ValueProvider
+
+	cache *stlDecomposition
+}
+
+type stlDecomposition struct {
+	trend, seasonal, remainder []float64
+}
+
+// NewSTLSeries returns the trend, seasonal and remainder series of an inner series, which share one decomposition.
+// Each is named after the component, i.e. "cpu trend"; without a name the legend names them after their derivation.
+func NewSTLSeries(name string, inner ValueProvider, period int) (trend, seasonal, remainder *STLSeries) {
+	cache := &stlDecomposition{}
+	component := func(c STLComponent) *STLSeries {
+		stl := &STLSeries{Component: c, Period: period, InnerSeries: inner, cache: cache}
+		if len(name) > 0 {
+			stl.Name = name + " " + c.String()
+		}
+		return stl
+	}
+	return component(STLTrend), component(STLSeasonal), component(STLRemainder)
+}
+
+// GetName returns the name of the time series.
+func (stl STLSeries) GetName() string {
+	return stl.Name
+}
+
+// GetStyle returns the line style.
+func (stl STLSeries) GetStyle() Style {
+	return stl.Style
+}
+
+// GetYAxis returns which YAxis the series draws on.
+func (stl STLSeries) GetYAxis() YAxisType {
+	return stl.YAxis
+}
+
+// GetSeasonalWindow returns the seasonal window, an odd number of cycles.
+func (stl STLSeries) GetSeasonalWindow() int {
+	if stl.SeasonalWindow == 0 {
+		return DefaultSTLSeasonalWindow
+	}
+	return stlOdd(Math.MaxInt(stl.SeasonalWindow, 3))
+}
+
+// GetTrendWindow returns the trend window, an odd number of values.
+func (stl STLSeries) GetTrendWindow() int {
+	if stl.TrendWindow == 0 {
+		return stlOdd(int(math.Ceil(1.5 * float64(stl.Period) / (1.0 - 1.5/float64(stl.GetSeasonalWindow())))))
+	}
+	return stlOdd(Math.MaxInt(stl.TrendWindow, 3))
+}
+
+// GetInnerSeries implements `DerivedSeries`.
+func (stl STLSeries) GetInnerSeries() ValueProvider {
+	return stl.InnerSeries
+}
+
+// GetDerivation implements `DerivedSeries`.
+func (stl STLSeries) GetDerivation() string {
+	return fmt.Sprintf("STL %s(%d)", stl.Component.String(), stl.Period)
+}
+
+// Len returns the number of elements in the series.
+func (stl STLSeries) Len() int {
+	if stl.InnerSeries == nil {
+		return 0
+	}
+	return stl.InnerSeries.Len()
+}
+
+// GetValue gets a value at a given index.
+func (stl *STLSeries) GetValue(index int) (x, y float64) {
+	if stl.InnerSeries == nil {
+		return
+	}
+	stl.ensureCachedValues()
+	x, _ = stl.InnerSeries.GetValue(index)
+	y = stl.getComponentValues()[index]
+	return
+}
+
+// GetLastValue gets the last value of the series.
+func (stl *STLSeries) GetLastValue() (x, y float64) {
+	if stl.InnerSeries == nil || stl.InnerSeries.Len() == 0 {
+		return
+	}
+	return stl.GetValue(stl.InnerSeries.Len() - 1)
+}
+
+func (stl *STLSeries) getComponentValues() []float64 {
+	switch stl.Component {
+	case STLSeasonal:
+		return stl.cache.seasonal
+	case STLRemainder:
+		return stl.cache.remainder
+	default:
+		return stl.cache.trend
+	}
+}
+
+func (stl *STLSeries) ensureCachedValues() {
+	if stl.cache == nil {
+		stl.cache = &stlDecomposition{}
+	}
+	if stl.cache.trend != nil {
+		return
+	}
+
+	n := stl.InnerSeries.Len()
+	values := make([]float64, n)
+	for index := range values {
+		_, values[index] = stl.InnerSeries.GetValue(index)
+	}
+
+	inner, outer := 2, 0
+	if stl.Robust {
+		inner, outer = 1, DefaultSTLRobustIterations
+	}
+	weights := make([]float64, n)
+	for index := range weights {
+		weights[index] = 1
+	}
+
+	trend, seasonal := make([]float64, n), make([]float64, n)
+	for pass := 0; pass <= outer; pass++ {
+		for x := 0; x < inner; x++ {
+			seasonal = stl.getSeasonal(values, trend, weights)
+			trend = stl.getTrend(values, seasonal, weights)
+		}
+		if pass < outer {
+			weights = stlRobustnessWeights(values, trend, seasonal)
+		}
+	}
+
+	remainder := make([]float64, n)
+	for index := range remainder {
+		remainder[index] = values[index] - trend[index] - seasonal[index]
+	}
+	stl.cache.trend, stl.cache.seasonal, stl.cache.remainder = trend, seasonal, remainder
+}
+
+// getSeasonal smooths each value of the cycle across the cycles of the detrended values (extending each cycle
+// subseries by a value on both ends), then removes the low frequencies the smoothing picked up.
+func (stl *STLSeries) getSeasonal(values, trend, weights []float64) []float64 {
+	n, period := len(values), stl.Period
+	cycles := make([]float64, n+2*period)
+	for k := 0; k < period && k < n; k++ {
+		var sub, subWeights []float64
+		for index := k; index < n; index += period {
+			sub = append(sub, values[index]-trend[index])
+			subWeights = append(subWeights, weights[index])
+		}
+		for j := -1; j <= len(sub); j++ {
+			cycles[k+(j+1)*period] = stlLoess(sub, subWeights, float64(j), stl.GetSeasonalWindow())
+		}
+	}
+
+	lowPass := stlMovingAverage(stlMovingAverage(stlMovingAverage(cycles, period), period), 3)
+	unit := make([]float64, len(lowPass))
+	for index := range unit {
+		unit[index] = 1
+	}
+	lowPassWindow := stlOdd(period)
+
+	seasonal := make([]float64, n)
+	for index := range seasonal {
+		seasonal[index] = cycles[index+period] - stlLoess(lowPass, unit, float64(index), lowPassWindow)
+	}
+	return seasonal
+}
+
+// getTrend smooths the deseasonalized values.
+func (stl *STLSeries) getTrend(values, seasonal, weights []float64) []float64 {
+	deseasonalized := make([]float64, len(values))
+	for index := range values {
+		deseasonalized[index] = values[index] - seasonal[index]
+	}
+	trend := make([]float64, len(values))
+	for index := range trend {
+		trend[index] = stlLoess(deseasonalized, weights, float64(index), stl.GetTrendWindow())
+	}
+	return trend
+}
+
+// Render renders the series.
+func (stl *STLSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
+	style := stl.Style.InheritFrom(defaults)
+	Draw.LineSeries(r, canvasBox, xrange, yrange, style, stl)
+}
+
+// Validate validates the series.
+func (stl *STLSeries) Validate() error {
+	if stl.InnerSeries == nil {
+		return fmt.Errorf("stl series requires InnerSeries to be set")
+	}
+	if stl.Period < 2 {
+		return fmt.Errorf("stl series requires a Period of at least 2")
+	}
+	if stl.InnerSeries.Len() < 2*stl.Period {
+		return fmt.Errorf("stl series requires at least two periods of values")
+	}
+	return nil
+}
+
+// stlLoess returns the locally weighted linear regression of the values (at positions 0 through n-1) at a position,
+// over the window nearest values.
+func stlLoess(values, weights []float64, x float64, window int) float64 {
+	n := len(values)
+	if n == 0 {
+		return 0
+	}
+
+	left := Math.MaxInt(0, Math.MinInt(n-window, int(math.Floor(x+0.5))-window/2))
+	right := Math.MinInt(n-1, left+window-1)
+	for right < n-1 && x-float64(left) > float64(right+1)-x {
+		left, right = left+1, right+1
+	}
+	for left > 0 && float64(right)-x > x-float64(left-1) {
+		left, right = left-1, right-1
+	}
+	h := math.Max(x-float64(left), float64(right)-x)
+	if window > n {
+		h += float64((window - n) / 2)
+	}
+
+	w := make([]float64, right-left+1)
+	var total float64
+	for index := range w {
+		distance := math.Abs(float64(left+index) - x)
+		if h > 0 && distance < h {
+			u := distance / h
+			w[index] = math.Pow(1-u*u*u, 3) * weights[left+index]
+		} else if h == 0 {
+			w[index] = weights[left+index]
+		}
+		total += w[index]
+	}
+	if total <= 0 {
+		return values[Math.MaxInt(0, Math.MinInt(n-1, int(math.Floor(x+0.5))))]
+	}
+
+	var mean float64
+	for index := range w {
+		w[index] /= total
+		mean += w[index] * float64(left+index)
+	}
+	var spread float64
+	for index := range w {
+		d := float64(left+index) - mean
+		spread += w[index] * d * d
+	}
+	if h > 0 && math.Sqrt(spread) > 0.001*float64(n-1) {
+		slope := (x - mean) / spread
+		for index := range w {
+			w[index] *= 1 + slope*(float64(left+index)-mean)
+		}
+	}
+
+	var y float64
+	for index := range w {
+		y += w[index] * values[left+index]
+	}
+	return y
+}
+
+// stlMovingAverage returns the moving averages of a window; it has window-1 fewer values.
+func stlMovingAverage(values []float64, window int) []float64 {
+	if len(values) < window {
+		return nil
+	}
+	output := make([]float64, len(values)-window+1)
+	var sum float64
+	for index, v := range values {
+		sum += v
+		if index >= window {
+			sum -= values[index-window]
+		}
+		if index >= window-1 {
+			output[index-window+1] = sum / float64(window)
+		}
+	}
+	return output
+}
+
+// stlRobustnessWeights returns the bisquare weights of the remainders, relative to six times their median magnitude.
+func stlRobustnessWeights(values, trend, seasonal []float64) []float64 {
+	magnitudes := make([]float64, len(values))
+	for index := range values {
+		magnitudes[index] = math.Abs(values[index] - trend[index] - seasonal[index])
+	}
+	sorted := make([]float64, len(magnitudes))
+	copy(sorted, magnitudes)
+	sort.Float64s(sorted)
+	var median float64
+	if mid := len(sorted) / 2; len(sorted)%2 == 1 {
+		median = sorted[mid]
+	} else if len(sorted) > 0 {
+		median = (sorted[mid-1] + sorted[mid]) / 2.0
+	}
+
+	h := 6 * median
+	weights := make([]float64, len(values))
+	for index, m := range magnitudes {
+		if h == 0 {
+			weights[index] = 1
+		} else if u := m / h; u < 1 {
+			weights[index] = (1 - u*u) * (1 - u*u)
+		}
+	}
+	return weights
+}
+
+// stlOdd returns the smallest odd number at least as large as a value.
+func stlOdd(value int) int {
+	if value%2 == 0 {
+		return value + 1
+	}
+	return value
+}
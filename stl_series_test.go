@@ -0,0 +1,78 @@
+package chart
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	assert "github.com/blendlabs/go-assert"
+)
+
+func stlTestSeries(n int) ContinuousSeries {
+	cs := ContinuousSeries{XValues: make([]float64, n), YValues: make([]float64, n)}
+	for index := 0; index < n; index++ {
+		cs.XValues[index] = float64(index)
+		cs.YValues[index] = 0.5*float64(index) + 10*math.Sin(2*math.Pi*float64(index)/12.0)
+	}
+	return cs
+}
+
+func TestSTLSeries(t *testing.T) {
+	assert := assert.New(t)
+
+	inner := stlTestSeries(120)
+	trend, seasonal, remainder := NewSTLSeries("test", inner, 12)
+	assert.Nil(trend.Validate())
+	assert.Equal(120, seasonal.Len())
+	assert.Equal("STL seasonal(12)", seasonal.GetDerivation())
+	assert.Equal("test trend", trend.GetName())
+	assert.Equal("test seasonal", seasonal.GetName())
+	assert.Equal("test remainder", remainder.GetName())
+
+	for index := 0; index < inner.Len(); index++ {
+		x, ty := trend.GetValue(index)
+		_, sy := seasonal.GetValue(index)
+		_, ry := remainder.GetValue(index)
+		assert.Equal(inner.XValues[index], x)
+		assert.InDelta(inner.YValues[index], ty+sy+ry, 1e-9)
+
+		// away from the ends the components are recovered closely.
+		if index >= 24 && index < 96 {
+			assert.InDelta(0.5*float64(index), ty, 0.5)
+			assert.InDelta(10*math.Sin(2*math.Pi*float64(index)/12.0), sy, 0.5)
+		}
+	}
+
+	// the components share one decomposition.
+	assert.NotNil(trend.cache.trend)
+	assert.NotNil(remainder.cache.trend)
+}
+
+func TestSTLSeriesRobust(t *testing.T) {
+	assert := assert.New(t)
+
+	// robustness weights are relative to the typical remainder, so the values need some noise.
+	inner := stlTestSeries(120)
+	noise := rand.New(rand.NewSource(1))
+	for index := range inner.YValues {
+		inner.YValues[index] += noise.Float64() - 0.5
+	}
+	inner.YValues[60] += 100
+
+	stl := &STLSeries{Component: STLRemainder, Period: 12, Robust: true, InnerSeries: inner}
+	_, outlier := stl.GetValue(60)
+	assert.True(outlier > 90)
+
+	_, neighbor := stl.GetValue(61)
+	assert.True(math.Abs(neighbor) < 1)
+}
+
+func TestSTLSeriesValidate(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.NotNil((&STLSeries{Period: 12}).Validate())
+	assert.Zero((&STLSeries{Period: 12}).Len())
+	assert.NotNil((&STLSeries{Period: 1, InnerSeries: stlTestSeries(24)}).Validate())
+	assert.NotNil((&STLSeries{Period: 12, InnerSeries: stlTestSeries(20)}).Validate())
+	assert.Nil((&STLSeries{Period: 12, InnerSeries: stlTestSeries(24)}).Validate())
+}
@@ -34,6 +34,12 @@ type Style struct {
 	TextWrap            TextWrap
 	TextLineSpacing     int
 	TextRotationDegrees float64 //0 is unset or normal
+	TextMaxLines        int     //0 is unlimited
+	TextMaxWidth        int     //0 lets the component pick the width to wrap to
+
+	// FastPath trades fidelity for speed when drawing line series, i.e. for background context series:
+	// they are drawn without anti-aliasing and with fewer points.
+	FastPath bool
 }
 
 // IsZero returns if the object is set or not.
@@ -252,6 +258,26 @@ func (s Style) GetTextRotationDegrees(defaults ...float64) float64 {
 	return s.TextRotationDegrees
 }
 
+// GetTextMaxLines returns the maximum number of lines wrapped text is limited to.
+func (s Style) GetTextMaxLines(defaults ...int) int {
+	if s.TextMaxLines == 0 {
+		if len(defaults) > 0 {
+			return defaults[0]
+		}
+	}
+	return s.TextMaxLines
+}
+
+// GetTextMaxWidth returns the width in pixels wrapped text is limited to.
+func (s Style) GetTextMaxWidth(defaults ...int) int {
+	if s.TextMaxWidth == 0 {
+		if len(defaults) > 0 {
+			return defaults[0]
+		}
+	}
+	return s.TextMaxWidth
+}
+
 // WriteToRenderer passes the style's options to a renderer.
 func (s Style) WriteToRenderer(r Renderer) {
 	r.SetStrokeColor(s.GetStrokeColor())
@@ -298,6 +324,9 @@ func (s Style) InheritFrom(defaults Style) (final Style) {
 	final.TextWrap = s.GetTextWrap(defaults.TextWrap)
 	final.TextLineSpacing = s.GetTextLineSpacing(defaults.TextLineSpacing)
 	final.TextRotationDegrees = s.GetTextRotationDegrees(defaults.TextRotationDegrees)
+	final.TextMaxLines = s.GetTextMaxLines(defaults.TextMaxLines)
+	final.TextMaxWidth = s.GetTextMaxWidth(defaults.TextMaxWidth)
+	final.FastPath = s.FastPath || defaults.FastPath
 	return
 }
 
@@ -338,5 +367,7 @@ func (s Style) GetTextOptions() Style {
 		TextWrap:            s.TextWrap,
 		TextLineSpacing:     s.TextLineSpacing,
 		TextRotationDegrees: s.TextRotationDegrees,
+		TextMaxLines:        s.TextMaxLines,
+		TextMaxWidth:        s.TextMaxWidth,
 	}
 }
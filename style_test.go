@@ -212,3 +212,17 @@ func TestStyleGetTextOptions(t *testing.T) {
 	assert.True(svgStroke.FillColor.IsZero())
 	assert.False(svgStroke.FontColor.IsZero())
 }
+
+func TestStyleGetTextMaxLines(t *testing.T) {
+	assert := assert.New(t)
+
+	unset := Style{}
+	assert.Zero(unset.GetTextMaxLines())
+	assert.Equal(2, unset.GetTextMaxLines(2))
+
+	set := Style{TextMaxLines: 3, TextMaxWidth: 100}
+	assert.Equal(3, set.GetTextMaxLines(2))
+	assert.Equal(100, set.GetTextMaxWidth(50))
+	assert.Equal(3, unset.InheritFrom(set).TextMaxLines)
+	assert.Equal(100, set.GetTextOptions().TextMaxWidth)
+}
@@ -0,0 +1,223 @@
+package chart
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+)
+
+const (
+	// svgCompactLevel is the detail level paths are written with relative coordinates and without repeated points.
+	svgCompactLevel = 1
+	// svgSubPixelLevel is the detail level paths and circles smaller than a pixel are dropped.
+	svgSubPixelLevel = 2
+	// svgSimplifyLevel is the detail level lines are simplified, with a tolerance that doubles each level.
+	svgSimplifyLevel = 2
+	// svgSnapLevel is the detail level coordinates are snapped to a grid, with a spacing that doubles each level.
+	svgSnapLevel = 4
+	// svgMaxLevel is the lowest detail level.
+	svgMaxLevel = 6
+)
+
+// SVGWithBudget returns a svg renderer provider whose output is kept under `maxBytes` if possible.
+// The chart is written at full detail first; if that is too large the renderer shortens the path data, drops sub-pixel
+// detail, simplifies lines and finally reduces coordinate precision until it fits.
+// If the chart is still too large at the lowest detail it is written anyway and `Save` returns an error.
+func SVGWithBudget(maxBytes int) RendererProvider {
+	return func(width, height int) (Renderer, error) {
+		r, err := SVG(width, height)
+		if err != nil {
+			return nil, err
+		}
+		r.(*vectorRenderer).budget = maxBytes
+		return r, nil
+	}
+}
+
+// saveWithBudget writes the recorded elements at the highest detail level that fits the budget.
+func (vr *vectorRenderer) saveWithBudget(w io.Writer) error {
+	var buffer *bytes.Buffer
+	for level := 0; level <= svgMaxLevel; level++ {
+		buffer = bytes.NewBuffer([]byte{})
+		c := newCanvas(buffer)
+		c.dpi = vr.c.dpi
+		c.Start(vr.c.width, vr.c.height)
+		for _, element := range vr.elements {
+			element(c, level)
+		}
+		c.End()
+		if buffer.Len() <= vr.budget {
+			break
+		}
+	}
+	if _, err := w.Write(buffer.Bytes()); err != nil {
+		return err
+	}
+	if buffer.Len() > vr.budget {
+		return fmt.Errorf("svg is %d bytes at the lowest detail, over the budget of %d bytes", buffer.Len(), vr.budget)
+	}
+	return nil
+}
+
+// svgPathData returns the path data of a path at a given detail level, or an empty string if the path is dropped.
+func svgPathData(path []PathCommand, level int) string {
+	if level < svgCompactLevel {
+		var steps []string
+		for _, pc := range path {
+			switch pc.Command {
+			case "M", "L":
+				steps = append(steps, fmt.Sprintf("%s %d %d", pc.Command, pc.X, pc.Y))
+			case "Q":
+				steps = append(steps, fmt.Sprintf("Q%d,%d %d,%d", pc.CX, pc.CY, pc.X, pc.Y))
+			case "A":
+				steps = append(steps, fmt.Sprintf("A %d %d %0.2f 0 1 %d %d", int(pc.RX), int(pc.RY), Math.RadiansToDegrees(pc.Delta), pc.X, pc.Y))
+			case "Z":
+				steps = append(steps, "Z")
+			}
+		}
+		return strings.Join(steps, "\n")
+	}
+
+	if level >= svgSnapLevel {
+		path = svgSnapPath(path, 1<<uint(level-svgSnapLevel+1))
+	}
+	if level >= svgSimplifyLevel {
+		path = svgSimplifyPath(path, 0.5*float64(int(1)<<uint(level-svgSimplifyLevel)))
+	}
+	if level >= svgSubPixelLevel && svgIsSubPixel(path) {
+		return ""
+	}
+
+	var d []byte
+	var x, y, startX, startY int
+	for index, pc := range path {
+		switch pc.Command {
+		case "M":
+			d = append(d, fmt.Sprintf("M%d %d", pc.X, pc.Y)...)
+			startX, startY = pc.X, pc.Y
+		case "L":
+			if index > 0 && pc.X == x && pc.Y == y {
+				continue
+			}
+			d = append(d, fmt.Sprintf("l%d %d", pc.X-x, pc.Y-y)...)
+		case "Q":
+			d = append(d, fmt.Sprintf("q%d %d %d %d", pc.CX-x, pc.CY-y, pc.X-x, pc.Y-y)...)
+		case "A":
+			d = append(d, fmt.Sprintf("a%d %d %.0f 0 1 %d %d", int(pc.RX), int(pc.RY), Math.RadiansToDegrees(pc.Delta), pc.X-x, pc.Y-y)...)
+		case "Z":
+			d = append(d, 'Z')
+			x, y = startX, startY
+			continue
+		}
+		x, y = pc.X, pc.Y
+	}
+	return string(d)
+}
+
+// svgSnapPath rounds the coordinates of a path to a grid.
+func svgSnapPath(path []PathCommand, grid int) []PathCommand {
+	snap := func(v int) int {
+		return int(math.Floor(float64(v)/float64(grid)+0.5)) * grid
+	}
+	snapped := make([]PathCommand, len(path))
+	for index, pc := range path {
+		pc.X, pc.Y = snap(pc.X), snap(pc.Y)
+		if pc.Command == "Q" {
+			pc.CX, pc.CY = snap(pc.CX), snap(pc.CY)
+		}
+		snapped[index] = pc
+	}
+	return snapped
+}
+
+// svgSimplifyPath simplifies each run of lines of a path (with the point it starts from) so that no dropped point is
+// further than the tolerance from the simplified line.
+func svgSimplifyPath(path []PathCommand, tolerance float64) []PathCommand {
+	var simplified []PathCommand
+	var run []PathCommand
+	flush := func() {
+		if len(run) > 0 {
+			simplified = append(simplified, svgSimplifyRun(run, tolerance)...)
+			run = nil
+		}
+	}
+	for _, pc := range path {
+		switch pc.Command {
+		case "M":
+			flush()
+			run = []PathCommand{pc}
+		case "L":
+			if len(run) == 0 && len(simplified) > 0 && simplified[len(simplified)-1].Command != "Z" {
+				// the run starts from the end of the previous command.
+				last := simplified[len(simplified)-1]
+				simplified = simplified[:len(simplified)-1]
+				run = []PathCommand{last}
+			}
+			run = append(run, pc)
+		default:
+			flush()
+			simplified = append(simplified, pc)
+		}
+	}
+	flush()
+	return simplified
+}
+
+// svgSimplifyRun is the ramer-douglas-peucker algorithm over a run of commands; the first and last are always kept.
+func svgSimplifyRun(run []PathCommand, tolerance float64) []PathCommand {
+	if len(run) < 3 {
+		return run
+	}
+	first, last := run[0], run[len(run)-1]
+	var maxDistance float64
+	var maxIndex int
+	for index := 1; index < len(run)-1; index++ {
+		if distance := svgDistanceToLine(run[index], first, last); distance > maxDistance {
+			maxDistance, maxIndex = distance, index
+		}
+	}
+	if maxDistance <= tolerance {
+		return []PathCommand{first, last}
+	}
+	head := svgSimplifyRun(run[:maxIndex+1], tolerance)
+	return append(head[:len(head)-1], svgSimplifyRun(run[maxIndex:], tolerance)...)
+}
+
+// svgDistanceToLine returns the distance of a point to the line segment between two others.
+func svgDistanceToLine(p, a, b PathCommand) float64 {
+	px, py := float64(p.X), float64(p.Y)
+	ax, ay := float64(a.X), float64(a.Y)
+	dx, dy := float64(b.X-a.X), float64(b.Y-a.Y)
+	if dx == 0 && dy == 0 {
+		return math.Hypot(px-ax, py-ay)
+	}
+	t := math.Max(0, math.Min(1, ((px-ax)*dx+(py-ay)*dy)/(dx*dx+dy*dy)))
+	return math.Hypot(px-(ax+t*dx), py-(ay+t*dy))
+}
+
+// svgIsSubPixel returns if every point of a path is the same pixel.
+func svgIsSubPixel(path []PathCommand) bool {
+	var x, y int
+	var hasPoint bool
+	for _, pc := range path {
+		switch pc.Command {
+		case "Z":
+			continue
+		case "A":
+			if int(pc.RX) > 0 || int(pc.RY) > 0 {
+				return false
+			}
+		case "Q":
+			if pc.CX != pc.X || pc.CY != pc.Y {
+				return false
+			}
+		}
+		if hasPoint && (pc.X != x || pc.Y != y) {
+			return false
+		}
+		x, y, hasPoint = pc.X, pc.Y, true
+	}
+	return true
+}
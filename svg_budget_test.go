@@ -0,0 +1,65 @@
+package chart
+
+import (
+	"bytes"
+	"math"
+	"strings"
+	"testing"
+
+	assert "github.com/blendlabs/go-assert"
+)
+
+func budgetTestChart() Chart {
+	xvalues := Sequence.Float64(0, 2000)
+	yvalues := make([]float64, len(xvalues))
+	for index, x := range xvalues {
+		yvalues[index] = math.Sin(x / 100)
+	}
+	return Chart{
+		XAxis:  XAxis{Style: StyleShow()},
+		YAxis:  YAxis{Style: StyleShow()},
+		Series: []Series{ContinuousSeries{XValues: xvalues, YValues: yvalues}},
+	}
+}
+
+func TestSVGWithBudget(t *testing.T) {
+	assert := assert.New(t)
+
+	full := bytes.NewBuffer([]byte{})
+	assert.Nil(budgetTestChart().Render(SVG, full))
+
+	unbounded := bytes.NewBuffer([]byte{})
+	assert.Nil(budgetTestChart().Render(SVGWithBudget(full.Len()), unbounded))
+	assert.Equal(full.String(), unbounded.String())
+
+	budget := full.Len() / 4
+	reduced := bytes.NewBuffer([]byte{})
+	assert.Nil(budgetTestChart().Render(SVGWithBudget(budget), reduced))
+	assert.True(reduced.Len() <= budget)
+	assert.True(strings.HasPrefix(reduced.String(), "<svg"))
+	assert.True(strings.HasSuffix(reduced.String(), "</svg>"))
+
+	tooSmall := bytes.NewBuffer([]byte{})
+	assert.NotNil(budgetTestChart().Render(SVGWithBudget(100), tooSmall))
+	assert.NotZero(tooSmall.Len())
+}
+
+func TestSVGPathData(t *testing.T) {
+	assert := assert.New(t)
+
+	path := []PathCommand{
+		{Command: "M", X: 10, Y: 10},
+		{Command: "L", X: 20, Y: 10},
+		{Command: "L", X: 20, Y: 10},
+		{Command: "L", X: 30, Y: 10},
+		{Command: "L", X: 30, Y: 20},
+		{Command: "Z"},
+	}
+	assert.Equal("M 10 10\nL 20 10\nL 20 10\nL 30 10\nL 30 20\nZ", svgPathData(path, 0))
+	assert.Equal("M10 10l10 0l10 0l0 10Z", svgPathData(path, svgCompactLevel))
+	assert.Equal("M10 10l20 0l0 10Z", svgPathData(path, svgSimplifyLevel))
+
+	dot := []PathCommand{{Command: "M", X: 10, Y: 10}, {Command: "L", X: 10, Y: 10}}
+	assert.NotEmpty(svgPathData(dot, svgCompactLevel))
+	assert.Empty(svgPathData(dot, svgSubPixelLevel))
+}
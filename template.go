@@ -0,0 +1,140 @@
+package chart
+
+import (
+	"fmt"
+	"time"
+)
+
+// Template is a chart layout with named data slots; instantiating it with data produces a renderable chart.
+// Templates let a set of standard chart layouts be filled with fresh data for each report run.
+type Template struct {
+	// Chart is the chart definition; its `DataSlot` series are replaced with the slots' data on instantiation.
+	Chart Chart
+	// AllowMissing instantiates slots without data as empty series, instead of returning an error.
+	AllowMissing bool
+}
+
+// GetSlots returns the names of the template's data slots, in series order.
+func (t Template) GetSlots() []string {
+	var names []string
+	seen := map[string]bool{}
+	for _, s := range t.Chart.Series {
+		if slot, isSlot := s.(DataSlot); isSlot && !seen[slot.Slot] {
+			names = append(names, slot.Slot)
+			seen[slot.Slot] = true
+		}
+	}
+	return names
+}
+
+// Instantiate returns a copy of the template's chart with each data slot filled with the values for its name.
+func (t Template) Instantiate(data map[string][]Value2) (Chart, error) {
+	c := t.Chart
+	c.Series = make([]Series, len(t.Chart.Series))
+	for index, s := range t.Chart.Series {
+		slot, isSlot := s.(DataSlot)
+		if !isSlot {
+			c.Series[index] = s
+			continue
+		}
+		values, hasValues := data[slot.Slot]
+		if !hasValues && !t.AllowMissing {
+			return Chart{}, fmt.Errorf("template data slot %q has no data", slot.Slot)
+		}
+		filled, err := slot.Fill(values)
+		if err != nil {
+			return Chart{}, err
+		}
+		c.Series[index] = filled
+	}
+	return c, nil
+}
+
+// DataSlot is a placeholder series in a template chart, filled with data when the template is instantiated.
+// A slot can be used by more than one series, i.e. to draw both the raw values and a moving average of them.
+type DataSlot struct {
+	// Slot is the name of the data that fills the slot.
+	Slot string
+	// Series is the series the data is filled into; `ContinuousSeries`, `TimeSeries` and `AnnotationSeries` are
+	// supported (time series x values are `Time.ToFloat64` times). It defaults to a `ContinuousSeries` named after
+	// the slot.
+	Series Series
+	// Wrap, if set, wraps the filled series, i.e. in an `SMASeries`.
+	Wrap func(inner ValueProvider) Series
+}
+
+// GetName returns the name of the slot's series.
+func (ds DataSlot) GetName() string {
+	if ds.Series == nil {
+		return ds.Slot
+	}
+	return ds.Series.GetName()
+}
+
+// GetStyle returns the style of the slot's series.
+func (ds DataSlot) GetStyle() Style {
+	if ds.Series == nil {
+		return Style{}
+	}
+	return ds.Series.GetStyle()
+}
+
+// GetYAxis returns the y axis of the slot's series.
+func (ds DataSlot) GetYAxis() YAxisType {
+	if ds.Series == nil {
+		return YAxisPrimary
+	}
+	return ds.Series.GetYAxis()
+}
+
+// Render does nothing; slots are filled before rendering.
+func (ds DataSlot) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {}
+
+// Validate returns an error, as charts with unfilled data slots cannot be rendered.
+func (ds DataSlot) Validate() error {
+	return fmt.Errorf("data slot %q must be filled by instantiating its template", ds.Slot)
+}
+
+// Fill returns the slot's series filled with values.
+func (ds DataSlot) Fill(values []Value2) (Series, error) {
+	var filled Series
+	switch typed := ds.Series.(type) {
+	case nil:
+		cs := ContinuousSeries{Name: ds.Slot}
+		cs.XValues, cs.YValues = dataSlotValues(values)
+		filled = cs
+	case ContinuousSeries:
+		typed.XValues, typed.YValues = dataSlotValues(values)
+		filled = typed
+	case TimeSeries:
+		var xvalues []float64
+		xvalues, typed.YValues = dataSlotValues(values)
+		typed.XValues = make([]time.Time, len(xvalues))
+		for index, xv := range xvalues {
+			typed.XValues[index] = Time.FromFloat64(xv)
+		}
+		filled = typed
+	case AnnotationSeries:
+		typed.Annotations = values
+		filled = typed
+	default:
+		return nil, fmt.Errorf("data slot %q cannot fill a %T", ds.Slot, ds.Series)
+	}
+
+	if ds.Wrap != nil {
+		vp, isValueProvider := filled.(ValueProvider)
+		if !isValueProvider {
+			return nil, fmt.Errorf("data slot %q cannot wrap a %T", ds.Slot, filled)
+		}
+		return ds.Wrap(vp), nil
+	}
+	return filled, nil
+}
+
+func dataSlotValues(values []Value2) (xvalues, yvalues []float64) {
+	xvalues, yvalues = make([]float64, len(values)), make([]float64, len(values))
+	for index, v := range values {
+		xvalues[index], yvalues[index] = v.XValue, v.YValue
+	}
+	return
+}
@@ -0,0 +1,81 @@
+package chart
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/blendlabs/go-assert"
+)
+
+func TestTemplateInstantiate(t *testing.T) {
+	assert := assert.New(t)
+
+	template := Template{
+		Chart: Chart{
+			Title: "Weekly report",
+			Series: []Series{
+				DataSlot{Slot: "cpu", Series: ContinuousSeries{Name: "CPU", Style: Style{Show: true, StrokeColor: ColorRed}}},
+				DataSlot{Slot: "cpu", Wrap: func(inner ValueProvider) Series {
+					return SMASeries{Period: 2, InnerSeries: inner}
+				}},
+				DataSlot{Slot: "deploys", Series: AnnotationSeries{Name: "Deploys"}},
+				ContinuousSeries{Name: "Target", XValues: []float64{1, 3}, YValues: []float64{2, 2}},
+			},
+		},
+	}
+	assert.Equal([]string{"cpu", "deploys"}, template.GetSlots())
+
+	var buffer bytes.Buffer
+	assert.NotNil(template.Chart.Render(PNG, &buffer), "unfilled slots cannot be rendered")
+
+	_, err := template.Instantiate(map[string][]Value2{"cpu": {{XValue: 1, YValue: 1}}})
+	assert.NotNil(err, "every slot needs data")
+
+	c, err := template.Instantiate(map[string][]Value2{
+		"cpu":     {{XValue: 1, YValue: 1}, {XValue: 2, YValue: 3}, {XValue: 3, YValue: 2}},
+		"deploys": {{XValue: 2, YValue: 3, Label: "v1.2"}},
+	})
+	assert.Nil(err)
+	assert.Equal("Weekly report", c.Title)
+	assert.Len(c.Series, 4)
+
+	cpu := c.Series[0].(ContinuousSeries)
+	assert.Equal("CPU", cpu.Name)
+	assert.Equal(ColorRed, cpu.Style.StrokeColor)
+	assert.Equal([]float64{1, 2, 3}, cpu.XValues)
+	assert.Equal([]float64{1, 3, 2}, cpu.YValues)
+
+	sma := c.Series[1].(SMASeries)
+	assert.Equal(3, sma.InnerSeries.Len())
+	assert.Equal("v1.2", c.Series[2].(AnnotationSeries).Annotations[0].Label)
+	assert.Equal(template.Chart.Series[3], c.Series[3])
+	_, isSlot := template.Chart.Series[0].(DataSlot)
+	assert.True(isSlot, "instantiating leaves the template as is")
+
+	assert.Nil(c.Render(PNG, &buffer))
+}
+
+func TestTemplateInstantiateMissing(t *testing.T) {
+	assert := assert.New(t)
+
+	template := Template{
+		Chart: Chart{
+			Series: []Series{
+				DataSlot{Slot: "latency", Series: TimeSeries{Name: "Latency"}},
+			},
+		},
+		AllowMissing: true,
+	}
+	c, err := template.Instantiate(nil)
+	assert.Nil(err)
+	assert.Zero(c.Series[0].(TimeSeries).Len())
+
+	now := time.Unix(1500000000, 0)
+	c, err = template.Instantiate(map[string][]Value2{"latency": {{XValue: Time.ToFloat64(now), YValue: 5}}})
+	assert.Nil(err)
+	assert.True(now.Equal(c.Series[0].(TimeSeries).XValues[0]))
+
+	_, err = DataSlot{Slot: "bins", Series: HistogramSeries{}}.Fill(nil)
+	assert.NotNil(err)
+}
@@ -30,6 +30,8 @@ const (
 	TextWrapWord TextWrap = 2
 	// TextWrapRune will split a string on a rune (i.e. utf-8 codepage) to fit within a horizontal boundary.
 	TextWrapRune TextWrap = 3
+	// TextWrapHyphenate will split a string on words, and split words that are too long for a line with a hyphen.
+	TextWrapHyphenate TextWrap = 4
 )
 
 // TextVerticalAlign is an enum for the vertical alignment options.
@@ -70,10 +72,88 @@ func (t text) WrapFit(r Renderer, value string, width int, style Style) []string
 		return t.WrapFitRune(r, value, width, style)
 	case TextWrapWord:
 		return t.WrapFitWord(r, value, width, style)
+	case TextWrapHyphenate:
+		return t.WrapFitHyphenate(r, value, width, style)
 	}
 	return []string{value}
 }
 
+// Wrap splits a string into lines that fit a pixel width according to the style's wrap option.
+// If the style sets `TextMaxLines` the lines past the limit are dropped, and the last line kept is
+// truncated with an ellipsis.
+func (t text) Wrap(r Renderer, value string, width int, style Style) []string {
+	lines := t.WrapFit(r, value, width, style)
+	maxLines := style.GetTextMaxLines()
+	if maxLines == 0 || len(lines) <= maxLines {
+		return lines
+	}
+	output := make([]string, maxLines)
+	copy(output, lines[:maxLines])
+	output[maxLines-1] = t.truncate(r, output[maxLines-1], width, true)
+	return output
+}
+
+// Ellipsize truncates a string with an ellipsis so that it fits a pixel width.
+// Strings that already fit are returned as is.
+func (t text) Ellipsize(r Renderer, value string, width int, style Style) string {
+	style.WriteTextOptionsToRenderer(r)
+	return t.truncate(r, value, width, false)
+}
+
+// WrapFitHyphenate splits a string on words to fit a pixel width, splitting words that do not fit on a line
+// by themselves with a trailing hyphen.
+func (t text) WrapFitHyphenate(r Renderer, value string, width int, style Style) []string {
+	style.WriteToRenderer(r)
+
+	var output []string
+	for _, paragraph := range strings.Split(value, "\n") {
+		var line string
+		for _, word := range strings.Fields(paragraph) {
+			if len(line) > 0 {
+				if t.fits(r, line+" "+word, width) {
+					line = line + " " + word
+					continue
+				}
+				output = append(output, line)
+				line = ""
+			}
+
+			for !t.fits(r, word, width) {
+				runes := []rune(word)
+				split := 1
+				for split < len(runes)-1 && t.fits(r, string(runes[:split+1])+"-", width) {
+					split++
+				}
+				if split >= len(runes) {
+					break
+				}
+				output = append(output, string(runes[:split])+"-")
+				word = string(runes[split:])
+			}
+			line = word
+		}
+		output = append(output, line)
+	}
+	return output
+}
+
+func (t text) fits(r Renderer, value string, width int) bool {
+	return r.MeasureText(value).Width() < width
+}
+
+// truncate removes runes from the end of a string until it fits (with an ellipsis) within a width.
+// If force is set the ellipsis is added even if the string fits as is.
+func (t text) truncate(r Renderer, value string, width int, force bool) string {
+	if !force && t.fits(r, value, width) {
+		return value
+	}
+	runes := []rune(t.Trim(value))
+	for len(runes) > 0 && !t.fits(r, t.Trim(string(runes))+DefaultTextEllipsis, width) {
+		runes = runes[:len(runes)-1]
+	}
+	return t.Trim(string(runes)) + DefaultTextEllipsis
+}
+
 func (t text) WrapFitWord(r Renderer, value string, width int, style Style) []string {
 	style.WriteToRenderer(r)
 
@@ -0,0 +1,40 @@
+package chart
+
+import (
+	"sync"
+
+	"github.com/golang/freetype/truetype"
+)
+
+// textMeasureKey identifies a measured string.
+type textMeasureKey struct {
+	font *truetype.Font
+	size float64
+	dpi  float64
+	text string
+}
+
+// textMeasureCache is a measured text width cache shared by renderers, that is safe to use from multiple goroutines.
+// It is reset once it holds `DefaultTextMeasureCacheSize` entries so it stays bounded when rendering many charts.
+type textMeasureCache struct {
+	lock   sync.RWMutex
+	widths map[textMeasureKey]int
+}
+
+var _textMeasureCache = &textMeasureCache{widths: map[textMeasureKey]int{}}
+
+func (tmc *textMeasureCache) get(key textMeasureKey) (width int, ok bool) {
+	tmc.lock.RLock()
+	width, ok = tmc.widths[key]
+	tmc.lock.RUnlock()
+	return
+}
+
+func (tmc *textMeasureCache) set(key textMeasureKey, width int) {
+	tmc.lock.Lock()
+	if len(tmc.widths) >= DefaultTextMeasureCacheSize {
+		tmc.widths = map[textMeasureKey]int{}
+	}
+	tmc.widths[key] = width
+	tmc.lock.Unlock()
+}
@@ -0,0 +1,255 @@
+package chart
+
+const (
+	arabicTatweel rune = 0x0640
+	arabicLam     rune = 0x0644
+)
+
+var (
+	// arabicFormCounts is the number of presentation forms (isolated, final, initial, medial) for the
+	// letters starting at U+0621; the forms are laid out contiguously starting at U+FE80.
+	arabicFormCounts = [...]int{
+		1, 2, 2, 2, 2, 4, 2, 4, 2, 4, 4, 4, 4, 4, 2, 2, 2, 2, 4, 4, 4, 4, 4, 4, 4, 4, // U+0621 - U+063A
+		0, 0, 0, 0, 0, 0, // U+063B - U+0640
+		4, 4, 4, 4, 4, 4, 4, 2, 2, 4, // U+0641 - U+064A
+	}
+
+	// arabicLamAlef maps the alef variants to the isolated form of their lam-alef ligature.
+	arabicLamAlef = map[rune]rune{
+		0x0622: 0xFEF5,
+		0x0623: 0xFEF7,
+		0x0625: 0xFEF9,
+		0x0627: 0xFEFB,
+	}
+
+	bidiMirrors = map[rune]rune{
+		'(': ')', ')': '(',
+		'[': ']', ']': '[',
+		'{': '}', '}': '{',
+		'<': '>', '>': '<',
+	}
+)
+
+type bidiClass int
+
+const (
+	bidiNeutral bidiClass = iota
+	bidiLeft
+	bidiRight
+	bidiNumber
+)
+
+// IsRTL returns if a string contains right-to-left (i.e. hebrew or arabic) runes.
+func (t text) IsRTL(value string) bool {
+	for _, r := range value {
+		if t.bidiClass(r) == bidiRight {
+			return true
+		}
+	}
+	return false
+}
+
+// Visual returns a string shaped and reordered for display by a renderer that draws runes strictly left to right.
+// Strings without right-to-left runes are returned as is.
+func (t text) Visual(value string) string {
+	if !t.IsRTL(value) {
+		return value
+	}
+	return t.Reorder(t.Shape(value))
+}
+
+// Shape substitutes arabic letters with their contextual (isolated, initial, medial, final) presentation forms,
+// including the lam-alef ligatures. Measuring a shaped string yields the width it will actually be drawn at.
+func (t text) Shape(value string) string {
+	runes := []rune(value)
+	output := make([]rune, 0, len(runes))
+	for index := 0; index < len(runes); index++ {
+		r := runes[index]
+		count := t.arabicFormCount(r)
+		if count == 0 {
+			output = append(output, r)
+			continue
+		}
+
+		prev := t.arabicNeighbor(runes, index, -1)
+		joinsPrev := t.arabicJoinsNext(prev)
+
+		if r == arabicLam && index+1 < len(runes) {
+			if ligature, isLigature := arabicLamAlef[runes[index+1]]; isLigature {
+				if joinsPrev {
+					ligature++
+				}
+				output = append(output, ligature)
+				index++
+				continue
+			}
+		}
+
+		next := t.arabicNeighbor(runes, index, 1)
+		joinsPrev = joinsPrev && count > 1
+		joinsNext := t.arabicJoinsNext(r) && t.arabicJoinsPrev(next)
+
+		var form int
+		switch {
+		case joinsPrev && joinsNext:
+			form = 3
+		case joinsPrev:
+			form = 1
+		case joinsNext:
+			form = 2
+		}
+		output = append(output, t.arabicFormBase(r)+rune(form))
+	}
+	return string(output)
+}
+
+// Reorder converts a string from logical order to visual (left to right) order.
+// It is a simplified form of the unicode bidi algorithm; the paragraph direction is taken from the first strong rune,
+// numbers stay left to right, neutrals take the direction of their surrounding runs and brackets
+// in right to left runs are mirrored.
+func (t text) Reorder(value string) string {
+	runes := []rune(value)
+	if len(runes) == 0 {
+		return value
+	}
+
+	classes := make([]bidiClass, len(runes))
+	base := bidiLeft
+	var foundBase bool
+	for index, r := range runes {
+		classes[index] = t.bidiClass(r)
+		if !foundBase && (classes[index] == bidiLeft || classes[index] == bidiRight) {
+			base = classes[index]
+			foundBase = true
+		}
+	}
+
+	// resolve neutrals to the direction of their neighbors, or the base direction if the neighbors disagree.
+	for index := 0; index < len(classes); index++ {
+		if classes[index] != bidiNeutral {
+			continue
+		}
+		end := index
+		for end < len(classes) && classes[end] == bidiNeutral {
+			end++
+		}
+		before, after := base, base
+		if index > 0 {
+			before = t.bidiStrong(classes[index-1])
+		}
+		if end < len(classes) {
+			after = t.bidiStrong(classes[end])
+		}
+		resolved := base
+		if before == after {
+			resolved = before
+		}
+		for x := index; x < end; x++ {
+			classes[x] = resolved
+		}
+		index = end - 1
+	}
+
+	levels := make([]int, len(runes))
+	var maxLevel int
+	for index, class := range classes {
+		if base == bidiRight {
+			if class == bidiRight {
+				levels[index] = 1
+			} else {
+				levels[index] = 2
+			}
+		} else if class == bidiRight {
+			levels[index] = 1
+		}
+		maxLevel = Math.MaxInt(maxLevel, levels[index])
+	}
+
+	output := make([]rune, len(runes))
+	for index, r := range runes {
+		if mirror, hasMirror := bidiMirrors[r]; hasMirror && levels[index]%2 == 1 {
+			r = mirror
+		}
+		output[index] = r
+	}
+
+	for level := maxLevel; level > 0; level-- {
+		for index := 0; index < len(output); index++ {
+			if levels[index] < level {
+				continue
+			}
+			end := index
+			for end < len(output) && levels[end] >= level {
+				end++
+			}
+			for a, b := index, end-1; a < b; a, b = a+1, b-1 {
+				output[a], output[b] = output[b], output[a]
+				levels[a], levels[b] = levels[b], levels[a]
+			}
+			index = end - 1
+		}
+	}
+	return string(output)
+}
+
+func (t text) bidiClass(r rune) bidiClass {
+	switch {
+	case r >= '0' && r <= '9', r >= 0x0660 && r <= 0x0669, r >= 0x06F0 && r <= 0x06F9:
+		return bidiNumber
+	case r >= 0x0590 && r <= 0x08FF, r >= 0xFB1D && r <= 0xFDFF, r >= 0xFE70 && r <= 0xFEFF:
+		return bidiRight
+	case r < 0x80:
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') {
+			return bidiLeft
+		}
+		return bidiNeutral
+	case r >= 0x2000 && r <= 0x2BFF, r >= 0x3000 && r <= 0x303F:
+		return bidiNeutral
+	}
+	return bidiLeft
+}
+
+// bidiStrong returns the direction a class imposes on adjacent neutrals; numbers behave as left to right.
+func (t text) bidiStrong(class bidiClass) bidiClass {
+	if class == bidiRight {
+		return bidiRight
+	}
+	return bidiLeft
+}
+
+func (t text) arabicFormCount(r rune) int {
+	if r < 0x0621 || r > 0x064A {
+		return 0
+	}
+	return arabicFormCounts[r-0x0621]
+}
+
+func (t text) arabicFormBase(r rune) rune {
+	base := rune(0xFE80)
+	for index := 0; index < int(r-0x0621); index++ {
+		base += rune(arabicFormCounts[index])
+	}
+	return base
+}
+
+// arabicNeighbor returns the closest letter in a direction, skipping over diacritics.
+func (t text) arabicNeighbor(runes []rune, index, direction int) rune {
+	for x := index + direction; x >= 0 && x < len(runes); x += direction {
+		r := runes[x]
+		if (r >= 0x064B && r <= 0x065F) || r == 0x0670 {
+			continue
+		}
+		return r
+	}
+	return 0
+}
+
+// arabicJoinsNext returns if a letter connects to the letter that follows it.
+func (t text) arabicJoinsNext(r rune) bool {
+	return r == arabicTatweel || t.arabicFormCount(r) == 4
+}
+
+// arabicJoinsPrev returns if a letter connects to the letter that precedes it.
+func (t text) arabicJoinsPrev(r rune) bool {
+	return r == arabicTatweel || t.arabicFormCount(r) > 1
+}
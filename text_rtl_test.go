@@ -0,0 +1,48 @@
+package chart
+
+import (
+	"testing"
+
+	assert "github.com/blendlabs/go-assert"
+)
+
+func TestTextIsRTL(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.False(Text.IsRTL("hello world 123"))
+	assert.True(Text.IsRTL("hello שלום"))
+	assert.True(Text.IsRTL("مرحبا"))
+}
+
+func TestTextReorder(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal("hello world", Text.Reorder("hello world"))
+	assert.Equal("םולש", Text.Reorder("שלום"))
+	assert.Equal("abc םולש", Text.Reorder("abc שלום"))
+	assert.Equal("abc םולש", Text.Reorder("שלום abc"))
+	assert.Equal("2017 םולש", Text.Reorder("שלום 2017"))
+	assert.Equal("(םולש)", Text.Reorder("(שלום)"))
+}
+
+func TestTextShape(t *testing.T) {
+	assert := assert.New(t)
+
+	// beh + alef; beh joins forward (initial), alef joins backward (final).
+	assert.Equal(string([]rune{0xFE91, 0xFE8E}), Text.Shape("با"))
+	// beh + beh + beh; initial, medial, final.
+	assert.Equal(string([]rune{0xFE91, 0xFE92, 0xFE90}), Text.Shape("ببب"))
+	// alef does not join forward so the beh following it is isolated.
+	assert.Equal(string([]rune{0xFE8D, 0xFE8F}), Text.Shape("اب"))
+	// lam + alef ligature.
+	assert.Equal(string([]rune{0xFEFB}), Text.Shape("لا"))
+	assert.Equal(string([]rune{0xFE91, 0xFEFC}), Text.Shape("بلا"))
+	assert.Equal("hello", Text.Shape("hello"))
+}
+
+func TestTextVisual(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal("hello", Text.Visual("hello"))
+	assert.Equal(string([]rune{0xFE8E, 0xFE91}), Text.Visual("با"))
+}
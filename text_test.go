@@ -1,6 +1,7 @@
 package chart
 
 import (
+	"strings"
 	"testing"
 
 	assert "github.com/blendlabs/go-assert"
@@ -58,3 +59,67 @@ func TestTextWrapRune(t *testing.T) {
 	assert.Equal("this is a t", output[0])
 	assert.Equal("est string", output[1])
 }
+
+func TestTextWrapHyphenate(t *testing.T) {
+	assert := assert.New(t)
+
+	r, err := PNG(1024, 1024)
+	assert.Nil(err)
+	f, err := GetDefaultFont()
+	assert.Nil(err)
+
+	basicTextStyle := Style{Font: f, FontSize: 24}
+
+	output := Text.WrapFitHyphenate(r, "this is a test string", 100, basicTextStyle)
+	assert.Len(output, 3)
+	assert.Equal("this is", output[0])
+	assert.Equal("a test", output[1])
+	assert.Equal("string", output[2])
+
+	output = Text.WrapFitHyphenate(r, "supercalifragilistic", 100, basicTextStyle)
+	assert.True(len(output) > 1)
+	for index, line := range output {
+		basicTextStyle.WriteToRenderer(r)
+		assert.True(r.MeasureText(line).Width() < 100, line)
+		if index < len(output)-1 {
+			assert.True(strings.HasSuffix(line, "-"), line)
+		}
+	}
+	assert.Equal("supercalifragilistic", strings.Replace(strings.Join(output, ""), "-", "", -1))
+}
+
+func TestTextWrapMaxLines(t *testing.T) {
+	assert := assert.New(t)
+
+	r, err := PNG(1024, 1024)
+	assert.Nil(err)
+	f, err := GetDefaultFont()
+	assert.Nil(err)
+
+	style := Style{Font: f, FontSize: 24, TextWrap: TextWrapWord, TextMaxLines: 2}
+
+	output := Text.Wrap(r, "this is a test string", 100, style)
+	assert.Len(output, 2)
+	assert.Equal("this is", output[0])
+	assert.True(strings.HasSuffix(output[1], DefaultTextEllipsis))
+	assert.True(r.MeasureText(output[1]).Width() < 100)
+
+	style.TextMaxLines = 0
+	assert.Len(Text.Wrap(r, "this is a test string", 100, style), 3)
+}
+
+func TestTextEllipsize(t *testing.T) {
+	assert := assert.New(t)
+
+	r, err := PNG(1024, 1024)
+	assert.Nil(err)
+	f, err := GetDefaultFont()
+	assert.Nil(err)
+
+	style := Style{Font: f, FontSize: 24}
+
+	assert.Equal("foo", Text.Ellipsize(r, "foo", 100, style))
+	output := Text.Ellipsize(r, "this is a test string", 100, style)
+	assert.True(strings.HasSuffix(output, DefaultTextEllipsis))
+	assert.True(r.MeasureText(output).Width() < 100)
+}
@@ -3,6 +3,7 @@ package chart
 import (
 	"fmt"
 	"math"
+	"sort"
 	"strings"
 )
 
@@ -11,6 +12,101 @@ type TicksProvider interface {
 	GetTicks(r Renderer, defaults Style, vf ValueFormatter) []Tick
 }
 
+// TickContext is where a tick falls within the set of ticks for an axis.
+type TickContext struct {
+	Index int
+	Total int
+
+	// Previous is the value of the previous tick, and is only valid if HasPrevious is set (i.e. not for the first tick).
+	Previous    float64
+	HasPrevious bool
+
+	// Step is the typical (median) distance between the values of the ticks, or zero for a single tick.
+	Step float64
+}
+
+// IsFirst returns if the tick is the first tick.
+func (tc TickContext) IsFirst() bool {
+	return tc.Index == 0
+}
+
+// IsLast returns if the tick is the last tick.
+func (tc TickContext) IsLast() bool {
+	return tc.Index == tc.Total-1
+}
+
+// TickFormatter is a function that produces a tick label from a value and the tick's context,
+// i.e. to only show the year of a date when it changes.
+type TickFormatter func(v interface{}, tc TickContext) string
+
+// FormatTicks returns a copy of a set of ticks with the labels produced by a tick formatter.
+func FormatTicks(ticks []Tick, tf TickFormatter) []Tick {
+	output := make([]Tick, len(ticks))
+	step := getTickStep(ticks)
+	for index, t := range ticks {
+		tc := TickContext{
+			Index: index,
+			Total: len(ticks),
+			Step:  step,
+		}
+		if index > 0 {
+			tc.Previous = ticks[index-1].Value
+			tc.HasPrevious = true
+		}
+		output[index] = Tick{
+			Value: t.Value,
+			Label: tf(t.Value, tc),
+		}
+	}
+	return output
+}
+
+// getTickStep returns the median distance between consecutive ticks; the first and last ticks are usually the range
+// bounds, which are not a step apart from their neighbours.
+func getTickStep(ticks []Tick) float64 {
+	if len(ticks) < 2 {
+		return 0
+	}
+	steps := make([]float64, len(ticks)-1)
+	for index := range steps {
+		steps[index] = math.Abs(ticks[index+1].Value - ticks[index].Value)
+	}
+	sort.Float64s(steps)
+	return steps[len(steps)/2]
+}
+
+// formatTicksToFit relabels a set of ticks with a tick formatter and, as the ticks were spaced for their original
+// labels, keeps every other (or every third, etc.) tick if the new labels would not fit in that spacing.
+func formatTicksToFit(r Renderer, ticks []Tick, isVertical bool, style Style, tf TickFormatter) []Tick {
+	spacing := DefaultMinimumTickHorizontalSpacing
+	if isVertical {
+		spacing = DefaultMinimumTickVerticalSpacing
+	}
+	labelSize := func(ticks []Tick) (size int) {
+		for _, t := range ticks {
+			tb := Draw.MeasureText(r, t.Label, style)
+			if isVertical {
+				size = Math.MaxInt(size, tb.Height())
+			} else {
+				size = Math.MaxInt(size, tb.Width())
+			}
+		}
+		return
+	}
+
+	spacedFor := labelSize(ticks) + spacing
+	for step := 1; ; step++ {
+		var kept []Tick
+		for index := 0; index < len(ticks); index += step {
+			kept = append(kept, ticks[index])
+		}
+		formatted := FormatTicks(kept, tf)
+		if len(kept) <= 2 || labelSize(formatted)+spacing <= step*spacedFor {
+			return formatted
+		}
+	}
+}
+
 // Tick represents a label on an axis.
 type Tick struct {
 	Value float64
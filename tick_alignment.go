@@ -0,0 +1,100 @@
+package chart
+
+import (
+	"math"
+
+	"github.com/wcharczuk/go-chart/drawing"
+)
+
+// alignedRange is a continuous range whose ticks evenly divide it into a fixed number of intervals,
+// so the ticks of an aligned primary and secondary range are drawn at the same positions.
+type alignedRange struct {
+	*ContinuousRange
+	intervals int
+}
+
+// GetTicks implements `TicksProvider`.
+func (ar *alignedRange) GetTicks(r Renderer, defaults Style, vf ValueFormatter) []Tick {
+	ticks := make([]Tick, ar.intervals+1)
+	step := ar.GetDelta() / float64(ar.intervals)
+	for index := range ticks {
+		value := ar.GetMin() + step*float64(index)
+		ticks[index] = Tick{Value: value, Label: vf(value)}
+	}
+	return ticks
+}
+
+// Translate maps a value into the range space like `ContinuousRange`, but snaps values within rounding error of a
+// pixel so the ticks of both aligned ranges land on the same pixels.
+func (ar *alignedRange) Translate(value float64) int {
+	scaled := (value - ar.Min) / ar.GetDelta() * float64(ar.Domain)
+	if rounded := math.Floor(scaled + 0.5); math.Abs(scaled-rounded) < 1e-6 {
+		scaled = rounded
+	}
+	if ar.IsDescending() {
+		return ar.Domain - int(math.Ceil(scaled))
+	}
+	return int(math.Ceil(scaled))
+}
+
+// getAlignedYAxisIntervals returns the most tick intervals aligned y axes are split into, matching the
+// density of generated ticks on the default canvas.
+func (c Chart) getAlignedYAxisIntervals() int {
+	fontSize := math.Max(c.YAxis.Style.GetFontSize(DefaultFontSize), c.YAxisSecondary.Style.GetFontSize(DefaultFontSize))
+	tickSize := drawing.PointsToPixels(c.GetDPI(DefaultDPI), fontSize) + DefaultMinimumTickVerticalSpacing
+	return Math.MaxInt(2, int(float64(c.getDefaultCanvasBox().Height())/tickSize))
+}
+
+// alignYRanges sets nice bounds for the primary and secondary y ranges that split both into the same number of
+// intervals, picking the number of intervals (at most `maxIntervals`) that wastes the least space.
+func alignYRanges(yrange, yrangeAlt *ContinuousRange, maxIntervals int) (primary, secondary Range) {
+	miny, maxy := yrange.GetMin(), yrange.GetMax()
+	minya, maxya := yrangeAlt.GetMin(), yrangeAlt.GetMax()
+	if !(maxy > miny) || !(maxya > minya) {
+		return yrange, yrangeAlt
+	}
+	// non-finite bounds are left for `checkRanges` to report.
+	for _, bound := range []float64{miny, maxy, minya, maxya, maxy - miny, maxya - minya} {
+		if math.IsInf(bound, 0) || math.IsNaN(bound) {
+			return yrange, yrangeAlt
+		}
+	}
+
+	intervals := maxIntervals
+	bestWaste := math.MaxFloat64
+	for n := maxIntervals; n >= Math.MaxInt(2, maxIntervals/2); n-- {
+		low, high := alignedBounds(miny, maxy, n)
+		lowAlt, highAlt := alignedBounds(minya, maxya, n)
+		if waste := (high-low)/(maxy-miny) + (highAlt-lowAlt)/(maxya-minya); waste < bestWaste {
+			intervals, bestWaste = n, waste
+		}
+	}
+
+	low, high := alignedBounds(miny, maxy, intervals)
+	yrange.SetMin(low)
+	yrange.SetMax(high)
+	lowAlt, highAlt := alignedBounds(minya, maxya, intervals)
+	yrangeAlt.SetMin(lowAlt)
+	yrangeAlt.SetMax(highAlt)
+	return &alignedRange{ContinuousRange: yrange, intervals: intervals}, &alignedRange{ContinuousRange: yrangeAlt, intervals: intervals}
+}
+
+// alignedBounds returns the tightest bounds around min and max that are a number of intervals of a nice step
+// (1, 2, 2.5 or 5 times a power of ten) apart; bounds that cannot be aligned are returned as is.
+func alignedBounds(min, max float64, intervals int) (low, high float64) {
+	magnitude := math.Pow(10, math.Floor(math.Log10((max-min)/float64(intervals))))
+	if math.IsInf(magnitude, 0) || math.IsNaN(magnitude) || magnitude == 0 {
+		return min, max
+	}
+	for x := 0; x < 4; x++ {
+		for _, multiple := range []float64{1, 2, 2.5, 5} {
+			step := multiple * magnitude
+			low = math.Floor(min/step) * step
+			if high = low + step*float64(intervals); high >= max {
+				return
+			}
+		}
+		magnitude *= 10
+	}
+	return min, max
+}
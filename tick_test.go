@@ -58,3 +58,26 @@ func TestGenerateContinuousTicksDescending(t *testing.T) {
 	assert.Equal(1.0, ticks[len(ticks)-2].Value)
 	assert.Equal(0.0, ticks[len(ticks)-1].Value)
 }
+
+func TestFormatTicks(t *testing.T) {
+	assert := assert.New(t)
+
+	ticks := []Tick{{Value: 1.0}, {Value: 2.0}, {Value: 3.0}}
+	var contexts []TickContext
+	formatted := FormatTicks(ticks, func(v interface{}, tc TickContext) string {
+		contexts = append(contexts, tc)
+		return FloatValueFormatter(v)
+	})
+
+	assert.Len(formatted, 3)
+	assert.Equal("2.00", formatted[1].Label)
+	assert.Empty(ticks[1].Label)
+
+	assert.True(contexts[0].IsFirst())
+	assert.False(contexts[0].HasPrevious)
+	assert.Equal(1, contexts[1].Index)
+	assert.Equal(3, contexts[1].Total)
+	assert.True(contexts[1].HasPrevious)
+	assert.Equal(1.0, contexts[1].Previous)
+	assert.True(contexts[2].IsLast())
+}
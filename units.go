@@ -0,0 +1,89 @@
+package chart
+
+import "math"
+
+var (
+	// UnitsBytes are the binary units of data sizes, each 1024 times the last.
+	UnitsBytes = Units{Base: 1024, Names: []string{"B", "KB", "MB", "GB", "TB", "PB"}}
+	// UnitsBytesSI are the decimal (SI) units of data sizes, each 1000 times the last.
+	UnitsBytesSI = Units{Base: 1000, Names: []string{"B", "kB", "MB", "GB", "TB", "PB"}}
+	// UnitsBitsPerSecond are the units of network throughput, each 1000 times the last.
+	UnitsBitsPerSecond = Units{Base: 1000, Names: []string{"bps", "Kbps", "Mbps", "Gbps", "Tbps"}}
+)
+
+// Units is a family of units of a measure, each `Base` times the last, i.e. bytes, kilobytes and megabytes.
+// Values are given in the first unit.
+type Units struct {
+	Base  float64
+	Names []string
+}
+
+// IsZero returns if the units are unset.
+func (u Units) IsZero() bool {
+	return len(u.Names) == 0
+}
+
+// Pick returns the largest unit a magnitude is at least one of, and the size of the unit in the first unit.
+func (u Units) Pick(magnitude float64) (name string, scale float64) {
+	if u.IsZero() {
+		return "", 1
+	}
+	scale = 1
+	var index int
+	for index < len(u.Names)-1 && u.Base > 1 && math.Abs(magnitude) >= scale*u.Base {
+		scale *= u.Base
+		index++
+	}
+	return u.Names[index], scale
+}
+
+// pickForRange picks the unit for the larger magnitude of the ends of a range.
+func (u Units) pickForRange(ra Range) (name string, scale float64) {
+	return u.Pick(math.Max(math.Abs(ra.GetMin()), math.Abs(ra.GetMax())))
+}
+
+// appendUnit appends the unit picked for a range to an axis name, i.e. "Memory (MB)".
+func (u Units) appendUnit(name string, ra Range) string {
+	if u.IsZero() {
+		return name
+	}
+	unit, _ := u.pickForRange(ra)
+	if len(unit) == 0 {
+		return name
+	}
+	if len(name) == 0 {
+		return unit
+	}
+	return name + " (" + unit + ")"
+}
+
+// scaledValueFormatter returns a formatter of values in the unit picked for a range.
+func (u Units) scaledValueFormatter(ra Range, vf ValueFormatter) ValueFormatter {
+	if u.IsZero() {
+		return vf
+	}
+	_, scale := u.pickForRange(ra)
+	return func(v interface{}) string {
+		if typed, isTyped := v.(float64); isTyped {
+			return vf(typed / scale)
+		}
+		return vf(v)
+	}
+}
+
+// generateTicks generates continuous ticks in the unit picked for a range, so the ticks fall on round values of
+// the unit rather than of the first unit.
+func (u Units) generateTicks(r Renderer, ra Range, isVertical bool, style Style, vf ValueFormatter) []Tick {
+	_, scale := u.pickForRange(ra)
+	scaled := &ContinuousRange{
+		Min:        ra.GetMin() / scale,
+		Max:        ra.GetMax() / scale,
+		Domain:     ra.GetDomain(),
+		Descending: ra.IsDescending(),
+	}
+	ticks := GenerateContinuousTicks(r, scaled, isVertical, style, vf)
+	for index := range ticks {
+		ticks[index].Value *= scale
+	}
+	return ticks
+}
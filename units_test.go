@@ -0,0 +1,39 @@
+package chart
+
+import (
+	"testing"
+
+	assert "github.com/blendlabs/go-assert"
+)
+
+func TestUnitsPick(t *testing.T) {
+	assert := assert.New(t)
+
+	name, scale := UnitsBytes.Pick(512)
+	assert.Equal("B", name)
+	assert.Equal(1.0, scale)
+
+	name, scale = UnitsBytes.Pick(3 * 1024 * 1024)
+	assert.Equal("MB", name)
+	assert.Equal(1024.0*1024.0, scale)
+
+	name, _ = UnitsBytesSI.Pick(-2.5e9)
+	assert.Equal("GB", name)
+
+	// magnitudes past the largest unit stay in it.
+	name, _ = UnitsBitsPerSecond.Pick(1e20)
+	assert.Equal("Tbps", name)
+
+	name, scale = Units{}.Pick(1e6)
+	assert.Empty(name)
+	assert.Equal(1.0, scale)
+}
+
+func TestUnitsAppendUnit(t *testing.T) {
+	assert := assert.New(t)
+
+	ra := &ContinuousRange{Min: 0, Max: 5e6}
+	assert.Equal("Memory (MB)", UnitsBytesSI.appendUnit("Memory", ra))
+	assert.Equal("MB", UnitsBytesSI.appendUnit("", ra))
+	assert.Equal("Memory", Units{}.appendUnit("Memory", ra))
+}
@@ -47,7 +47,11 @@ func (vs Values) Normalize() []Value {
 
 // Value2 is a two axis value.
 type Value2 struct {
+	// Style overrides the series style for this value, i.e. to color a single annotation by severity.
 	Style          Style
 	Label          string
 	XValue, YValue float64
+
+	// Priority decides which labels win when overlapping labels are resolved; higher priorities are kept in place.
+	Priority int
 }
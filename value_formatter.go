@@ -2,6 +2,7 @@ package chart
 
 import (
 	"fmt"
+	"math"
 	"time"
 )
 
@@ -37,6 +38,26 @@ func TimeValueFormatterWithFormat(v interface{}, dateFormat string) string {
 	return ""
 }
 
+// TimeYearChangeTickFormatter is a TickFormatter for timestamps that only includes the year
+// on the first tick and when the year changes from the previous tick.
+func TimeYearChangeTickFormatter(v interface{}, tc TickContext) string {
+	return TimeYearChangeTickFormatterWithFormat(v, tc, DefaultDateMonthDayFormat, DefaultDateFormat)
+}
+
+// TimeYearChangeTickFormatterWithFormat is a TickFormatter for timestamps with a given format, and a given format
+// (that should include the year) for the first tick and ticks where the year changes.
+func TimeYearChangeTickFormatterWithFormat(v interface{}, tc TickContext, dateFormat, yearDateFormat string) string {
+	if !tc.HasPrevious {
+		return TimeValueFormatterWithFormat(v, yearDateFormat)
+	}
+	current := TimeValueFormatterWithFormat(v, "2006")
+	previous := TimeValueFormatterWithFormat(tc.Previous, "2006")
+	if current != previous {
+		return TimeValueFormatterWithFormat(v, yearDateFormat)
+	}
+	return TimeValueFormatterWithFormat(v, dateFormat)
+}
+
 // FloatValueFormatter is a ValueFormatter for float64.
 func FloatValueFormatter(v interface{}) string {
 	return FloatValueFormatterWithFormat(v, DefaultFloatFormat)
@@ -67,3 +88,70 @@ func FloatValueFormatterWithFormat(v interface{}, floatFormat string) string {
 	}
 	return ""
 }
+
+// PrefixValueFormatter returns a ValueFormatter that prefixes the output of another formatter, i.e. with "$".
+// Empty output is left empty.
+func PrefixValueFormatter(prefix string, vf ValueFormatter) ValueFormatter {
+	return func(v interface{}) string {
+		if output := vf(v); len(output) > 0 {
+			return prefix + output
+		}
+		return ""
+	}
+}
+
+// SuffixValueFormatter returns a ValueFormatter that suffixes the output of another formatter, i.e. with "ms".
+// Empty output is left empty.
+func SuffixValueFormatter(suffix string, vf ValueFormatter) ValueFormatter {
+	return func(v interface{}) string {
+		if output := vf(v); len(output) > 0 {
+			return output + suffix
+		}
+		return ""
+	}
+}
+
+// FallbackValueFormatter returns a ValueFormatter that returns the first non-empty output of a list of formatters,
+// i.e. to format both times and floats.
+func FallbackValueFormatter(formatters ...ValueFormatter) ValueFormatter {
+	return func(v interface{}) string {
+		for _, vf := range formatters {
+			if output := vf(v); len(output) > 0 {
+				return output
+			}
+		}
+		return ""
+	}
+}
+
+// DeltaPrecisionValueFormatter returns a float ValueFormatter with just enough decimals to show values a given
+// delta apart, i.e. the step between axis ticks: a delta of 0.25 formats with 2 decimals and a delta of 50 with none.
+// Use `DeltaPrecisionTickFormatter` as an axis tick formatter to take the delta from the ticks of the axis.
+func DeltaPrecisionValueFormatter(delta float64) ValueFormatter {
+	return func(v interface{}) string {
+		return FloatValueFormatterWithFormat(v, fmt.Sprintf("%%0.%df", deltaPrecision(delta)))
+	}
+}
+
+// DeltaPrecisionTickFormatter is a TickFormatter for floats with just enough decimals to show the step between the
+// ticks of the axis (see `DeltaPrecisionValueFormatter`), so the precision follows the range of the axis.
+func DeltaPrecisionTickFormatter(v interface{}, tc TickContext) string {
+	return DeltaPrecisionValueFormatter(tc.Step)(v)
+}
+
+// deltaPrecision returns the number of decimals needed to show a delta exactly, up to two decimals more than its
+// order of magnitude for deltas that do not terminate (i.e. 1/3).
+func deltaPrecision(delta float64) int {
+	delta = math.Abs(delta)
+	if delta == 0 || math.IsNaN(delta) || math.IsInf(delta, 0) {
+		return 2
+	}
+	limit := Math.MaxInt(int(math.Ceil(-math.Log10(delta))), 0) + 2
+	for decimals := 0; decimals < limit; decimals++ {
+		scaled := delta * math.Pow(10, float64(decimals))
+		if math.Abs(scaled-math.Floor(scaled+0.5)) < 1e-6 {
+			return decimals
+		}
+	}
+	return limit
+}
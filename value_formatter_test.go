@@ -1,6 +1,7 @@
 package chart
 
 import (
+	"strings"
 	"testing"
 	"time"
 
@@ -56,3 +57,78 @@ func TestFloatValueFormatterWithFormat(t *testing.T) {
 	assert.Equal("123.456", sv)
 	assert.Equal("123.000", FloatValueFormatterWithFormat(123, "%.3f"))
 }
+
+func TestTimeYearChangeTickFormatter(t *testing.T) {
+	assert := assert.New(t)
+
+	dates := []time.Time{
+		time.Date(2016, 11, 01, 12, 0, 0, 0, time.Local),
+		time.Date(2016, 12, 01, 12, 0, 0, 0, time.Local),
+		time.Date(2017, 01, 01, 12, 0, 0, 0, time.Local),
+		time.Date(2017, 02, 01, 12, 0, 0, 0, time.Local),
+	}
+	var ticks []Tick
+	for _, d := range dates {
+		ticks = append(ticks, Tick{Value: Time.ToFloat64(d)})
+	}
+
+	formatted := FormatTicks(ticks, TimeYearChangeTickFormatter)
+	assert.Equal("2016-11-01", formatted[0].Label)
+	assert.Equal("12-01", formatted[1].Label)
+	assert.Equal("2017-01-01", formatted[2].Label)
+	assert.Equal("02-01", formatted[3].Label)
+}
+
+func TestValueFormatterCombinators(t *testing.T) {
+	assert := assert.New(t)
+
+	dollars := PrefixValueFormatter("$", FloatValueFormatter)
+	assert.Equal("$1.50", dollars(1.5))
+	assert.Equal("", dollars("nope"))
+
+	millis := SuffixValueFormatter("ms", DeltaPrecisionValueFormatter(50))
+	assert.Equal("150ms", millis(150.0))
+
+	either := FallbackValueFormatter(FloatValueFormatter, TimeValueFormatter)
+	assert.Equal("2.00", either(2.0))
+	assert.Equal("2017-01-02", either(time.Date(2017, 01, 02, 0, 0, 0, 0, time.UTC)))
+	assert.Equal("", FallbackValueFormatter()(1.0))
+}
+
+func TestDeltaPrecisionValueFormatter(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal("1", DeltaPrecisionValueFormatter(1)(1.0))
+	assert.Equal("0.25", DeltaPrecisionValueFormatter(0.25)(0.25))
+	assert.Equal("0.333", DeltaPrecisionValueFormatter(1.0/3.0)(1.0/3.0))
+	assert.Equal("12", DeltaPrecisionValueFormatter(-50)(12.4))
+	assert.Equal("0.0010", DeltaPrecisionValueFormatter(0.0005)(0.001))
+	assert.Equal("3.14", DeltaPrecisionValueFormatter(0)(3.14159))
+}
+
+func TestDeltaPrecisionTickFormatter(t *testing.T) {
+	assert := assert.New(t)
+
+	r, err := PNG(1024, 1024)
+	assert.Nil(err)
+	f, err := GetDefaultFont()
+	assert.Nil(err)
+
+	decimals := func(ra Range) int {
+		xa := XAxis{TickFormatter: DeltaPrecisionTickFormatter}
+		ticks := xa.GetTicks(r, ra, Style{Font: f, FontSize: 10.0}, FloatValueFormatter)
+		assert.True(len(ticks) > 2)
+		label := ticks[1].Label
+		if dot := strings.Index(label, "."); dot >= 0 {
+			return len(label) - dot - 1
+		}
+		return 0
+	}
+
+	wide := decimals(&ContinuousRange{Min: 0, Max: 1000, Domain: 1024})
+	narrow := decimals(&ContinuousRange{Min: 0, Max: 1, Domain: 1024})
+	narrower := decimals(&ContinuousRange{Min: 0, Max: 0.01, Domain: 1024})
+	assert.Zero(wide)
+	assert.True(narrow > wide)
+	assert.True(narrower > narrow)
+}
@@ -33,3 +33,19 @@ type FullBoundedValueProvider interface {
 	BoundedValueProvider
 	BoundedLastValueProvider
 }
+
+// XRangeValueProvider is a type whose values span the chart's x range instead of providing their own x values,
+// i.e. a flat target line. The chart injects the resolved x range; these types do not contribute to the x range.
+type XRangeValueProvider interface {
+	WithXRange(xrange Range) ValueProvider
+}
+
+// PercentileValueProvider is a type that produces pre-aggregated percentiles for each x value,
+// i.e. the p50, p90 and p99 latency of each minute.
+type PercentileValueProvider interface {
+	Len() int
+	// GetPercentiles returns the percentiles (0-100) every value has, in ascending order.
+	GetPercentiles() []float64
+	// GetPercentileValues returns the x value and the y value of each percentile at an index.
+	GetPercentileValues(index int) (x float64, ys []float64)
+}
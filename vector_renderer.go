@@ -2,10 +2,12 @@ package chart
 
 import (
 	"bytes"
+	"encoding/xml"
 	"fmt"
 	"io"
 	"math"
 	"strings"
+	"sync/atomic"
 
 	"golang.org/x/image/font"
 
@@ -13,16 +15,20 @@ import (
 	"github.com/wcharczuk/go-chart/drawing"
 )
 
+// svgRenderers counts the svg renderers created, so each one's clip path ids are unique within a page
+// that inlines several charts.
+var svgRenderers uint64
+
 // SVG returns a new png/raster renderer.
 func SVG(width, height int) (Renderer, error) {
 	buffer := bytes.NewBuffer([]byte{})
 	canvas := newCanvas(buffer)
 	canvas.Start(width, height)
 	return &vectorRenderer{
-		b: buffer,
-		c: canvas,
-		s: &Style{},
-		p: []string{},
+		b:      buffer,
+		c:      canvas,
+		s:      &Style{},
+		clipID: fmt.Sprintf("clip-%d", atomic.AddUint64(&svgRenderers, 1)),
 	}, nil
 }
 
@@ -32,8 +38,16 @@ type vectorRenderer struct {
 	b   *bytes.Buffer
 	c   *canvas
 	s   *Style
-	p   []string
+	p   []PathCommand
 	fc  *font.Drawer
+
+	clipID    string
+	clips     int
+	isClipped bool
+
+	// budget is the size limit in bytes of the saved svg; when set, elements are recorded and written at save.
+	budget   int
+	elements []func(c *canvas, level int)
 }
 
 func (vr *vectorRenderer) ResetStyle() {
@@ -74,17 +88,17 @@ func (vr *vectorRenderer) SetStrokeDashArray(dashArray []float64) {
 
 // MoveTo implements the interface method.
 func (vr *vectorRenderer) MoveTo(x, y int) {
-	vr.p = append(vr.p, fmt.Sprintf("M %d %d", x, y))
+	vr.p = append(vr.p, PathCommand{Command: "M", X: x, Y: y})
 }
 
 // LineTo implements the interface method.
 func (vr *vectorRenderer) LineTo(x, y int) {
-	vr.p = append(vr.p, fmt.Sprintf("L %d %d", x, y))
+	vr.p = append(vr.p, PathCommand{Command: "L", X: x, Y: y})
 }
 
 // QuadCurveTo draws a quad curve.
 func (vr *vectorRenderer) QuadCurveTo(cx, cy, x, y int) {
-	vr.p = append(vr.p, fmt.Sprintf("Q%d,%d %d,%d", cx, cy, x, y))
+	vr.p = append(vr.p, PathCommand{Command: "Q", CX: cx, CY: cy, X: x, Y: y})
 }
 
 func (vr *vectorRenderer) ArcTo(cx, cy int, rx, ry, startAngle, delta float64) {
@@ -95,22 +109,20 @@ func (vr *vectorRenderer) ArcTo(cx, cy int, rx, ry, startAngle, delta float64) {
 	starty := cy - int(ry*math.Cos(startAngle))
 
 	if len(vr.p) > 0 {
-		vr.p = append(vr.p, fmt.Sprintf("L %d %d", startx, starty))
+		vr.p = append(vr.p, PathCommand{Command: "L", X: startx, Y: starty})
 	} else {
-		vr.p = append(vr.p, fmt.Sprintf("M %d %d", startx, starty))
+		vr.p = append(vr.p, PathCommand{Command: "M", X: startx, Y: starty})
 	}
 
 	endx := cx + int(rx*math.Sin(endAngle))
 	endy := cy - int(ry*math.Cos(endAngle))
 
-	dd := Math.RadiansToDegrees(delta)
-
-	vr.p = append(vr.p, fmt.Sprintf("A %d %d %0.2f 0 1 %d %d", int(rx), int(ry), dd, endx, endy))
+	vr.p = append(vr.p, PathCommand{Command: "A", CX: cx, CY: cy, RX: rx, RY: ry, StartAngle: startAngle, Delta: delta, X: endx, Y: endy})
 }
 
 // Close closes a shape.
 func (vr *vectorRenderer) Close() {
-	vr.p = append(vr.p, fmt.Sprintf("Z"))
+	vr.p = append(vr.p, PathCommand{Command: "Z"})
 }
 
 // Stroke draws the path with no fill.
@@ -130,13 +142,23 @@ func (vr *vectorRenderer) FillStroke() {
 
 // drawPath draws a path.
 func (vr *vectorRenderer) drawPath(s Style) {
-	vr.c.Path(strings.Join(vr.p, "\n"), vr.s.GetFillAndStrokeOptions())
-	vr.p = []string{} // clear the path
+	path, style := vr.p, vr.s.GetFillAndStrokeOptions()
+	vr.draw(func(c *canvas, level int) {
+		if d := svgPathData(path, level); len(d) > 0 {
+			c.Path(d, style)
+		}
+	})
+	vr.p = nil // clear the path
 }
 
 // Circle implements the interface method.
 func (vr *vectorRenderer) Circle(radius float64, x, y int) {
-	vr.c.Circle(x, y, int(radius), vr.s.GetFillAndStrokeOptions())
+	style := vr.s.GetFillAndStrokeOptions()
+	vr.draw(func(c *canvas, level int) {
+		if int(radius) > 0 || level < svgSubPixelLevel {
+			c.Circle(x, y, int(radius), style)
+		}
+	})
 }
 
 // SetFont implements the interface method.
@@ -156,19 +178,29 @@ func (vr *vectorRenderer) SetFontSize(size float64) {
 
 // Text draws a text blob.
 func (vr *vectorRenderer) Text(body string, x, y int) {
-	vr.c.Text(x, y, body, vr.s.GetTextOptions())
+	theta, style := vr.c.textTheta, vr.s.GetTextOptions()
+	vr.draw(func(c *canvas, _ int) {
+		c.textTheta = theta
+		c.Text(x, y, body, style)
+	})
 }
 
 // MeasureText uses the truetype font drawer to measure the width of text.
 func (vr *vectorRenderer) MeasureText(body string) (box Box) {
 	if vr.s.GetFont() != nil {
-		vr.fc = &font.Drawer{
-			Face: truetype.NewFace(vr.s.GetFont(), &truetype.Options{
-				DPI:  vr.dpi,
-				Size: vr.s.FontSize,
-			}),
+		key := textMeasureKey{font: vr.s.GetFont(), size: vr.s.FontSize, dpi: vr.dpi, text: body}
+		w, ok := _textMeasureCache.get(key)
+		if !ok {
+			vr.fc = &font.Drawer{
+				Face: truetype.NewFace(vr.s.GetFont(), &truetype.Options{
+					DPI:  vr.dpi,
+					Size: vr.s.FontSize,
+				}),
+			}
+			// svg viewers reorder right-to-left text themselves, but the shaped forms determine the drawn width.
+			w = vr.fc.MeasureString(Text.Shape(body)).Ceil()
+			_textMeasureCache.set(key, w)
 		}
-		w := vr.fc.MeasureString(body).Ceil()
 
 		box.Right = w
 		box.Bottom = int(drawing.PointsToPixels(vr.dpi, vr.s.FontSize))
@@ -190,13 +222,77 @@ func (vr *vectorRenderer) ClearTextRotation() {
 	vr.c.textTheta = nil
 }
 
+// SetDescription writes the description as the svg `<desc>` element.
+func (vr *vectorRenderer) SetDescription(description string) {
+	vr.draw(func(c *canvas, _ int) {
+		c.Desc(description)
+	})
+}
+
+// StartGroup implements `GroupRenderer`.
+func (vr *vectorRenderer) StartGroup(id string) {
+	vr.draw(func(c *canvas, _ int) {
+		c.StartGroup(id)
+	})
+}
+
+// EndGroup implements `GroupRenderer`.
+func (vr *vectorRenderer) EndGroup() {
+	vr.draw(func(c *canvas, _ int) {
+		c.EndGroup()
+	})
+}
+
+// Clip implements `ClipRenderer` as a `<clipPath>` applied to a `<g>` around subsequent elements.
+func (vr *vectorRenderer) Clip() {
+	vr.ResetClip()
+	vr.clips++
+	id, path := fmt.Sprintf("%s-%d", vr.clipID, vr.clips), vr.p
+	vr.draw(func(c *canvas, level int) {
+		c.StartClip(id, svgPathData(path, level))
+	})
+	vr.p = nil
+	vr.isClipped = true
+}
+
+// ResetClip implements `ClipRenderer`.
+func (vr *vectorRenderer) ResetClip() {
+	if vr.isClipped {
+		vr.EndGroup()
+		vr.isClipped = false
+	}
+}
+
+// getSize implements `sizedRenderer`.
+func (vr *vectorRenderer) getSize() (width, height int) {
+	return vr.c.width, vr.c.height
+}
+
+// GetContentType implements `ContentTypeRenderer`.
+func (vr *vectorRenderer) GetContentType() string {
+	return ContentTypeSVG
+}
+
 // Save saves the renderer's contents to a writer.
 func (vr *vectorRenderer) Save(w io.Writer) error {
+	vr.ResetClip()
+	if vr.budget > 0 {
+		return vr.saveWithBudget(w)
+	}
 	vr.c.End()
 	_, err := w.Write(vr.b.Bytes())
 	return err
 }
 
+// draw writes an element to the canvas, or records it to be written at save if there is a size budget.
+func (vr *vectorRenderer) draw(element func(c *canvas, level int)) {
+	if vr.budget == 0 {
+		element(vr.c, 0)
+		return
+	}
+	vr.elements = append(vr.elements, element)
+}
+
 func newCanvas(w io.Writer) *canvas {
 	return &canvas{
 		w: w,
@@ -217,6 +313,27 @@ func (c *canvas) Start(width, height int) {
 	c.w.Write([]byte(fmt.Sprintf(`<svg xmlns="http://www.w3.org/2000/svg" xmlns:xlink="http://www.w3.org/1999/xlink" width="%d" height="%d">\n`, c.width, c.height)))
 }
 
+func (c *canvas) Desc(description string) {
+	c.w.Write([]byte("<desc>"))
+	xml.EscapeText(c.w, []byte(description))
+	c.w.Write([]byte("</desc>\n"))
+}
+
+func (c *canvas) StartGroup(id string) {
+	c.w.Write([]byte(`<g id="`))
+	xml.EscapeText(c.w, []byte(id))
+	c.w.Write([]byte("\">\n"))
+}
+
+func (c *canvas) StartClip(id, d string) {
+	c.w.Write([]byte(fmt.Sprintf("<defs><clipPath id=\"%s\"><path d=\"%s\"/></clipPath></defs>\n", id, d)))
+	c.w.Write([]byte(fmt.Sprintf("<g clip-path=\"url(#%s)\">\n", id)))
+}
+
+func (c *canvas) EndGroup() {
+	c.w.Write([]byte("</g>\n"))
+}
+
 func (c *canvas) Path(d string, style Style) {
 	var strokeDashArrayProperty string
 	if len(style.StrokeDashArray) > 0 {
@@ -235,7 +352,7 @@ func (c *canvas) Text(x, y int, body string, style Style) {
 }
 
 func (c *canvas) Circle(x, y, r int, style Style) {
-	c.w.Write([]byte(fmt.Sprintf(`<circle cx="%d" cy="%d" r="%d" style="%s">`, x, y, r, c.styleAsSVG(style))))
+	c.w.Write([]byte(fmt.Sprintf(`<circle cx="%d" cy="%d" r="%d" style="%s"/>`, x, y, r, c.styleAsSVG(style))))
 }
 
 func (c *canvas) End() {
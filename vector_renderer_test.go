@@ -75,3 +75,21 @@ func TestCanvasStyleSVG(t *testing.T) {
 	assert.True(strings.Contains(svgString, "stroke-width:5"))
 	assert.True(strings.Contains(svgString, "fill:rgba(255,255,255,1.0)"))
 }
+
+func TestVectorRendererDescription(t *testing.T) {
+	assert := assert.New(t)
+
+	c := Chart{
+		Description: "Visits <per> day & more",
+		Series: []Series{
+			ContinuousSeries{
+				XValues: []float64{1.0, 2.0, 3.0},
+				YValues: []float64{1.0, 2.0, 3.0},
+			},
+		},
+	}
+
+	buffer := bytes.NewBuffer([]byte{})
+	assert.Nil(c.Render(SVG, buffer))
+	assert.True(strings.Contains(buffer.String(), "<desc>Visits &lt;per&gt; day &amp; more</desc>"))
+}
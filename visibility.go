@@ -0,0 +1,117 @@
+package chart
+
+// Layout is the output a chart is rendered to; visibility conditions are decided on it.
+type Layout struct {
+	Width  int
+	Height int
+	DPI    float64
+}
+
+// Density returns the number of points per pixel of width, i.e. to hide labels on a dense series.
+func (l Layout) Density(points int) float64 {
+	if l.Width == 0 {
+		return 0
+	}
+	return float64(points) / float64(l.Width)
+}
+
+// sizedRenderer is a renderer that knows the size of its output.
+type sizedRenderer interface {
+	getSize() (width, height int)
+}
+
+// getLayout returns the layout of a renderer's output; renderers that do not know their size are assumed to be
+// the size of the given box.
+func getLayout(r Renderer, fallback Box) Layout {
+	width, height := fallback.Right, fallback.Bottom
+	if sr, isSized := r.(sizedRenderer); isSized {
+		width, height = sr.getSize()
+	}
+	return Layout{Width: width, Height: height, DPI: r.GetDPI()}
+}
+
+// VisibleIf returns an element that is only drawn if a condition holds for the layout,
+// i.e. to hide a legend on narrow outputs.
+func VisibleIf(visibleIf func(Layout) bool, element Renderable) Renderable {
+	return func(r Renderer, canvasBox Box, defaults Style) {
+		if visibleIf(getLayout(r, canvasBox)) {
+			element(r, canvasBox, defaults)
+		}
+	}
+}
+
+// ConditionalSeries is a series that is only shown if a condition holds for the layout,
+// i.e. to hide an annotation series when the output is small.
+// Hidden series keep their place in the chart, so the default colors of the other series do not change.
+type ConditionalSeries struct {
+	InnerSeries Series
+	VisibleIf   func(Layout) bool
+}
+
+// GetName returns the name of the inner series.
+func (cs ConditionalSeries) GetName() string {
+	return cs.InnerSeries.GetName()
+}
+
+// GetStyle returns the style of the inner series.
+func (cs ConditionalSeries) GetStyle() Style {
+	return cs.InnerSeries.GetStyle()
+}
+
+// GetYAxis returns which YAxis the inner series draws on.
+func (cs ConditionalSeries) GetYAxis() YAxisType {
+	return cs.InnerSeries.GetYAxis()
+}
+
+// IsVisible returns if the series is shown for a layout.
+func (cs ConditionalSeries) IsVisible(l Layout) bool {
+	return cs.VisibleIf == nil || cs.VisibleIf(l)
+}
+
+// Render renders the inner series.
+func (cs ConditionalSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
+	if cs.IsVisible(getLayout(r, canvasBox)) {
+		cs.InnerSeries.Render(r, canvasBox, xrange, yrange, defaults)
+	}
+}
+
+// Validate validates the inner series.
+func (cs ConditionalSeries) Validate() error {
+	return cs.InnerSeries.Validate()
+}
+
+// hiddenSeries is a series hidden by its condition.
+type hiddenSeries struct {
+	Series
+}
+
+// GetStyle returns a style that hides the series.
+func (hs hiddenSeries) GetStyle() Style {
+	style := hs.Series.GetStyle()
+	style.Show = false
+	if style.IsZero() {
+		style.StrokeColor = ColorTransparent
+	}
+	return style
+}
+
+// Render renders nothing.
+func (hs hiddenSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {}
+
+// getLayoutSeries returns the series with the conditional series resolved for a layout; shown series are replaced
+// by their inner series and hidden series by a placeholder.
+func (c Chart) getLayoutSeries(l Layout) []Series {
+	output := make([]Series, len(c.Series))
+	for index, s := range c.Series {
+		if cs, isConditional := s.(ConditionalSeries); isConditional {
+			if cs.IsVisible(l) {
+				output[index] = cs.InnerSeries
+			} else {
+				output[index] = hiddenSeries{cs.InnerSeries}
+			}
+			continue
+		}
+		output[index] = s
+	}
+	return output
+}
@@ -0,0 +1,72 @@
+package chart
+
+import (
+	"bytes"
+	"testing"
+
+	assert "github.com/blendlabs/go-assert"
+)
+
+func TestLayoutDensity(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(0.5, Layout{Width: 200}.Density(100))
+	assert.Zero(Layout{}.Density(100))
+}
+
+func TestVisibleIf(t *testing.T) {
+	assert := assert.New(t)
+
+	var drawn []Layout
+	element := VisibleIf(func(l Layout) bool { return l.Width >= 300 }, func(r Renderer, canvasBox Box, defaults Style) {
+		drawn = append(drawn, getLayout(r, canvasBox))
+	})
+
+	for _, width := range []int{200, 400} {
+		c := Chart{
+			Width:    width,
+			Height:   200,
+			Series:   []Series{ContinuousSeries{XValues: []float64{1, 2}, YValues: []float64{1, 2}}},
+			Elements: []Renderable{element},
+		}
+		assert.Nil(c.Render(PNG, bytes.NewBuffer(nil)))
+		assert.Nil(c.Render(SVG, bytes.NewBuffer(nil)))
+	}
+	assert.Len(drawn, 2)
+	assert.Equal(400, drawn[0].Width)
+	assert.Equal(200, drawn[0].Height)
+}
+
+func TestConditionalSeries(t *testing.T) {
+	assert := assert.New(t)
+
+	labels := AnnotationSeries{Annotations: []Value2{{XValue: 1, YValue: 1, Label: "one"}}}
+	c := Chart{
+		Width: 200,
+		Series: []Series{
+			ConditionalSeries{
+				InnerSeries: ContinuousSeries{Name: "wide", XValues: []float64{1, 2}, YValues: []float64{1, 2}},
+				VisibleIf:   func(l Layout) bool { return l.Width > 300 },
+			},
+			ContinuousSeries{Name: "always", XValues: []float64{1, 2}, YValues: []float64{1, 2}},
+			ConditionalSeries{
+				InnerSeries: labels,
+				VisibleIf:   func(l Layout) bool { return l.Density(2) < 0.1 },
+			},
+		},
+	}
+	assert.Equal("wide", c.Series[0].GetName())
+
+	series := c.getLayoutSeries(Layout{Width: 200})
+	assert.Len(series, 3)
+	assert.False(series[0].GetStyle().IsZero() || series[0].GetStyle().Show)
+	assert.True(series[1].GetStyle().IsZero())
+	_, isAnnotationSeries := series[2].(AnnotationSeries)
+	assert.True(isAnnotationSeries)
+
+	series = c.getLayoutSeries(Layout{Width: 10})
+	assert.False(series[2].GetStyle().IsZero() || series[2].GetStyle().Show)
+
+	c.Elements = []Renderable{Legend(&c)}
+	assert.Nil(c.Render(PNG, bytes.NewBuffer(nil)))
+}
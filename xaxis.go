@@ -1,6 +1,9 @@
 package chart
 
-import "math"
+import (
+	"math"
+	"time"
+)
 
 // XAxis represents the horizontal axis.
 type XAxis struct {
@@ -10,14 +13,29 @@ type XAxis struct {
 	Style          Style
 	ValueFormatter ValueFormatter
 	Range          Range
+	// RangeFunc, if set, picks the range from the min and max of the data once the series are scanned;
+	// it is not used if the range or the ticks are set.
+	RangeFunc RangeFunc
+
+	// TickFormatter, if set, labels generated ticks with the context of the other ticks.
+	TickFormatter TickFormatter
 
 	TickStyle    Style
 	Ticks        []Tick
 	TickPosition TickPosition
 
+	// TickChipStyle, if shown, draws a filled, rounded chip behind each (unrotated) tick label;
+	// its padding is added to the axis measurement.
+	TickChipStyle Style
+
 	GridLines      []GridLine
 	GridMajorStyle Style
 	GridMinorStyle Style
+
+	// Now, if set, labels the (time) axis relative to the instant, i.e. "-6h", "-1d" and "now",
+	// in place of the value formatter, and marks the instant with the NowStyle.
+	Now      time.Time
+	NowStyle Style
 }
 
 // GetName returns the name.
@@ -46,15 +64,24 @@ func (xa XAxis) GetTickPosition(defaults ...TickPosition) TickPosition {
 // 	- User Supplied Ticks (i.e. Ticks array on the axis itself).
 // 	- Range ticks (i.e. if the range provides ticks).
 //	- Generating continuous ticks based on minimum spacing and canvas width.
+// Generated ticks are relabeled with the TickFormatter if it is set, dropping ticks if the new labels do not fit.
 func (xa XAxis) GetTicks(r Renderer, ra Range, defaults Style, vf ValueFormatter) []Tick {
 	if len(xa.Ticks) > 0 {
 		return xa.Ticks
 	}
-	if tp, isTickProvider := ra.(TicksProvider); isTickProvider {
-		return tp.GetTicks(r, defaults, vf)
+	var ticks []Tick
+	if !xa.Now.IsZero() {
+		ticks = GenerateRelativeTimeTicks(r, ra, xa.Style.InheritFrom(defaults), xa.Now)
+	} else if tp, isTickProvider := ra.(TicksProvider); isTickProvider {
+		ticks = tp.GetTicks(r, defaults, vf)
+	} else {
+		tickStyle := xa.Style.InheritFrom(defaults)
+		ticks = GenerateContinuousTicks(r, ra, false, tickStyle, vf)
 	}
-	tickStyle := xa.Style.InheritFrom(defaults)
-	return GenerateContinuousTicks(r, ra, false, tickStyle, vf)
+	if xa.TickFormatter != nil {
+		return formatTicksToFit(r, ticks, false, xa.Style.InheritFrom(defaults), xa.TickFormatter)
+	}
+	return ticks
 }
 
 // GetGridLines returns the gridlines for the axis.
@@ -65,12 +92,22 @@ func (xa XAxis) GetGridLines(ticks []Tick) []GridLine {
 	return GenerateGridLines(ticks, xa.GridMajorStyle, xa.GridMinorStyle)
 }
 
+// getGridLines returns the gridlines for the axis, from the range if it provides them.
+func (xa XAxis) getGridLines(ra Range, ticks []Tick, isVertical bool) []GridLine {
+	if glp, isGridLineProvider := ra.(GridLineProvider); isGridLineProvider && len(xa.GridLines) == 0 {
+		return glp.GetGridLines(ticks, isVertical, xa.GridMajorStyle, xa.GridMinorStyle)
+	}
+	return xa.GetGridLines(ticks)
+}
+
 // Measure returns the bounds of the axis.
 func (xa XAxis) Measure(r Renderer, canvasBox Box, ra Range, defaults Style, ticks []Tick) Box {
 	tickStyle := xa.TickStyle.InheritFrom(xa.Style.InheritFrom(defaults))
 
 	tp := xa.GetTickPosition()
 
+	chip := xa.getTickChipPadding(tickStyle)
+
 	var ltx, rtx int
 	var tx, ty int
 	var left, right, bottom = math.MaxInt32, 0, 0
@@ -79,15 +116,15 @@ func (xa XAxis) Measure(r Renderer, canvasBox Box, ra Range, defaults Style, tic
 		tb := Draw.MeasureText(r, t.Label, tickStyle.GetTextOptions())
 
 		tx = canvasBox.Left + ra.Translate(v)
-		ty = canvasBox.Bottom + DefaultXAxisMargin + tb.Height()
+		ty = canvasBox.Bottom + DefaultXAxisMargin + tb.Height() + chip.Top + chip.Bottom
 		switch tp {
 		case TickPositionUnderTick, TickPositionUnset:
-			ltx = tx - tb.Width()>>1
-			rtx = tx + tb.Width()>>1
+			ltx = tx - tb.Width()>>1 - chip.Left
+			rtx = tx + tb.Width()>>1 + chip.Right
 			break
 		case TickPositionBetweenTicks:
 			if index > 0 {
-				ltx = ra.Translate(ticks[index-1].Value)
+				ltx = canvasBox.Left + ra.Translate(ticks[index-1].Value)
 				rtx = tx
 			}
 			break
@@ -121,6 +158,7 @@ func (xa XAxis) Render(r Renderer, canvasBox Box, ra Range, defaults Style, tick
 	r.Stroke()
 
 	tp := xa.GetTickPosition()
+	chip := xa.getTickChipPadding(tickStyle)
 
 	var tx, ty int
 	var maxTextHeight int
@@ -142,12 +180,15 @@ func (xa XAxis) Render(r Renderer, canvasBox Box, ra Range, defaults Style, tick
 		case TickPositionUnderTick, TickPositionUnset:
 			if tickStyle.TextRotationDegrees == 0 {
 				tx = tx - tb.Width()>>1
-				ty = canvasBox.Bottom + DefaultXAxisMargin + tb.Height()
+				ty = canvasBox.Bottom + DefaultXAxisMargin + chip.Top + tb.Height()
+				if xa.TickChipStyle.Show {
+					Draw.LabelChip(r, Box{Top: ty - tb.Height(), Left: tx, Right: tx + tb.Width(), Bottom: ty}, xa.TickChipStyle)
+				}
 			} else {
 				ty = canvasBox.Bottom + (2 * DefaultXAxisMargin)
 			}
 			Draw.Text(r, t.Label, tx, ty, tickWithAxisStyle)
-			maxTextHeight = Math.MaxInt(maxTextHeight, tb.Height())
+			maxTextHeight = Math.MaxInt(maxTextHeight, tb.Height()+chip.Top+chip.Bottom)
 			break
 		case TickPositionBetweenTicks:
 			if index > 0 {
@@ -178,7 +219,7 @@ func (xa XAxis) Render(r Renderer, canvasBox Box, ra Range, defaults Style, tick
 	}
 
 	if xa.GridMajorStyle.Show || xa.GridMinorStyle.Show {
-		for _, gl := range xa.GetGridLines(ticks) {
+		for _, gl := range xa.getGridLines(ra, ticks, true) {
 			if (gl.IsMinor && xa.GridMinorStyle.Show) || (!gl.IsMinor && xa.GridMajorStyle.Show) {
 				defaults := xa.GridMajorStyle
 				if gl.IsMinor {
@@ -188,4 +229,34 @@ func (xa XAxis) Render(r Renderer, canvasBox Box, ra Range, defaults Style, tick
 			}
 		}
 	}
+
+	if !xa.Now.IsZero() && (xa.NowStyle.IsZero() || xa.NowStyle.Show) {
+		xa.renderNow(r, canvasBox, ra)
+	}
+}
+
+// getTickChipPadding returns the padding the tick chips add around tick labels, if they are shown.
+func (xa XAxis) getTickChipPadding(tickStyle Style) Box {
+	if !xa.TickChipStyle.Show || tickStyle.TextRotationDegrees != 0 {
+		return Box{}
+	}
+	return labelChipPadding(xa.TickChipStyle)
+}
+
+// renderNow draws the "now" marker, if it is within the range.
+func (xa XAxis) renderNow(r Renderer, canvasBox Box, ra Range) {
+	now := Time.ToFloat64(xa.Now)
+	if now < ra.GetMin() || now > ra.GetMax() {
+		return
+	}
+	style := xa.NowStyle.InheritFrom(Style{
+		StrokeColor:     DefaultNowMarkerColor,
+		StrokeWidth:     1.0,
+		StrokeDashArray: []float64{2.0, 2.0},
+	})
+	x := canvasBox.Left + ra.Translate(now)
+	style.GetStrokeOptions().WriteToRenderer(r)
+	r.MoveTo(x, canvasBox.Top)
+	r.LineTo(x, canvasBox.Bottom)
+	r.Stroke()
 }
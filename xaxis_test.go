@@ -65,3 +65,78 @@ func TestXAxisMeasure(t *testing.T) {
 	assert.Equal(122, xab.Width())
 	assert.Equal(21, xab.Height())
 }
+
+func TestXAxisMeasureTickChip(t *testing.T) {
+	assert := assert.New(t)
+
+	f, err := GetDefaultFont()
+	assert.Nil(err)
+	style := Style{
+		Font:     f,
+		FontSize: 10.0,
+	}
+	r, err := PNG(100, 100)
+	assert.Nil(err)
+	ticks := []Tick{{Value: 1.0, Label: "1.0"}, {Value: 2.0, Label: "2.0"}, {Value: 3.0, Label: "3.0"}}
+	xa := XAxis{TickChipStyle: StyleShow()}
+	xab := xa.Measure(r, Box{0, 0, 100, 100}, &ContinuousRange{Min: 1.0, Max: 3.0, Domain: 100}, style, ticks)
+	assert.Equal(130, xab.Width())
+	assert.Equal(25, xab.Height())
+}
+
+func TestXAxisGetTicksWithTickFormatter(t *testing.T) {
+	assert := assert.New(t)
+
+	r, err := PNG(1024, 1024)
+	assert.Nil(err)
+
+	f, err := GetDefaultFont()
+	assert.Nil(err)
+
+	xa := XAxis{
+		TickFormatter: func(v interface{}, tc TickContext) string {
+			if tc.IsLast() {
+				return "last"
+			}
+			return FloatValueFormatter(v)
+		},
+	}
+	xr := &ContinuousRange{Min: 10, Max: 100, Domain: 1024}
+	styleDefaults := Style{
+		Font:     f,
+		FontSize: 10.0,
+	}
+	ticks := xa.GetTicks(r, xr, styleDefaults, FloatValueFormatter)
+	assert.Len(ticks, 16)
+	assert.Equal("10.00", ticks[0].Label)
+	assert.Equal("last", ticks[15].Label)
+}
+
+func TestXAxisGetTicksWithWideTickFormatter(t *testing.T) {
+	assert := assert.New(t)
+
+	r, err := PNG(1024, 1024)
+	assert.Nil(err)
+
+	f, err := GetDefaultFont()
+	assert.Nil(err)
+
+	xa := XAxis{
+		TickFormatter: func(v interface{}, tc TickContext) string {
+			return "value " + FloatValueFormatter(v) + " of the series"
+		},
+	}
+	xr := &ContinuousRange{Min: 10, Max: 100, Domain: 1024}
+	styleDefaults := Style{
+		Font:     f,
+		FontSize: 10.0,
+	}
+	ticks := xa.GetTicks(r, xr, styleDefaults, FloatValueFormatter)
+	assert.True(len(ticks) > 2)
+	assert.True(len(ticks) < 16)
+	styleDefaults.GetTextOptions().WriteToRenderer(r)
+	for index := 1; index < len(ticks); index++ {
+		spacing := xr.Translate(ticks[index].Value) - xr.Translate(ticks[index-1].Value)
+		assert.True(spacing >= r.MeasureText(ticks[index].Label).Width(), ticks[index].Label)
+	}
+}
@@ -7,6 +7,9 @@ import "math"
 type YAxis struct {
 	Name      string
 	NameStyle Style
+	// NameColorHint draws the name in the color of the (first) series mapped to the axis, so the axes
+	// of a dual axis chart read without a legend. A font color set on the name style wins.
+	NameColorHint bool
 
 	Style Style
 
@@ -15,12 +18,28 @@ type YAxis struct {
 	AxisType  YAxisType
 	Ascending bool
 
+	// Side is the side of the canvas the axis is drawn on; see `YAxisSideUnset` for the defaults.
+	Side YAxisSide
+
 	ValueFormatter ValueFormatter
-	Range          Range
+	// Units, if set, labels the whole axis in a single unit picked from the magnitude of its range (i.e. MB),
+	// and appends the unit to the axis name, in place of mixing units across the tick labels.
+	Units Units
+	Range Range
+	// RangeFunc, if set, picks the range from the min and max of the data once the series are scanned, in place of
+	// rounding it to the tick spacing; it is not used if the range or the ticks are set.
+	RangeFunc RangeFunc
+
+	// TickFormatter, if set, labels generated ticks with the context of the other ticks.
+	TickFormatter TickFormatter
 
 	TickStyle Style
 	Ticks     []Tick
 
+	// TickChipStyle, if shown, draws a filled, rounded chip behind each (unrotated) tick label;
+	// its padding is added to the axis measurement.
+	TickChipStyle Style
+
 	GridLines      []GridLine
 	GridMajorStyle Style
 	GridMinorStyle Style
@@ -31,6 +50,11 @@ func (ya YAxis) GetName() string {
 	return ya.Name
 }
 
+// getName returns the name as drawn, with the unit of the range appended if the axis has units.
+func (ya YAxis) getName(ra Range) string {
+	return ya.Units.appendUnit(ya.Name, ra)
+}
+
 // GetNameStyle returns the name style.
 func (ya YAxis) GetNameStyle() Style {
 	return ya.NameStyle
@@ -51,15 +75,25 @@ func (ya YAxis) GetTickStyle() Style {
 // 	- User Supplied Ticks (i.e. Ticks array on the axis itself).
 // 	- Range ticks (i.e. if the range provides ticks).
 //	- Generating continuous ticks based on minimum spacing and canvas width.
+// Generated ticks are relabeled with the TickFormatter if it is set, dropping ticks if the new labels do not fit.
 func (ya YAxis) GetTicks(r Renderer, ra Range, defaults Style, vf ValueFormatter) []Tick {
 	if len(ya.Ticks) > 0 {
 		return ya.Ticks
 	}
+	var ticks []Tick
 	if tp, isTickProvider := ra.(TicksProvider); isTickProvider {
-		return tp.GetTicks(r, defaults, vf)
+		ticks = tp.GetTicks(r, defaults, ya.Units.scaledValueFormatter(ra, vf))
+	} else if !ya.Units.IsZero() {
+		tickStyle := ya.Style.InheritFrom(defaults)
+		ticks = ya.Units.generateTicks(r, ra, true, tickStyle, vf)
+	} else {
+		tickStyle := ya.Style.InheritFrom(defaults)
+		ticks = GenerateContinuousTicks(r, ra, true, tickStyle, vf)
+	}
+	if ya.TickFormatter != nil {
+		return formatTicksToFit(r, ticks, true, ya.Style.InheritFrom(defaults), ya.TickFormatter)
 	}
-	tickStyle := ya.Style.InheritFrom(defaults)
-	return GenerateContinuousTicks(r, ra, true, tickStyle, vf)
+	return ticks
 }
 
 // GetGridLines returns the gridlines for the axis.
@@ -70,6 +104,14 @@ func (ya YAxis) GetGridLines(ticks []Tick) []GridLine {
 	return GenerateGridLines(ticks, ya.GridMajorStyle, ya.GridMinorStyle)
 }
 
+// getGridLines returns the gridlines for the axis, from the range if it provides them.
+func (ya YAxis) getGridLines(ra Range, ticks []Tick, isVertical bool) []GridLine {
+	if glp, isGridLineProvider := ra.(GridLineProvider); isGridLineProvider && len(ya.GridLines) == 0 {
+		return glp.GetGridLines(ticks, isVertical, ya.GridMajorStyle, ya.GridMinorStyle)
+	}
+	return ya.GetGridLines(ticks)
+}
+
 // Measure returns the bounds of the axis.
 func (ya YAxis) Measure(r Renderer, canvasBox Box, ra Range, defaults Style, ticks []Tick) Box {
 	var tx int
@@ -79,7 +121,10 @@ func (ya YAxis) Measure(r Renderer, canvasBox Box, ra Range, defaults Style, tic
 		tx = canvasBox.Left - DefaultYAxisMargin
 	}
 
-	ya.TickStyle.InheritFrom(ya.Style.InheritFrom(defaults)).WriteToRenderer(r)
+	tickStyle := ya.TickStyle.InheritFrom(ya.Style.InheritFrom(defaults))
+	tickStyle.WriteToRenderer(r)
+	chip := ya.getTickChipPadding(tickStyle)
+
 	var minx, maxx, miny, maxy = math.MaxInt32, 0, math.MaxInt32, 0
 	var maxTextHeight int
 	for _, t := range ticks {
@@ -88,27 +133,32 @@ func (ya YAxis) Measure(r Renderer, canvasBox Box, ra Range, defaults Style, tic
 
 		tb := r.MeasureText(t.Label)
 		tbh2 := tb.Height() >> 1
+		width := tb.Width() + chip.Left + chip.Right
 		finalTextX := tx
 		if ya.AxisType == YAxisSecondary {
-			finalTextX = tx - tb.Width()
+			finalTextX = tx - width
 		}
 
 		maxTextHeight = Math.MaxInt(tb.Height(), maxTextHeight)
 
 		if ya.AxisType == YAxisPrimary {
 			minx = canvasBox.Right
-			maxx = Math.MaxInt(maxx, tx+tb.Width())
+			maxx = Math.MaxInt(maxx, tx+width)
 		} else if ya.AxisType == YAxisSecondary {
 			minx = Math.MinInt(minx, finalTextX)
 			maxx = Math.MaxInt(maxx, tx)
 		}
 
-		miny = Math.MinInt(miny, ly-tbh2)
-		maxy = Math.MaxInt(maxy, ly+tbh2)
+		miny = Math.MinInt(miny, ly-tbh2-chip.Top)
+		maxy = Math.MaxInt(maxy, ly+tbh2+chip.Bottom)
 	}
 
-	if ya.NameStyle.Show && len(ya.Name) > 0 {
-		maxx += (DefaultYAxisMargin + maxTextHeight)
+	if ya.NameStyle.Show && len(ya.getName(ra)) > 0 {
+		if ya.AxisType == YAxisSecondary {
+			minx -= (DefaultYAxisMargin + maxTextHeight)
+		} else {
+			maxx += (DefaultYAxisMargin + maxTextHeight)
+		}
 	}
 
 	return Box{
@@ -119,6 +169,14 @@ func (ya YAxis) Measure(r Renderer, canvasBox Box, ra Range, defaults Style, tic
 	}
 }
 
+// getTickChipPadding returns the padding the tick chips add around tick labels, if they are shown.
+func (ya YAxis) getTickChipPadding(tickStyle Style) Box {
+	if !ya.TickChipStyle.Show || tickStyle.TextRotationDegrees != 0 {
+		return Box{}
+	}
+	return labelChipPadding(ya.TickChipStyle)
+}
+
 // Render renders the axis.
 func (ya YAxis) Render(r Renderer, canvasBox Box, ra Range, defaults Style, ticks []Tick) {
 	tickStyle := ya.TickStyle.InheritFrom(ya.Style.InheritFrom(defaults))
@@ -140,6 +198,8 @@ func (ya YAxis) Render(r Renderer, canvasBox Box, ra Range, defaults Style, tick
 	r.LineTo(lx, canvasBox.Top)
 	r.Stroke()
 
+	chip := ya.getTickChipPadding(tickStyle)
+
 	var maxTextWidth int
 	var finalTextX, finalTextY int
 	for _, t := range ticks {
@@ -148,14 +208,14 @@ func (ya YAxis) Render(r Renderer, canvasBox Box, ra Range, defaults Style, tick
 
 		tb := Draw.MeasureText(r, t.Label, tickStyle)
 
-		if tb.Width() > maxTextWidth {
-			maxTextWidth = tb.Width()
+		if width := tb.Width() + chip.Left + chip.Right; width > maxTextWidth {
+			maxTextWidth = width
 		}
 
 		if ya.AxisType == YAxisSecondary {
-			finalTextX = tx - tb.Width()
+			finalTextX = tx - tb.Width() - chip.Right
 		} else {
-			finalTextX = tx
+			finalTextX = tx + chip.Left
 		}
 
 		if tickStyle.TextRotationDegrees == 0 {
@@ -174,19 +234,28 @@ func (ya YAxis) Render(r Renderer, canvasBox Box, ra Range, defaults Style, tick
 		}
 		r.Stroke()
 
+		if ya.TickChipStyle.Show && tickStyle.TextRotationDegrees == 0 {
+			Draw.LabelChip(r, Box{Top: finalTextY - tb.Height(), Left: finalTextX, Right: finalTextX + tb.Width(), Bottom: finalTextY}, ya.TickChipStyle)
+			tickStyle.WriteToRenderer(r)
+		}
 		Draw.Text(r, t.Label, finalTextX, finalTextY, tickStyle)
 	}
 
 	nameStyle := ya.NameStyle.InheritFrom(defaults.InheritFrom(Style{TextRotationDegrees: 90}))
-	if ya.NameStyle.Show && len(ya.Name) > 0 {
+	if name := ya.getName(ra); ya.NameStyle.Show && len(name) > 0 {
 		nameStyle.GetTextOptions().WriteToRenderer(r)
-		tb := Draw.MeasureText(r, ya.Name, nameStyle)
+		tb := Draw.MeasureText(r, name, nameStyle)
 
 		var tx int
 		if ya.AxisType == YAxisPrimary {
 			tx = canvasBox.Right + int(sw) + DefaultYAxisMargin + maxTextWidth + DefaultYAxisMargin
 		} else if ya.AxisType == YAxisSecondary {
 			tx = canvasBox.Left - (DefaultYAxisMargin + int(sw) + maxTextWidth + DefaultYAxisMargin)
+			if ya.Side != YAxisSideUnset {
+				// the name is drawn from its left edge, so it has to clear the tick labels by its own width; this is
+				// only done for axes placed with `Side`, so charts that don't set it render as they always have.
+				tx -= tb.Width()
+			}
 		}
 
 		var ty int
@@ -196,7 +265,7 @@ func (ya YAxis) Render(r Renderer, canvasBox Box, ra Range, defaults Style, tick
 			ty = canvasBox.Top + (canvasBox.Height()>>1 - tb.Height()>>1)
 		}
 
-		Draw.Text(r, ya.Name, tx, ty, nameStyle)
+		Draw.Text(r, name, tx, ty, nameStyle)
 	}
 
 	if ya.Zero.Style.Show {
@@ -204,7 +273,7 @@ func (ya YAxis) Render(r Renderer, canvasBox Box, ra Range, defaults Style, tick
 	}
 
 	if ya.GridMajorStyle.Show || ya.GridMinorStyle.Show {
-		for _, gl := range ya.GetGridLines(ticks) {
+		for _, gl := range ya.getGridLines(ra, ticks, false) {
 			if (gl.IsMinor && ya.GridMinorStyle.Show) || (!gl.IsMinor && ya.GridMajorStyle.Show) {
 				defaults := ya.GridMajorStyle
 				if gl.IsMinor {
@@ -66,6 +66,24 @@ func TestYAxisMeasure(t *testing.T) {
 	assert.Equal(110, yab.Height())
 }
 
+func TestYAxisMeasureTickChip(t *testing.T) {
+	assert := assert.New(t)
+
+	f, err := GetDefaultFont()
+	assert.Nil(err)
+	style := Style{
+		Font:     f,
+		FontSize: 10.0,
+	}
+	r, err := PNG(100, 100)
+	assert.Nil(err)
+	ticks := []Tick{{Value: 1.0, Label: "1.0"}, {Value: 2.0, Label: "2.0"}, {Value: 3.0, Label: "3.0"}}
+	ya := YAxis{TickChipStyle: StyleShow()}
+	yab := ya.Measure(r, Box{0, 0, 100, 100}, &ContinuousRange{Min: 1.0, Max: 3.0, Domain: 100}, style, ticks)
+	assert.Equal(40, yab.Width())
+	assert.Equal(114, yab.Height())
+}
+
 func TestYAxisSecondaryMeasure(t *testing.T) {
 	assert := assert.New(t)
 
@@ -83,3 +101,24 @@ func TestYAxisSecondaryMeasure(t *testing.T) {
 	assert.Equal(32, yab.Width())
 	assert.Equal(110, yab.Height())
 }
+
+func TestYAxisGetTicksUnits(t *testing.T) {
+	assert := assert.New(t)
+
+	r, err := PNG(1024, 1024)
+	assert.Nil(err)
+	f, err := GetDefaultFont()
+	assert.Nil(err)
+
+	ya := YAxis{Units: UnitsBytes}
+	ra := &ContinuousRange{Min: 0, Max: 4 * 1024 * 1024 * 1024, Domain: 256}
+	ticks := ya.GetTicks(r, ra, Style{Font: f, FontSize: 10.0}, FloatValueFormatter)
+	assert.NotEmpty(ticks)
+
+	// every label is in the one unit, at the tick's value.
+	for _, tick := range ticks {
+		assert.Equal(FloatValueFormatter(tick.Value/(1024*1024*1024)), tick.Label)
+	}
+	assert.Equal("4.00", ticks[len(ticks)-1].Label)
+	assert.Equal("GB", ya.getName(ra))
+}